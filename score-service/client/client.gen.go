@@ -0,0 +1,7764 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by archy/scores version (devel) DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for AgeCategory.
+const (
+	Adult AgeCategory = "adult"
+	N50   AgeCategory = "50+"
+	U12   AgeCategory = "u12"
+	U14   AgeCategory = "u14"
+	U15   AgeCategory = "u15"
+	U16   AgeCategory = "u16"
+	U18   AgeCategory = "u18"
+	U21   AgeCategory = "u21"
+)
+
+// Defines values for AuditLogEntryAction.
+const (
+	Create AuditLogEntryAction = "create"
+	Delete AuditLogEntryAction = "delete"
+	Update AuditLogEntryAction = "update"
+)
+
+// Defines values for BowClass.
+const (
+	Barebow  BowClass = "barebow"
+	Compound BowClass = "compound"
+	Longbow  BowClass = "longbow"
+	Recurve  BowClass = "recurve"
+)
+
+// Defines values for CoachAthleteGrantedBy.
+const (
+	Admin      CoachAthleteGrantedBy = "admin"
+	Invitation CoachAthleteGrantedBy = "invitation"
+)
+
+// Defines values for CoachInvitationStatus.
+const (
+	Accepted CoachInvitationStatus = "accepted"
+	Declined CoachInvitationStatus = "declined"
+	Pending  CoachInvitationStatus = "pending"
+	Revoked  CoachInvitationStatus = "revoked"
+)
+
+// Defines values for CreatePersonalAccessTokenRequestScopes.
+const (
+	CoachesWrite     CreatePersonalAccessTokenRequestScopes = "coaches:write"
+	ScoresRead       CreatePersonalAccessTokenRequestScopes = "scores:read"
+	ScoresWrite      CreatePersonalAccessTokenRequestScopes = "scores:write"
+	TargetfacesWrite CreatePersonalAccessTokenRequestScopes = "targetfaces:write"
+)
+
+// Defines values for FatigueTrendDimension.
+const (
+	ArrowNumber FatigueTrendDimension = "arrow_number"
+	SetNumber   FatigueTrendDimension = "set_number"
+)
+
+// Defines values for FatigueTrendMetric.
+const (
+	DistanceFromCenter FatigueTrendMetric = "distance_from_center"
+	Score              FatigueTrendMetric = "score"
+)
+
+// Defines values for Gender.
+const (
+	Female Gender = "female"
+	Male   Gender = "male"
+)
+
+// Defines values for RoundType.
+const (
+	Practice      RoundType = "practice"
+	Qualification RoundType = "qualification"
+	Training      RoundType = "training"
+	Warmup        RoundType = "warmup"
+)
+
+// Defines values for SignerRole.
+const (
+	Archer  SignerRole = "archer"
+	Judge   SignerRole = "judge"
+	Witness SignerRole = "witness"
+)
+
+// Defines values for ListAuditLogParamsEntityType.
+const (
+	ListAuditLogParamsEntityTypeRound      ListAuditLogParamsEntityType = "round"
+	ListAuditLogParamsEntityTypeSet        ListAuditLogParamsEntityType = "set"
+	ListAuditLogParamsEntityTypeShot       ListAuditLogParamsEntityType = "shot"
+	ListAuditLogParamsEntityTypeTargetFace ListAuditLogParamsEntityType = "target_face"
+)
+
+// AddAthleteRequest defines model for AddAthleteRequest.
+type AddAthleteRequest struct {
+	AthleteUserId string `json:"athlete_user_id"`
+}
+
+// AgeCategory defines model for AgeCategory.
+type AgeCategory string
+
+// ArcherClassification defines model for ArcherClassification.
+type ArcherClassification struct {
+	AchievedAt     time.Time          `json:"achieved_at"`
+	AgeCategory    string             `json:"age_category"`
+	BowClass       string             `json:"bow_class"`
+	Classification string             `json:"classification"`
+	ExternalUserId string             `json:"external_user_id"`
+	Id             openapi_types.UUID `json:"id"`
+	Rank           int32              `json:"rank"`
+	RoundId        openapi_types.UUID `json:"round_id"`
+	RuleId         openapi_types.UUID `json:"rule_id"`
+	Score          int32              `json:"score"`
+}
+
+// ArcherHandicap defines model for ArcherHandicap.
+type ArcherHandicap struct {
+	BowClass       string    `json:"bow_class"`
+	ExternalUserId string    `json:"external_user_id"`
+	Handicap       int32     `json:"handicap"`
+	RoundsCount    int32     `json:"rounds_count"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// AuditLogEntry defines model for AuditLogEntry.
+type AuditLogEntry struct {
+	Action      AuditLogEntryAction `json:"action"`
+	ActorUserId string              `json:"actor_user_id"`
+
+	// AfterData The entity after the change
+	AfterData *map[string]interface{} `json:"after_data"`
+
+	// BeforeData The entity before the change
+	BeforeData  *map[string]interface{} `json:"before_data"`
+	EntityId    openapi_types.UUID      `json:"entity_id"`
+	EntityType  string                  `json:"entity_type"`
+	Id          int64                   `json:"id"`
+	OccurredAt  time.Time               `json:"occurred_at"`
+	OwnerUserId *string                 `json:"owner_user_id"`
+	Reason      *string                 `json:"reason"`
+	RequestId   *string                 `json:"request_id"`
+	TokenId     *string                 `json:"token_id"`
+	TokenType   string                  `json:"token_type"`
+}
+
+// AuditLogEntryAction defines model for AuditLogEntry.Action.
+type AuditLogEntryAction string
+
+// BowClass defines model for BowClass.
+type BowClass string
+
+// ClassificationRule defines model for ClassificationRule.
+type ClassificationRule struct {
+	AgeCategory     string             `json:"age_category"`
+	BowClass        string             `json:"bow_class"`
+	Classification  string             `json:"classification"`
+	CreatedAt       time.Time          `json:"created_at"`
+	Gender          string             `json:"gender"`
+	Id              openapi_types.UUID `json:"id"`
+	MinScore        int32              `json:"min_score"`
+	Rank            int32              `json:"rank"`
+	RoundTemplateId openapi_types.UUID `json:"round_template_id"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+}
+
+// CoachAthlete defines model for CoachAthlete.
+type CoachAthlete struct {
+	AthleteUserId string    `json:"athlete_user_id"`
+	CoachUserId   string    `json:"coach_user_id"`
+	CreatedAt     time.Time `json:"created_at"`
+
+	// GrantedBy Access granted by an admin outlives the invitations for the same coach
+	GrantedBy CoachAthleteGrantedBy `json:"granted_by"`
+}
+
+// CoachAthleteGrantedBy Access granted by an admin outlives the invitations for the same coach
+type CoachAthleteGrantedBy string
+
+// CoachInvitation defines model for CoachInvitation.
+type CoachInvitation struct {
+	AthleteUserId string `json:"athlete_user_id"`
+
+	// CoachUserId Null until an invite code is redeemed
+	CoachUserId *string               `json:"coach_user_id"`
+	CreatedAt   time.Time             `json:"created_at"`
+	ExpiresAt   time.Time             `json:"expires_at"`
+	Id          openapi_types.UUID    `json:"id"`
+	InviteCode  *string               `json:"invite_code"`
+	RespondedAt *time.Time            `json:"responded_at"`
+	Status      CoachInvitationStatus `json:"status"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+// CoachInvitationStatus defines model for CoachInvitation.Status.
+type CoachInvitationStatus string
+
+// CoachInvitations defines model for CoachInvitations.
+type CoachInvitations struct {
+	// Received As coach
+	Received []CoachInvitation `json:"received"`
+
+	// Sent As athlete
+	Sent []CoachInvitation `json:"sent"`
+}
+
+// Comment defines model for Comment.
+type Comment struct {
+	AuthorUserId string              `json:"author_user_id"`
+	Body         string              `json:"body"`
+	CreatedAt    time.Time           `json:"created_at"`
+	DeletedAt    *time.Time          `json:"deleted_at"`
+	Id           openapi_types.UUID  `json:"id"`
+	RoundId      openapi_types.UUID  `json:"round_id"`
+	SetId        *openapi_types.UUID `json:"set_id"`
+	ShotId       *openapi_types.UUID `json:"shot_id"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+// CreateCommentRequest defines model for CreateCommentRequest.
+type CreateCommentRequest struct {
+	Body string `json:"body"`
+}
+
+// CreatePersonalAccessTokenRequest defines model for CreatePersonalAccessTokenRequest.
+type CreatePersonalAccessTokenRequest struct {
+	// ExpiresInDays Omit for a token that does not expire
+	ExpiresInDays *int   `json:"expires_in_days,omitempty"`
+	Name          string `json:"name"`
+
+	// Scopes Defaults to scores:read and scores:write
+	Scopes *[]CreatePersonalAccessTokenRequestScopes `json:"scopes,omitempty"`
+}
+
+// CreatePersonalAccessTokenRequestScopes defines model for CreatePersonalAccessTokenRequest.Scopes.
+type CreatePersonalAccessTokenRequestScopes string
+
+// CreateQualificationRoundRequest defines model for CreateQualificationRoundRequest.
+type CreateQualificationRoundRequest struct {
+	AgeCategory *AgeCategory `json:"age_category,omitempty"`
+	BowClass    *BowClass    `json:"bow_class,omitempty"`
+
+	// Distance In meters
+	Distance        int                 `json:"distance"`
+	Gender          *Gender             `json:"gender,omitempty"`
+	Name            string              `json:"name"`
+	Notes           *string             `json:"notes,omitempty"`
+	RoundTemplateId *openapi_types.UUID `json:"round_template_id,omitempty"`
+	RoundType       RoundType           `json:"round_type"`
+	ShotsPerSet     int                 `json:"shots_per_set"`
+
+	// StartTime Defaults to now
+	StartTime    *time.Time         `json:"start_time,omitempty"`
+	TargetFaceId openapi_types.UUID `json:"target_face_id"`
+	TotalSets    int                `json:"total_sets"`
+}
+
+// CreateSetRequest defines model for CreateSetRequest.
+type CreateSetRequest struct {
+	// MaxShots Defaults to the round's shots_per_set
+	MaxShots *int `json:"max_shots,omitempty"`
+
+	// ParentRoundId Ignored, the round is taken from the URL
+	ParentRoundId *openapi_types.UUID `json:"parent_round_id,omitempty"`
+
+	// Reason Required for a judge adding to a signed round
+	Reason    *string `json:"reason,omitempty"`
+	SetNumber int     `json:"set_number"`
+}
+
+// CreateShotRequest defines model for CreateShotRequest.
+type CreateShotRequest struct {
+	Notes *string `json:"notes,omitempty"`
+
+	// Reason Required for a judge adding to a signed end
+	Reason *string `json:"reason,omitempty"`
+
+	// Score Ignored, the score is computed from the coordinates
+	Score *int `json:"score,omitempty"`
+
+	// X Horizontal offset from the centre in mm
+	X float64 `json:"x"`
+
+	// Y Vertical offset from the centre in mm
+	Y float64 `json:"y"`
+}
+
+// CreateShotsBatchRequest defines model for CreateShotsBatchRequest.
+type CreateShotsBatchRequest struct {
+	// Reason Required for a judge adding to a signed end
+	Reason *string             `json:"reason,omitempty"`
+	Shots  []CreateShotRequest `json:"shots"`
+}
+
+// CreatedPersonalAccessToken defines model for CreatedPersonalAccessToken.
+type CreatedPersonalAccessToken struct {
+	CreatedAt  time.Time          `json:"created_at"`
+	ExpiresAt  *time.Time         `json:"expires_at,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	LastUsedAt *time.Time         `json:"last_used_at,omitempty"`
+	Name       string             `json:"name"`
+	Scopes     []string           `json:"scopes"`
+
+	// Token Only returned once
+	Token       string `json:"token"`
+	TokenPrefix string `json:"token_prefix"`
+}
+
+// FatigueAnalysis defines model for FatigueAnalysis.
+type FatigueAnalysis struct {
+	Arrows          int             `json:"arrows"`
+	ByArrowNumber   []FatigueBucket `json:"by_arrow_number"`
+	BySetNumber     []FatigueBucket `json:"by_set_number"`
+	DeclineDetected bool            `json:"decline_detected"`
+	Rounds          int             `json:"rounds"`
+	Trends          []FatigueTrend  `json:"trends"`
+}
+
+// FatigueBucket defines model for FatigueBucket.
+type FatigueBucket struct {
+	Arrows int `json:"arrows"`
+
+	// AverageDistanceFromCenter In mm
+	AverageDistanceFromCenter float64 `json:"average_distance_from_center"`
+	AverageScore              float64 `json:"average_score"`
+
+	// GroupingDiameter In mm (2 * standard deviation)
+	GroupingDiameter *float64 `json:"grouping_diameter,omitempty"`
+
+	// Position Set number or arrow order within an end
+	Position int `json:"position"`
+}
+
+// FatigueTrend defines model for FatigueTrend.
+type FatigueTrend struct {
+	// Declining Significant and in the direction of worse performance
+	Declining   bool                  `json:"declining"`
+	Dimension   FatigueTrendDimension `json:"dimension"`
+	Intercept   float64               `json:"intercept"`
+	Metric      FatigueTrendMetric    `json:"metric"`
+	PValue      float64               `json:"p_value"`
+	RSquared    float64               `json:"r_squared"`
+	Significant bool                  `json:"significant"`
+	Slope       float64               `json:"slope"`
+}
+
+// FatigueTrendDimension defines model for FatigueTrend.Dimension.
+type FatigueTrendDimension string
+
+// FatigueTrendMetric defines model for FatigueTrend.Metric.
+type FatigueTrendMetric string
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field JSON name of the field, or a query parameter
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Gender defines model for Gender.
+type Gender string
+
+// HandicapPredictions defines model for HandicapPredictions.
+type HandicapPredictions struct {
+	BowClass    *string           `json:"bow_class,omitempty"`
+	Handicap    int               `json:"handicap"`
+	Predictions []ScorePrediction `json:"predictions"`
+}
+
+// InviteCoachRequest defines model for InviteCoachRequest.
+type InviteCoachRequest struct {
+	// CoachUserId Omit to create an invite code
+	CoachUserId *string `json:"coach_user_id,omitempty"`
+}
+
+// PersonalAccessToken defines model for PersonalAccessToken.
+type PersonalAccessToken struct {
+	CreatedAt   time.Time          `json:"created_at"`
+	ExpiresAt   *time.Time         `json:"expires_at,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+	LastUsedAt  *time.Time         `json:"last_used_at,omitempty"`
+	Name        string             `json:"name"`
+	Scopes      []string           `json:"scopes"`
+	TokenPrefix string             `json:"token_prefix"`
+}
+
+// Problem RFC 7807 problem details.
+type Problem struct {
+	Detail    *string       `json:"detail,omitempty"`
+	Errors    *[]FieldError `json:"errors,omitempty"`
+	Instance  *string       `json:"instance,omitempty"`
+	RequestId *string       `json:"request_id,omitempty"`
+	Status    int           `json:"status"`
+	Title     string        `json:"title"`
+	Type      string        `json:"type"`
+}
+
+// QualificationRound defines model for QualificationRound.
+type QualificationRound struct {
+	AgeCategory string `json:"age_category"`
+
+	// AverageScore Per arrow
+	AverageScore   *float64            `json:"average_score"`
+	BowClass       string              `json:"bow_class"`
+	CompetitionId  *openapi_types.UUID `json:"competition_id"`
+	CompletedSets  int32               `json:"completed_sets"`
+	CreatedAt      time.Time           `json:"created_at"`
+	DeletedAt      *time.Time          `json:"deleted_at"`
+	Distance       int32               `json:"distance"`
+	EndTime        *time.Time          `json:"end_time"`
+	ExternalUserId string              `json:"external_user_id"`
+	Gender         *string             `json:"gender"`
+
+	// Handicap Set when the round is completed
+	Handicap        *int32              `json:"handicap"`
+	Id              openapi_types.UUID  `json:"id"`
+	MissCount       int32               `json:"miss_count"`
+	Name            string              `json:"name"`
+	Notes           *string             `json:"notes"`
+	RoundTemplateId *openapi_types.UUID `json:"round_template_id"`
+	RoundType       string              `json:"round_type"`
+	ShotsCount      int32               `json:"shots_count"`
+	ShotsPerSet     int32               `json:"shots_per_set"`
+	StartTime       *time.Time          `json:"start_time"`
+	TargetFaceId    openapi_types.UUID  `json:"target_face_id"`
+	TenCount        int32               `json:"ten_count"`
+	TotalScore      int32               `json:"total_score"`
+	TotalSets       int32               `json:"total_sets"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+	XCount          int32               `json:"x_count"`
+}
+
+// RedeemInviteCodeRequest defines model for RedeemInviteCodeRequest.
+type RedeemInviteCodeRequest struct {
+	InviteCode string `json:"invite_code"`
+}
+
+// RoundTemplate defines model for RoundTemplate.
+type RoundTemplate struct {
+	CreatedAt    time.Time          `json:"created_at"`
+	DeletedAt    *time.Time         `json:"deleted_at"`
+	Description  *string            `json:"description"`
+	Distance     int32              `json:"distance"`
+	Id           openapi_types.UUID `json:"id"`
+	Name         string             `json:"name"`
+	ShotsPerSet  int32              `json:"shots_per_set"`
+	TargetFaceId openapi_types.UUID `json:"target_face_id"`
+	TotalSets    int32              `json:"total_sets"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+}
+
+// RoundType defines model for RoundType.
+type RoundType string
+
+// RoundVerification defines model for RoundVerification.
+type RoundVerification struct {
+	Algorithm  string                  `json:"algorithm"`
+	Hash       string                  `json:"hash"`
+	RoundId    openapi_types.UUID      `json:"round_id"`
+	Sets       []SetVerification       `json:"sets"`
+	ShotCount  int                     `json:"shot_count"`
+	Signatures []SignatureVerification `json:"signatures"`
+
+	// Verified Whether the round is signed and no signed scores changed since
+	Verified bool `json:"verified"`
+}
+
+// ScorePrediction defines model for ScorePrediction.
+type ScorePrediction struct {
+	Arrows int `json:"arrows"`
+
+	// Distance In meters
+	Distance        int                `json:"distance"`
+	ExpectedScore   int                `json:"expected_score"`
+	MaxScore        int                `json:"max_score"`
+	Name            string             `json:"name"`
+	RoundTemplateId openapi_types.UUID `json:"round_template_id"`
+}
+
+// ScoreSignature defines model for ScoreSignature.
+type ScoreSignature struct {
+	Id         openapi_types.UUID `json:"id"`
+	Reason     *string            `json:"reason"`
+	RoundId    openapi_types.UUID `json:"round_id"`
+	ScoresHash string             `json:"scores_hash"`
+
+	// SetId Null for a signature on the whole round
+	SetId        *openapi_types.UUID `json:"set_id"`
+	SignedAt     time.Time           `json:"signed_at"`
+	SignerRole   string              `json:"signer_role"`
+	SignerUserId string              `json:"signer_user_id"`
+}
+
+// Set defines model for Set.
+type Set struct {
+	AverageScore *float64   `json:"average_score"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+
+	// GroupingCenterX In mm
+	GroupingCenterX *float64 `json:"grouping_center_x"`
+
+	// GroupingCenterY In mm
+	GroupingCenterY *float64 `json:"grouping_center_y"`
+
+	// GroupingDiameter In mm (2 * standard deviation)
+	GroupingDiameter *float64            `json:"grouping_diameter"`
+	Id               openapi_types.UUID  `json:"id"`
+	MaxShots         int32               `json:"max_shots"`
+	MissCount        int32               `json:"miss_count"`
+	ParentMatchId    *openapi_types.UUID `json:"parent_match_id"`
+	ParentRoundId    *openapi_types.UUID `json:"parent_round_id"`
+	SetNumber        int32               `json:"set_number"`
+	ShotsCount       int32               `json:"shots_count"`
+	TenCount         int32               `json:"ten_count"`
+	TotalScore       int32               `json:"total_score"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+	XCount           int32               `json:"x_count"`
+}
+
+// SetVerification defines model for SetVerification.
+type SetVerification struct {
+	Hash      string             `json:"hash"`
+	SetId     openapi_types.UUID `json:"set_id"`
+	SetNumber int                `json:"set_number"`
+	ShotCount int                `json:"shot_count"`
+}
+
+// Shot defines model for Shot.
+type Shot struct {
+	CreatedAt time.Time  `json:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+
+	// DistanceFromCenter In mm
+	DistanceFromCenter *float64           `json:"distance_from_center"`
+	Id                 openapi_types.UUID `json:"id"`
+	IsMiss             bool               `json:"is_miss"`
+	IsTen              bool               `json:"is_ten"`
+	IsX                bool               `json:"is_x"`
+	Notes              *string            `json:"notes"`
+	Score              int32              `json:"score"`
+	SetId              openapi_types.UUID `json:"set_id"`
+	UpdatedAt          time.Time          `json:"updated_at"`
+
+	// X In mm, positive is right
+	X *float64 `json:"x"`
+
+	// Y In mm, positive is up
+	Y *float64 `json:"y"`
+}
+
+// SignScoresRequest defines model for SignScoresRequest.
+type SignScoresRequest struct {
+	// Reason Required for judges
+	Reason *string `json:"reason,omitempty"`
+
+	// Role Archers sign their own rounds. Witnesses must be able to read the
+	// round, as the archer's coach or an official. Judges sign any round
+	// and give a reason.
+	Role SignerRole `json:"role"`
+}
+
+// SignatureVerification defines model for SignatureVerification.
+type SignatureVerification struct {
+	Id         openapi_types.UUID `json:"id"`
+	Reason     *string            `json:"reason"`
+	RoundId    openapi_types.UUID `json:"round_id"`
+	ScoresHash string             `json:"scores_hash"`
+
+	// SetId Null for a signature on the whole round
+	SetId        *openapi_types.UUID `json:"set_id"`
+	SignedAt     time.Time           `json:"signed_at"`
+	SignerRole   string              `json:"signer_role"`
+	SignerUserId string              `json:"signer_user_id"`
+
+	// Valid Whether the signed scores are unchanged since signing
+	Valid bool `json:"valid"`
+}
+
+// SignerRole Archers sign their own rounds. Witnesses must be able to read the
+// round, as the archer's coach or an official. Judges sign any round
+// and give a reason.
+type SignerRole string
+
+// TargetFace defines model for TargetFace.
+type TargetFace struct {
+	CreatedAt       time.Time          `json:"created_at"`
+	DeletedAt       *time.Time         `json:"deleted_at"`
+	Description     *string            `json:"description"`
+	HasX            bool               `json:"has_x"`
+	Id              openapi_types.UUID `json:"id"`
+	MaxScore        int32              `json:"max_score"`
+	Name            string             `json:"name"`
+	ScoringDiameter int32              `json:"scoring_diameter"`
+	Standard        string             `json:"standard"`
+	TotalDiameter   int32              `json:"total_diameter"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+
+	// ZonesConfig The zones as base64-encoded JSON
+	ZonesConfig []byte `json:"zones_config"`
+}
+
+// TargetFaceRequest defines model for TargetFaceRequest.
+type TargetFaceRequest struct {
+	Description *string `json:"description,omitempty"`
+	HasX        *bool   `json:"has_x,omitempty"`
+	Name        string  `json:"name"`
+
+	// ScoringDiameter In mm, at most total_diameter
+	ScoringDiameter int `json:"scoring_diameter"`
+
+	// Standard WA, NFAA, ...
+	Standard string `json:"standard"`
+
+	// TotalDiameter In mm
+	TotalDiameter int `json:"total_diameter"`
+
+	// ZonesConfig Scoring zones from the centre outwards
+	ZonesConfig []Zone `json:"zones_config"`
+}
+
+// UpdateShotRequest At least one of x, y or notes is required.
+type UpdateShotRequest struct {
+	Notes *string `json:"notes,omitempty"`
+
+	// Reason Required for a judge changing a signed end
+	Reason *string  `json:"reason,omitempty"`
+	X      *float64 `json:"x,omitempty"`
+	Y      *float64 `json:"y,omitempty"`
+}
+
+// Zone defines model for Zone.
+type Zone struct {
+	// Radius Outer radius in mm
+	Radius float64 `json:"radius"`
+	Score  int     `json:"score"`
+}
+
+// AthleteId defines model for AthleteId.
+type AthleteId = string
+
+// CoachId defines model for CoachId.
+type CoachId = string
+
+// Id defines model for Id.
+type Id = openapi_types.UUID
+
+// RoundId defines model for RoundId.
+type RoundId = openapi_types.UUID
+
+// SetId defines model for SetId.
+type SetId = openapi_types.UUID
+
+// ShotId defines model for ShotId.
+type ShotId = openapi_types.UUID
+
+// CoachAthleteList defines model for CoachAthleteList.
+type CoachAthleteList = []CoachAthlete
+
+// CoachInvitationResult defines model for CoachInvitationResult.
+type CoachInvitationResult = CoachInvitation
+
+// CommentCreated defines model for CommentCreated.
+type CommentCreated = Comment
+
+// CommentList defines model for CommentList.
+type CommentList = []Comment
+
+// Error RFC 7807 problem details.
+type Error = Problem
+
+// GetFatigueAnalysisParams defines parameters for GetFatigueAnalysis.
+type GetFatigueAnalysisParams struct {
+	RoundType *RoundType `form:"round_type,omitempty" json:"round_type,omitempty"`
+	Distance  *int       `form:"distance,omitempty" json:"distance,omitempty"`
+}
+
+// ListAuditLogParams defines parameters for ListAuditLog.
+type ListAuditLogParams struct {
+	EntityType *ListAuditLogParamsEntityType `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+	EntityId   *openapi_types.UUID           `form:"entity_id,omitempty" json:"entity_id,omitempty"`
+
+	// UserId Archer whose data changed
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// ActorId Who made the change
+	ActorId *string `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+
+	// BeforeId Page through entries older than this ID
+	BeforeId *int64 `form:"before_id,omitempty" json:"before_id,omitempty"`
+	Limit    *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListAuditLogParamsEntityType defines parameters for ListAuditLog.
+type ListAuditLogParamsEntityType string
+
+// ListClassificationRulesParams defines parameters for ListClassificationRules.
+type ListClassificationRulesParams struct {
+	RoundTemplateId *openapi_types.UUID `form:"round_template_id,omitempty" json:"round_template_id,omitempty"`
+	BowClass        *BowClass           `form:"bow_class,omitempty" json:"bow_class,omitempty"`
+}
+
+// GetHandicapPredictionsParams defines parameters for GetHandicapPredictions.
+type GetHandicapPredictionsParams struct {
+	Handicap *int      `form:"handicap,omitempty" json:"handicap,omitempty"`
+	BowClass *BowClass `form:"bow_class,omitempty" json:"bow_class,omitempty"`
+}
+
+// DeleteShotParams defines parameters for DeleteShot.
+type DeleteShotParams struct {
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty"`
+}
+
+// InviteCoachJSONRequestBody defines body for InviteCoach for application/json ContentType.
+type InviteCoachJSONRequestBody = InviteCoachRequest
+
+// RedeemInviteCodeJSONRequestBody defines body for RedeemInviteCode for application/json ContentType.
+type RedeemInviteCodeJSONRequestBody = RedeemInviteCodeRequest
+
+// AddAthleteJSONRequestBody defines body for AddAthlete for application/json ContentType.
+type AddAthleteJSONRequestBody = AddAthleteRequest
+
+// CreateRoundJSONRequestBody defines body for CreateRound for application/json ContentType.
+type CreateRoundJSONRequestBody = CreateQualificationRoundRequest
+
+// CreateRoundCommentJSONRequestBody defines body for CreateRoundComment for application/json ContentType.
+type CreateRoundCommentJSONRequestBody = CreateCommentRequest
+
+// CreateSetJSONRequestBody defines body for CreateSet for application/json ContentType.
+type CreateSetJSONRequestBody = CreateSetRequest
+
+// CreateSetCommentJSONRequestBody defines body for CreateSetComment for application/json ContentType.
+type CreateSetCommentJSONRequestBody = CreateCommentRequest
+
+// CreateShotJSONRequestBody defines body for CreateShot for application/json ContentType.
+type CreateShotJSONRequestBody = CreateShotRequest
+
+// CreateShotsBatchJSONRequestBody defines body for CreateShotsBatch for application/json ContentType.
+type CreateShotsBatchJSONRequestBody = CreateShotsBatchRequest
+
+// UpdateShotJSONRequestBody defines body for UpdateShot for application/json ContentType.
+type UpdateShotJSONRequestBody = UpdateShotRequest
+
+// CreateShotCommentJSONRequestBody defines body for CreateShotComment for application/json ContentType.
+type CreateShotCommentJSONRequestBody = CreateCommentRequest
+
+// SignSetJSONRequestBody defines body for SignSet for application/json ContentType.
+type SignSetJSONRequestBody = SignScoresRequest
+
+// SignRoundJSONRequestBody defines body for SignRound for application/json ContentType.
+type SignRoundJSONRequestBody = SignScoresRequest
+
+// CreateTargetFaceJSONRequestBody defines body for CreateTargetFace for application/json ContentType.
+type CreateTargetFaceJSONRequestBody = TargetFaceRequest
+
+// UpdateTargetFaceJSONRequestBody defines body for UpdateTargetFace for application/json ContentType.
+type UpdateTargetFaceJSONRequestBody = TargetFaceRequest
+
+// CreateTokenJSONRequestBody defines body for CreateToken for application/json ContentType.
+type CreateTokenJSONRequestBody = CreatePersonalAccessTokenRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetFatigueAnalysis request
+	GetFatigueAnalysis(ctx context.Context, params *GetFatigueAnalysisParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListMyCoaches request
+	ListMyCoaches(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeCoach request
+	RevokeCoach(ctx context.Context, coachId CoachId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAuditLog request
+	ListAuditLog(ctx context.Context, params *ListAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClassifications request
+	ListClassifications(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClassificationRules request
+	ListClassificationRules(ctx context.Context, params *ListClassificationRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCoachInvitations request
+	ListCoachInvitations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// InviteCoachWithBody request with any body
+	InviteCoachWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	InviteCoach(ctx context.Context, body InviteCoachJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RedeemInviteCodeWithBody request with any body
+	RedeemInviteCodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RedeemInviteCode(ctx context.Context, body RedeemInviteCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AcceptCoachInvitation request
+	AcceptCoachInvitation(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeclineCoachInvitation request
+	DeclineCoachInvitation(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeCoachInvitation request
+	RevokeCoachInvitation(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListMyAthletes request
+	ListMyAthletes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAthleteRounds request
+	ListAthleteRounds(ctx context.Context, athleteId AthleteId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCoachAthletes request
+	ListCoachAthletes(ctx context.Context, coachId CoachId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddAthleteWithBody request with any body
+	AddAthleteWithBody(ctx context.Context, coachId CoachId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddAthlete(ctx context.Context, coachId CoachId, body AddAthleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveAthlete request
+	RemoveAthlete(ctx context.Context, coachId CoachId, athleteId AthleteId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteComment request
+	DeleteComment(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListHandicaps request
+	ListHandicaps(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHandicapPredictions request
+	GetHandicapPredictions(ctx context.Context, params *GetHandicapPredictionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRoundTemplates request
+	ListRoundTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRounds request
+	ListRounds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRoundWithBody request with any body
+	CreateRoundWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRound(ctx context.Context, body CreateRoundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRound request
+	GetRound(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteRound request
+	CompleteRound(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRoundComments request
+	ListRoundComments(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRoundCommentWithBody request with any body
+	CreateRoundCommentWithBody(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRoundComment(ctx context.Context, roundId RoundId, body CreateRoundCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSets request
+	ListSets(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSetWithBody request with any body
+	CreateSetWithBody(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSet(ctx context.Context, roundId RoundId, body CreateSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSet request
+	GetSet(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSetComments request
+	ListSetComments(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSetCommentWithBody request with any body
+	CreateSetCommentWithBody(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSetComment(ctx context.Context, roundId RoundId, setId SetId, body CreateSetCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListShots request
+	ListShots(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateShotWithBody request with any body
+	CreateShotWithBody(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateShot(ctx context.Context, roundId RoundId, setId SetId, body CreateShotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateShotsBatchWithBody request with any body
+	CreateShotsBatchWithBody(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateShotsBatch(ctx context.Context, roundId RoundId, setId SetId, body CreateShotsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteShot request
+	DeleteShot(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, params *DeleteShotParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetShot request
+	GetShot(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateShotWithBody request with any body
+	UpdateShotWithBody(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateShot(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, body UpdateShotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListShotComments request
+	ListShotComments(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateShotCommentWithBody request with any body
+	CreateShotCommentWithBody(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateShotComment(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, body CreateShotCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SignSetWithBody request with any body
+	SignSetWithBody(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SignSet(ctx context.Context, roundId RoundId, setId SetId, body SignSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSignatures request
+	ListSignatures(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SignRoundWithBody request with any body
+	SignRoundWithBody(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SignRound(ctx context.Context, roundId RoundId, body SignRoundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyRound request
+	VerifyRound(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTargetFaces request
+	ListTargetFaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTargetFaceWithBody request with any body
+	CreateTargetFaceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTargetFace(ctx context.Context, body CreateTargetFaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTargetFace request
+	DeleteTargetFace(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTargetFace request
+	GetTargetFace(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTargetFaceWithBody request with any body
+	UpdateTargetFaceWithBody(ctx context.Context, id Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTargetFace(ctx context.Context, id Id, body UpdateTargetFaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTokens request
+	ListTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTokenWithBody request with any body
+	CreateTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateToken(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeToken request
+	RevokeToken(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetFatigueAnalysis(ctx context.Context, params *GetFatigueAnalysisParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetFatigueAnalysisRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListMyCoaches(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMyCoachesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeCoach(ctx context.Context, coachId CoachId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeCoachRequest(c.Server, coachId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAuditLog(ctx context.Context, params *ListAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditLogRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListClassifications(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClassificationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListClassificationRules(ctx context.Context, params *ListClassificationRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClassificationRulesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCoachInvitations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCoachInvitationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InviteCoachWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInviteCoachRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) InviteCoach(ctx context.Context, body InviteCoachJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInviteCoachRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RedeemInviteCodeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRedeemInviteCodeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RedeemInviteCode(ctx context.Context, body RedeemInviteCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRedeemInviteCodeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AcceptCoachInvitation(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAcceptCoachInvitationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeclineCoachInvitation(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeclineCoachInvitationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeCoachInvitation(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeCoachInvitationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListMyAthletes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMyAthletesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListAthleteRounds(ctx context.Context, athleteId AthleteId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAthleteRoundsRequest(c.Server, athleteId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCoachAthletes(ctx context.Context, coachId CoachId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCoachAthletesRequest(c.Server, coachId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddAthleteWithBody(ctx context.Context, coachId CoachId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAthleteRequestWithBody(c.Server, coachId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddAthlete(ctx context.Context, coachId CoachId, body AddAthleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddAthleteRequest(c.Server, coachId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveAthlete(ctx context.Context, coachId CoachId, athleteId AthleteId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveAthleteRequest(c.Server, coachId, athleteId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteComment(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCommentRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListHandicaps(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListHandicapsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHandicapPredictions(ctx context.Context, params *GetHandicapPredictionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHandicapPredictionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRoundTemplates(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRoundTemplatesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRounds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRoundsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRoundWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoundRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRound(ctx context.Context, body CreateRoundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoundRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRound(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoundRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompleteRound(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteRoundRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRoundComments(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRoundCommentsRequest(c.Server, roundId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRoundCommentWithBody(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoundCommentRequestWithBody(c.Server, roundId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRoundComment(ctx context.Context, roundId RoundId, body CreateRoundCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoundCommentRequest(c.Server, roundId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSets(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSetsRequest(c.Server, roundId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSetWithBody(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSetRequestWithBody(c.Server, roundId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSet(ctx context.Context, roundId RoundId, body CreateSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSetRequest(c.Server, roundId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSet(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSetRequest(c.Server, roundId, setId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSetComments(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSetCommentsRequest(c.Server, roundId, setId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSetCommentWithBody(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSetCommentRequestWithBody(c.Server, roundId, setId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSetComment(ctx context.Context, roundId RoundId, setId SetId, body CreateSetCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSetCommentRequest(c.Server, roundId, setId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListShots(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListShotsRequest(c.Server, roundId, setId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateShotWithBody(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateShotRequestWithBody(c.Server, roundId, setId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateShot(ctx context.Context, roundId RoundId, setId SetId, body CreateShotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateShotRequest(c.Server, roundId, setId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateShotsBatchWithBody(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateShotsBatchRequestWithBody(c.Server, roundId, setId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateShotsBatch(ctx context.Context, roundId RoundId, setId SetId, body CreateShotsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateShotsBatchRequest(c.Server, roundId, setId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteShot(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, params *DeleteShotParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteShotRequest(c.Server, roundId, setId, shotId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetShot(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetShotRequest(c.Server, roundId, setId, shotId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateShotWithBody(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateShotRequestWithBody(c.Server, roundId, setId, shotId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateShot(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, body UpdateShotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateShotRequest(c.Server, roundId, setId, shotId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListShotComments(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListShotCommentsRequest(c.Server, roundId, setId, shotId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateShotCommentWithBody(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateShotCommentRequestWithBody(c.Server, roundId, setId, shotId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateShotComment(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, body CreateShotCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateShotCommentRequest(c.Server, roundId, setId, shotId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SignSetWithBody(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignSetRequestWithBody(c.Server, roundId, setId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SignSet(ctx context.Context, roundId RoundId, setId SetId, body SignSetJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignSetRequest(c.Server, roundId, setId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSignatures(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSignaturesRequest(c.Server, roundId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SignRoundWithBody(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignRoundRequestWithBody(c.Server, roundId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SignRound(ctx context.Context, roundId RoundId, body SignRoundJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSignRoundRequest(c.Server, roundId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyRound(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyRoundRequest(c.Server, roundId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTargetFaces(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTargetFacesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTargetFaceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTargetFaceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTargetFace(ctx context.Context, body CreateTargetFaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTargetFaceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTargetFace(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTargetFaceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTargetFace(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTargetFaceRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTargetFaceWithBody(ctx context.Context, id Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTargetFaceRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTargetFace(ctx context.Context, id Id, body UpdateTargetFaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTargetFaceRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTokensRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateToken(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RevokeToken(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeTokenRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetFatigueAnalysisRequest generates requests for GetFatigueAnalysis
+func NewGetFatigueAnalysisRequest(server string, params *GetFatigueAnalysisParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/analysis/fatigue")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.RoundType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "round_type", runtime.ParamLocationQuery, *params.RoundType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Distance != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "distance", runtime.ParamLocationQuery, *params.Distance); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListMyCoachesRequest generates requests for ListMyCoaches
+func NewListMyCoachesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/athlete/coaches")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeCoachRequest generates requests for RevokeCoach
+func NewRevokeCoachRequest(server string, coachId CoachId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "coachId", runtime.ParamLocationPath, coachId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/athlete/coaches/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAuditLogRequest generates requests for ListAuditLog
+func NewListAuditLogRequest(server string, params *ListAuditLogParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/audit-log")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EntityType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity_type", runtime.ParamLocationQuery, *params.EntityType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EntityId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entity_id", runtime.ParamLocationQuery, *params.EntityId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ActorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor_id", runtime.ParamLocationQuery, *params.ActorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BeforeId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before_id", runtime.ParamLocationQuery, *params.BeforeId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClassificationsRequest generates requests for ListClassifications
+func NewListClassificationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/classifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClassificationRulesRequest generates requests for ListClassificationRules
+func NewListClassificationRulesRequest(server string, params *ListClassificationRulesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/classifications/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.RoundTemplateId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "round_template_id", runtime.ParamLocationQuery, *params.RoundTemplateId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BowClass != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bow_class", runtime.ParamLocationQuery, *params.BowClass); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListCoachInvitationsRequest generates requests for ListCoachInvitations
+func NewListCoachInvitationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coach-invitations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewInviteCoachRequest calls the generic InviteCoach builder with application/json body
+func NewInviteCoachRequest(server string, body InviteCoachJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewInviteCoachRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewInviteCoachRequestWithBody generates requests for InviteCoach with any type of body
+func NewInviteCoachRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coach-invitations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRedeemInviteCodeRequest calls the generic RedeemInviteCode builder with application/json body
+func NewRedeemInviteCodeRequest(server string, body RedeemInviteCodeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRedeemInviteCodeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRedeemInviteCodeRequestWithBody generates requests for RedeemInviteCode with any type of body
+func NewRedeemInviteCodeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coach-invitations/redeem")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAcceptCoachInvitationRequest generates requests for AcceptCoachInvitation
+func NewAcceptCoachInvitationRequest(server string, id Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coach-invitations/%s/accept", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeclineCoachInvitationRequest generates requests for DeclineCoachInvitation
+func NewDeclineCoachInvitationRequest(server string, id Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coach-invitations/%s/decline", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeCoachInvitationRequest generates requests for RevokeCoachInvitation
+func NewRevokeCoachInvitationRequest(server string, id Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coach-invitations/%s/revoke", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListMyAthletesRequest generates requests for ListMyAthletes
+func NewListMyAthletesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coach/athletes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAthleteRoundsRequest generates requests for ListAthleteRounds
+func NewListAthleteRoundsRequest(server string, athleteId AthleteId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "athleteId", runtime.ParamLocationPath, athleteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coach/athletes/%s/rounds", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListCoachAthletesRequest generates requests for ListCoachAthletes
+func NewListCoachAthletesRequest(server string, coachId CoachId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "coachId", runtime.ParamLocationPath, coachId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coaches/%s/athletes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddAthleteRequest calls the generic AddAthlete builder with application/json body
+func NewAddAthleteRequest(server string, coachId CoachId, body AddAthleteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddAthleteRequestWithBody(server, coachId, "application/json", bodyReader)
+}
+
+// NewAddAthleteRequestWithBody generates requests for AddAthlete with any type of body
+func NewAddAthleteRequestWithBody(server string, coachId CoachId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "coachId", runtime.ParamLocationPath, coachId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coaches/%s/athletes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveAthleteRequest generates requests for RemoveAthlete
+func NewRemoveAthleteRequest(server string, coachId CoachId, athleteId AthleteId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "coachId", runtime.ParamLocationPath, coachId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "athleteId", runtime.ParamLocationPath, athleteId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/coaches/%s/athletes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteCommentRequest generates requests for DeleteComment
+func NewDeleteCommentRequest(server string, id Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/comments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListHandicapsRequest generates requests for ListHandicaps
+func NewListHandicapsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/handicaps")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHandicapPredictionsRequest generates requests for GetHandicapPredictions
+func NewGetHandicapPredictionsRequest(server string, params *GetHandicapPredictionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/handicaps/predictions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Handicap != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "handicap", runtime.ParamLocationQuery, *params.Handicap); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BowClass != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bow_class", runtime.ParamLocationQuery, *params.BowClass); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRoundTemplatesRequest generates requests for ListRoundTemplates
+func NewListRoundTemplatesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/round-templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRoundsRequest generates requests for ListRounds
+func NewListRoundsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRoundRequest calls the generic CreateRound builder with application/json body
+func NewCreateRoundRequest(server string, body CreateRoundJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRoundRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRoundRequestWithBody generates requests for CreateRound with any type of body
+func NewCreateRoundRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRoundRequest generates requests for GetRound
+func NewGetRoundRequest(server string, id Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCompleteRoundRequest generates requests for CompleteRound
+func NewCompleteRoundRequest(server string, id Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/complete", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRoundCommentsRequest generates requests for ListRoundComments
+func NewListRoundCommentsRequest(server string, roundId RoundId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRoundCommentRequest calls the generic CreateRoundComment builder with application/json body
+func NewCreateRoundCommentRequest(server string, roundId RoundId, body CreateRoundCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRoundCommentRequestWithBody(server, roundId, "application/json", bodyReader)
+}
+
+// NewCreateRoundCommentRequestWithBody generates requests for CreateRoundComment with any type of body
+func NewCreateRoundCommentRequestWithBody(server string, roundId RoundId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSetsRequest generates requests for ListSets
+func NewListSetsRequest(server string, roundId RoundId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSetRequest calls the generic CreateSet builder with application/json body
+func NewCreateSetRequest(server string, roundId RoundId, body CreateSetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSetRequestWithBody(server, roundId, "application/json", bodyReader)
+}
+
+// NewCreateSetRequestWithBody generates requests for CreateSet with any type of body
+func NewCreateSetRequestWithBody(server string, roundId RoundId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSetRequest generates requests for GetSet
+func NewGetSetRequest(server string, roundId RoundId, setId SetId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSetCommentsRequest generates requests for ListSetComments
+func NewListSetCommentsRequest(server string, roundId RoundId, setId SetId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/comments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSetCommentRequest calls the generic CreateSetComment builder with application/json body
+func NewCreateSetCommentRequest(server string, roundId RoundId, setId SetId, body CreateSetCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSetCommentRequestWithBody(server, roundId, setId, "application/json", bodyReader)
+}
+
+// NewCreateSetCommentRequestWithBody generates requests for CreateSetComment with any type of body
+func NewCreateSetCommentRequestWithBody(server string, roundId RoundId, setId SetId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/comments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListShotsRequest generates requests for ListShots
+func NewListShotsRequest(server string, roundId RoundId, setId SetId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/shots", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateShotRequest calls the generic CreateShot builder with application/json body
+func NewCreateShotRequest(server string, roundId RoundId, setId SetId, body CreateShotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateShotRequestWithBody(server, roundId, setId, "application/json", bodyReader)
+}
+
+// NewCreateShotRequestWithBody generates requests for CreateShot with any type of body
+func NewCreateShotRequestWithBody(server string, roundId RoundId, setId SetId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/shots", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateShotsBatchRequest calls the generic CreateShotsBatch builder with application/json body
+func NewCreateShotsBatchRequest(server string, roundId RoundId, setId SetId, body CreateShotsBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateShotsBatchRequestWithBody(server, roundId, setId, "application/json", bodyReader)
+}
+
+// NewCreateShotsBatchRequestWithBody generates requests for CreateShotsBatch with any type of body
+func NewCreateShotsBatchRequestWithBody(server string, roundId RoundId, setId SetId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/shots/batch", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteShotRequest generates requests for DeleteShot
+func NewDeleteShotRequest(server string, roundId RoundId, setId SetId, shotId ShotId, params *DeleteShotParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "shotId", runtime.ParamLocationPath, shotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/shots/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Reason != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reason", runtime.ParamLocationQuery, *params.Reason); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetShotRequest generates requests for GetShot
+func NewGetShotRequest(server string, roundId RoundId, setId SetId, shotId ShotId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "shotId", runtime.ParamLocationPath, shotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/shots/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateShotRequest calls the generic UpdateShot builder with application/json body
+func NewUpdateShotRequest(server string, roundId RoundId, setId SetId, shotId ShotId, body UpdateShotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateShotRequestWithBody(server, roundId, setId, shotId, "application/json", bodyReader)
+}
+
+// NewUpdateShotRequestWithBody generates requests for UpdateShot with any type of body
+func NewUpdateShotRequestWithBody(server string, roundId RoundId, setId SetId, shotId ShotId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "shotId", runtime.ParamLocationPath, shotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/shots/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListShotCommentsRequest generates requests for ListShotComments
+func NewListShotCommentsRequest(server string, roundId RoundId, setId SetId, shotId ShotId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "shotId", runtime.ParamLocationPath, shotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/shots/%s/comments", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateShotCommentRequest calls the generic CreateShotComment builder with application/json body
+func NewCreateShotCommentRequest(server string, roundId RoundId, setId SetId, shotId ShotId, body CreateShotCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateShotCommentRequestWithBody(server, roundId, setId, shotId, "application/json", bodyReader)
+}
+
+// NewCreateShotCommentRequestWithBody generates requests for CreateShotComment with any type of body
+func NewCreateShotCommentRequestWithBody(server string, roundId RoundId, setId SetId, shotId ShotId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "shotId", runtime.ParamLocationPath, shotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/shots/%s/comments", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSignSetRequest calls the generic SignSet builder with application/json body
+func NewSignSetRequest(server string, roundId RoundId, setId SetId, body SignSetJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSignSetRequestWithBody(server, roundId, setId, "application/json", bodyReader)
+}
+
+// NewSignSetRequestWithBody generates requests for SignSet with any type of body
+func NewSignSetRequestWithBody(server string, roundId RoundId, setId SetId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "setId", runtime.ParamLocationPath, setId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/sets/%s/signatures", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSignaturesRequest generates requests for ListSignatures
+func NewListSignaturesRequest(server string, roundId RoundId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/signatures", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSignRoundRequest calls the generic SignRound builder with application/json body
+func NewSignRoundRequest(server string, roundId RoundId, body SignRoundJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSignRoundRequestWithBody(server, roundId, "application/json", bodyReader)
+}
+
+// NewSignRoundRequestWithBody generates requests for SignRound with any type of body
+func NewSignRoundRequestWithBody(server string, roundId RoundId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/signatures", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewVerifyRoundRequest generates requests for VerifyRound
+func NewVerifyRoundRequest(server string, roundId RoundId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "roundId", runtime.ParamLocationPath, roundId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/rounds/%s/verification", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTargetFacesRequest generates requests for ListTargetFaces
+func NewListTargetFacesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/target-faces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTargetFaceRequest calls the generic CreateTargetFace builder with application/json body
+func NewCreateTargetFaceRequest(server string, body CreateTargetFaceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTargetFaceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTargetFaceRequestWithBody generates requests for CreateTargetFace with any type of body
+func NewCreateTargetFaceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/target-faces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTargetFaceRequest generates requests for DeleteTargetFace
+func NewDeleteTargetFaceRequest(server string, id Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/target-faces/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTargetFaceRequest generates requests for GetTargetFace
+func NewGetTargetFaceRequest(server string, id Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/target-faces/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTargetFaceRequest calls the generic UpdateTargetFace builder with application/json body
+func NewUpdateTargetFaceRequest(server string, id Id, body UpdateTargetFaceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTargetFaceRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateTargetFaceRequestWithBody generates requests for UpdateTargetFace with any type of body
+func NewUpdateTargetFaceRequestWithBody(server string, id Id, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/target-faces/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTokensRequest generates requests for ListTokens
+func NewListTokensRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTokenRequest calls the generic CreateToken builder with application/json body
+func NewCreateTokenRequest(server string, body CreateTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTokenRequestWithBody generates requests for CreateToken with any type of body
+func NewCreateTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRevokeTokenRequest generates requests for RevokeToken
+func NewRevokeTokenRequest(server string, id Id) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetFatigueAnalysisWithResponse request
+	GetFatigueAnalysisWithResponse(ctx context.Context, params *GetFatigueAnalysisParams, reqEditors ...RequestEditorFn) (*GetFatigueAnalysisResponse, error)
+
+	// ListMyCoachesWithResponse request
+	ListMyCoachesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMyCoachesResponse, error)
+
+	// RevokeCoachWithResponse request
+	RevokeCoachWithResponse(ctx context.Context, coachId CoachId, reqEditors ...RequestEditorFn) (*RevokeCoachResponse, error)
+
+	// ListAuditLogWithResponse request
+	ListAuditLogWithResponse(ctx context.Context, params *ListAuditLogParams, reqEditors ...RequestEditorFn) (*ListAuditLogResponse, error)
+
+	// ListClassificationsWithResponse request
+	ListClassificationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClassificationsResponse, error)
+
+	// ListClassificationRulesWithResponse request
+	ListClassificationRulesWithResponse(ctx context.Context, params *ListClassificationRulesParams, reqEditors ...RequestEditorFn) (*ListClassificationRulesResponse, error)
+
+	// ListCoachInvitationsWithResponse request
+	ListCoachInvitationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCoachInvitationsResponse, error)
+
+	// InviteCoachWithBodyWithResponse request with any body
+	InviteCoachWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InviteCoachResponse, error)
+
+	InviteCoachWithResponse(ctx context.Context, body InviteCoachJSONRequestBody, reqEditors ...RequestEditorFn) (*InviteCoachResponse, error)
+
+	// RedeemInviteCodeWithBodyWithResponse request with any body
+	RedeemInviteCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RedeemInviteCodeResponse, error)
+
+	RedeemInviteCodeWithResponse(ctx context.Context, body RedeemInviteCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*RedeemInviteCodeResponse, error)
+
+	// AcceptCoachInvitationWithResponse request
+	AcceptCoachInvitationWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*AcceptCoachInvitationResponse, error)
+
+	// DeclineCoachInvitationWithResponse request
+	DeclineCoachInvitationWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*DeclineCoachInvitationResponse, error)
+
+	// RevokeCoachInvitationWithResponse request
+	RevokeCoachInvitationWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*RevokeCoachInvitationResponse, error)
+
+	// ListMyAthletesWithResponse request
+	ListMyAthletesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMyAthletesResponse, error)
+
+	// ListAthleteRoundsWithResponse request
+	ListAthleteRoundsWithResponse(ctx context.Context, athleteId AthleteId, reqEditors ...RequestEditorFn) (*ListAthleteRoundsResponse, error)
+
+	// ListCoachAthletesWithResponse request
+	ListCoachAthletesWithResponse(ctx context.Context, coachId CoachId, reqEditors ...RequestEditorFn) (*ListCoachAthletesResponse, error)
+
+	// AddAthleteWithBodyWithResponse request with any body
+	AddAthleteWithBodyWithResponse(ctx context.Context, coachId CoachId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAthleteResponse, error)
+
+	AddAthleteWithResponse(ctx context.Context, coachId CoachId, body AddAthleteJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAthleteResponse, error)
+
+	// RemoveAthleteWithResponse request
+	RemoveAthleteWithResponse(ctx context.Context, coachId CoachId, athleteId AthleteId, reqEditors ...RequestEditorFn) (*RemoveAthleteResponse, error)
+
+	// DeleteCommentWithResponse request
+	DeleteCommentWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*DeleteCommentResponse, error)
+
+	// ListHandicapsWithResponse request
+	ListHandicapsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListHandicapsResponse, error)
+
+	// GetHandicapPredictionsWithResponse request
+	GetHandicapPredictionsWithResponse(ctx context.Context, params *GetHandicapPredictionsParams, reqEditors ...RequestEditorFn) (*GetHandicapPredictionsResponse, error)
+
+	// ListRoundTemplatesWithResponse request
+	ListRoundTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRoundTemplatesResponse, error)
+
+	// ListRoundsWithResponse request
+	ListRoundsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRoundsResponse, error)
+
+	// CreateRoundWithBodyWithResponse request with any body
+	CreateRoundWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoundResponse, error)
+
+	CreateRoundWithResponse(ctx context.Context, body CreateRoundJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoundResponse, error)
+
+	// GetRoundWithResponse request
+	GetRoundWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*GetRoundResponse, error)
+
+	// CompleteRoundWithResponse request
+	CompleteRoundWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*CompleteRoundResponse, error)
+
+	// ListRoundCommentsWithResponse request
+	ListRoundCommentsWithResponse(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*ListRoundCommentsResponse, error)
+
+	// CreateRoundCommentWithBodyWithResponse request with any body
+	CreateRoundCommentWithBodyWithResponse(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoundCommentResponse, error)
+
+	CreateRoundCommentWithResponse(ctx context.Context, roundId RoundId, body CreateRoundCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoundCommentResponse, error)
+
+	// ListSetsWithResponse request
+	ListSetsWithResponse(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*ListSetsResponse, error)
+
+	// CreateSetWithBodyWithResponse request with any body
+	CreateSetWithBodyWithResponse(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSetResponse, error)
+
+	CreateSetWithResponse(ctx context.Context, roundId RoundId, body CreateSetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSetResponse, error)
+
+	// GetSetWithResponse request
+	GetSetWithResponse(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*GetSetResponse, error)
+
+	// ListSetCommentsWithResponse request
+	ListSetCommentsWithResponse(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*ListSetCommentsResponse, error)
+
+	// CreateSetCommentWithBodyWithResponse request with any body
+	CreateSetCommentWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSetCommentResponse, error)
+
+	CreateSetCommentWithResponse(ctx context.Context, roundId RoundId, setId SetId, body CreateSetCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSetCommentResponse, error)
+
+	// ListShotsWithResponse request
+	ListShotsWithResponse(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*ListShotsResponse, error)
+
+	// CreateShotWithBodyWithResponse request with any body
+	CreateShotWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateShotResponse, error)
+
+	CreateShotWithResponse(ctx context.Context, roundId RoundId, setId SetId, body CreateShotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateShotResponse, error)
+
+	// CreateShotsBatchWithBodyWithResponse request with any body
+	CreateShotsBatchWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateShotsBatchResponse, error)
+
+	CreateShotsBatchWithResponse(ctx context.Context, roundId RoundId, setId SetId, body CreateShotsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateShotsBatchResponse, error)
+
+	// DeleteShotWithResponse request
+	DeleteShotWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, params *DeleteShotParams, reqEditors ...RequestEditorFn) (*DeleteShotResponse, error)
+
+	// GetShotWithResponse request
+	GetShotWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, reqEditors ...RequestEditorFn) (*GetShotResponse, error)
+
+	// UpdateShotWithBodyWithResponse request with any body
+	UpdateShotWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateShotResponse, error)
+
+	UpdateShotWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, body UpdateShotJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateShotResponse, error)
+
+	// ListShotCommentsWithResponse request
+	ListShotCommentsWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, reqEditors ...RequestEditorFn) (*ListShotCommentsResponse, error)
+
+	// CreateShotCommentWithBodyWithResponse request with any body
+	CreateShotCommentWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateShotCommentResponse, error)
+
+	CreateShotCommentWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, body CreateShotCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateShotCommentResponse, error)
+
+	// SignSetWithBodyWithResponse request with any body
+	SignSetWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignSetResponse, error)
+
+	SignSetWithResponse(ctx context.Context, roundId RoundId, setId SetId, body SignSetJSONRequestBody, reqEditors ...RequestEditorFn) (*SignSetResponse, error)
+
+	// ListSignaturesWithResponse request
+	ListSignaturesWithResponse(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*ListSignaturesResponse, error)
+
+	// SignRoundWithBodyWithResponse request with any body
+	SignRoundWithBodyWithResponse(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignRoundResponse, error)
+
+	SignRoundWithResponse(ctx context.Context, roundId RoundId, body SignRoundJSONRequestBody, reqEditors ...RequestEditorFn) (*SignRoundResponse, error)
+
+	// VerifyRoundWithResponse request
+	VerifyRoundWithResponse(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*VerifyRoundResponse, error)
+
+	// ListTargetFacesWithResponse request
+	ListTargetFacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTargetFacesResponse, error)
+
+	// CreateTargetFaceWithBodyWithResponse request with any body
+	CreateTargetFaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTargetFaceResponse, error)
+
+	CreateTargetFaceWithResponse(ctx context.Context, body CreateTargetFaceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTargetFaceResponse, error)
+
+	// DeleteTargetFaceWithResponse request
+	DeleteTargetFaceWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*DeleteTargetFaceResponse, error)
+
+	// GetTargetFaceWithResponse request
+	GetTargetFaceWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*GetTargetFaceResponse, error)
+
+	// UpdateTargetFaceWithBodyWithResponse request with any body
+	UpdateTargetFaceWithBodyWithResponse(ctx context.Context, id Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTargetFaceResponse, error)
+
+	UpdateTargetFaceWithResponse(ctx context.Context, id Id, body UpdateTargetFaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTargetFaceResponse, error)
+
+	// ListTokensWithResponse request
+	ListTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTokensResponse, error)
+
+	// CreateTokenWithBodyWithResponse request with any body
+	CreateTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error)
+
+	CreateTokenWithResponse(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error)
+
+	// RevokeTokenWithResponse request
+	RevokeTokenWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*RevokeTokenResponse, error)
+}
+
+type GetFatigueAnalysisResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *FatigueAnalysis
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetFatigueAnalysisResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetFatigueAnalysisResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMyCoachesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CoachAthleteList
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMyCoachesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMyCoachesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeCoachResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeCoachResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeCoachResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAuditLogResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]AuditLogEntry
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClassificationsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]ArcherClassification
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClassificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClassificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClassificationRulesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]ClassificationRule
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClassificationRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClassificationRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCoachInvitationsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CoachInvitations
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCoachInvitationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCoachInvitationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InviteCoachResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *CoachInvitationResult
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r InviteCoachResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InviteCoachResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RedeemInviteCodeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CoachInvitationResult
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RedeemInviteCodeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RedeemInviteCodeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AcceptCoachInvitationResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CoachInvitationResult
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AcceptCoachInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AcceptCoachInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeclineCoachInvitationResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CoachInvitationResult
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeclineCoachInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeclineCoachInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeCoachInvitationResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CoachInvitationResult
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeCoachInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeCoachInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMyAthletesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CoachAthleteList
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMyAthletesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMyAthletesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAthleteRoundsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]QualificationRound
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAthleteRoundsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAthleteRoundsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCoachAthletesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CoachAthleteList
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCoachAthletesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCoachAthletesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddAthleteResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *CoachAthlete
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r AddAthleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddAthleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveAthleteResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveAthleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveAthleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCommentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListHandicapsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]ArcherHandicap
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListHandicapsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListHandicapsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHandicapPredictionsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *HandicapPredictions
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHandicapPredictionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHandicapPredictionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRoundTemplatesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]RoundTemplate
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRoundTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRoundTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRoundsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]QualificationRound
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRoundsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRoundsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRoundResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *QualificationRound
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRoundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRoundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRoundResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *QualificationRound
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRoundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRoundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteRoundResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *QualificationRound
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteRoundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteRoundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRoundCommentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CommentList
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRoundCommentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRoundCommentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRoundCommentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *CommentCreated
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRoundCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRoundCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSetsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Set
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Set
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Set
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSetCommentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CommentList
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSetCommentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSetCommentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSetCommentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *CommentCreated
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSetCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSetCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListShotsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Shot
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListShotsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListShotsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateShotResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *Shot
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateShotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateShotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateShotsBatchResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *[]Shot
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateShotsBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateShotsBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteShotResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteShotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteShotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetShotResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Shot
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetShotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetShotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateShotResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Shot
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateShotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateShotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListShotCommentsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CommentList
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListShotCommentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListShotCommentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateShotCommentResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *CommentCreated
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateShotCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateShotCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SignSetResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *ScoreSignature
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SignSetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SignSetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSignaturesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]ScoreSignature
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSignaturesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSignaturesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SignRoundResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *ScoreSignature
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r SignRoundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SignRoundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyRoundResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *RoundVerification
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyRoundResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyRoundResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTargetFacesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]TargetFace
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTargetFacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTargetFacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTargetFaceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *TargetFace
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTargetFaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTargetFaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTargetFaceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTargetFaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTargetFaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTargetFaceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TargetFace
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTargetFaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTargetFaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTargetFaceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TargetFace
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTargetFaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTargetFaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTokensResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]PersonalAccessToken
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTokenResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *CreatedPersonalAccessToken
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeTokenResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetFatigueAnalysisWithResponse request returning *GetFatigueAnalysisResponse
+func (c *ClientWithResponses) GetFatigueAnalysisWithResponse(ctx context.Context, params *GetFatigueAnalysisParams, reqEditors ...RequestEditorFn) (*GetFatigueAnalysisResponse, error) {
+	rsp, err := c.GetFatigueAnalysis(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetFatigueAnalysisResponse(rsp)
+}
+
+// ListMyCoachesWithResponse request returning *ListMyCoachesResponse
+func (c *ClientWithResponses) ListMyCoachesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMyCoachesResponse, error) {
+	rsp, err := c.ListMyCoaches(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMyCoachesResponse(rsp)
+}
+
+// RevokeCoachWithResponse request returning *RevokeCoachResponse
+func (c *ClientWithResponses) RevokeCoachWithResponse(ctx context.Context, coachId CoachId, reqEditors ...RequestEditorFn) (*RevokeCoachResponse, error) {
+	rsp, err := c.RevokeCoach(ctx, coachId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeCoachResponse(rsp)
+}
+
+// ListAuditLogWithResponse request returning *ListAuditLogResponse
+func (c *ClientWithResponses) ListAuditLogWithResponse(ctx context.Context, params *ListAuditLogParams, reqEditors ...RequestEditorFn) (*ListAuditLogResponse, error) {
+	rsp, err := c.ListAuditLog(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditLogResponse(rsp)
+}
+
+// ListClassificationsWithResponse request returning *ListClassificationsResponse
+func (c *ClientWithResponses) ListClassificationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClassificationsResponse, error) {
+	rsp, err := c.ListClassifications(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClassificationsResponse(rsp)
+}
+
+// ListClassificationRulesWithResponse request returning *ListClassificationRulesResponse
+func (c *ClientWithResponses) ListClassificationRulesWithResponse(ctx context.Context, params *ListClassificationRulesParams, reqEditors ...RequestEditorFn) (*ListClassificationRulesResponse, error) {
+	rsp, err := c.ListClassificationRules(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClassificationRulesResponse(rsp)
+}
+
+// ListCoachInvitationsWithResponse request returning *ListCoachInvitationsResponse
+func (c *ClientWithResponses) ListCoachInvitationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCoachInvitationsResponse, error) {
+	rsp, err := c.ListCoachInvitations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCoachInvitationsResponse(rsp)
+}
+
+// InviteCoachWithBodyWithResponse request with arbitrary body returning *InviteCoachResponse
+func (c *ClientWithResponses) InviteCoachWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*InviteCoachResponse, error) {
+	rsp, err := c.InviteCoachWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInviteCoachResponse(rsp)
+}
+
+func (c *ClientWithResponses) InviteCoachWithResponse(ctx context.Context, body InviteCoachJSONRequestBody, reqEditors ...RequestEditorFn) (*InviteCoachResponse, error) {
+	rsp, err := c.InviteCoach(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInviteCoachResponse(rsp)
+}
+
+// RedeemInviteCodeWithBodyWithResponse request with arbitrary body returning *RedeemInviteCodeResponse
+func (c *ClientWithResponses) RedeemInviteCodeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RedeemInviteCodeResponse, error) {
+	rsp, err := c.RedeemInviteCodeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRedeemInviteCodeResponse(rsp)
+}
+
+func (c *ClientWithResponses) RedeemInviteCodeWithResponse(ctx context.Context, body RedeemInviteCodeJSONRequestBody, reqEditors ...RequestEditorFn) (*RedeemInviteCodeResponse, error) {
+	rsp, err := c.RedeemInviteCode(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRedeemInviteCodeResponse(rsp)
+}
+
+// AcceptCoachInvitationWithResponse request returning *AcceptCoachInvitationResponse
+func (c *ClientWithResponses) AcceptCoachInvitationWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*AcceptCoachInvitationResponse, error) {
+	rsp, err := c.AcceptCoachInvitation(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAcceptCoachInvitationResponse(rsp)
+}
+
+// DeclineCoachInvitationWithResponse request returning *DeclineCoachInvitationResponse
+func (c *ClientWithResponses) DeclineCoachInvitationWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*DeclineCoachInvitationResponse, error) {
+	rsp, err := c.DeclineCoachInvitation(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeclineCoachInvitationResponse(rsp)
+}
+
+// RevokeCoachInvitationWithResponse request returning *RevokeCoachInvitationResponse
+func (c *ClientWithResponses) RevokeCoachInvitationWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*RevokeCoachInvitationResponse, error) {
+	rsp, err := c.RevokeCoachInvitation(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeCoachInvitationResponse(rsp)
+}
+
+// ListMyAthletesWithResponse request returning *ListMyAthletesResponse
+func (c *ClientWithResponses) ListMyAthletesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMyAthletesResponse, error) {
+	rsp, err := c.ListMyAthletes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMyAthletesResponse(rsp)
+}
+
+// ListAthleteRoundsWithResponse request returning *ListAthleteRoundsResponse
+func (c *ClientWithResponses) ListAthleteRoundsWithResponse(ctx context.Context, athleteId AthleteId, reqEditors ...RequestEditorFn) (*ListAthleteRoundsResponse, error) {
+	rsp, err := c.ListAthleteRounds(ctx, athleteId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAthleteRoundsResponse(rsp)
+}
+
+// ListCoachAthletesWithResponse request returning *ListCoachAthletesResponse
+func (c *ClientWithResponses) ListCoachAthletesWithResponse(ctx context.Context, coachId CoachId, reqEditors ...RequestEditorFn) (*ListCoachAthletesResponse, error) {
+	rsp, err := c.ListCoachAthletes(ctx, coachId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCoachAthletesResponse(rsp)
+}
+
+// AddAthleteWithBodyWithResponse request with arbitrary body returning *AddAthleteResponse
+func (c *ClientWithResponses) AddAthleteWithBodyWithResponse(ctx context.Context, coachId CoachId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddAthleteResponse, error) {
+	rsp, err := c.AddAthleteWithBody(ctx, coachId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAthleteResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddAthleteWithResponse(ctx context.Context, coachId CoachId, body AddAthleteJSONRequestBody, reqEditors ...RequestEditorFn) (*AddAthleteResponse, error) {
+	rsp, err := c.AddAthlete(ctx, coachId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddAthleteResponse(rsp)
+}
+
+// RemoveAthleteWithResponse request returning *RemoveAthleteResponse
+func (c *ClientWithResponses) RemoveAthleteWithResponse(ctx context.Context, coachId CoachId, athleteId AthleteId, reqEditors ...RequestEditorFn) (*RemoveAthleteResponse, error) {
+	rsp, err := c.RemoveAthlete(ctx, coachId, athleteId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveAthleteResponse(rsp)
+}
+
+// DeleteCommentWithResponse request returning *DeleteCommentResponse
+func (c *ClientWithResponses) DeleteCommentWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*DeleteCommentResponse, error) {
+	rsp, err := c.DeleteComment(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCommentResponse(rsp)
+}
+
+// ListHandicapsWithResponse request returning *ListHandicapsResponse
+func (c *ClientWithResponses) ListHandicapsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListHandicapsResponse, error) {
+	rsp, err := c.ListHandicaps(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListHandicapsResponse(rsp)
+}
+
+// GetHandicapPredictionsWithResponse request returning *GetHandicapPredictionsResponse
+func (c *ClientWithResponses) GetHandicapPredictionsWithResponse(ctx context.Context, params *GetHandicapPredictionsParams, reqEditors ...RequestEditorFn) (*GetHandicapPredictionsResponse, error) {
+	rsp, err := c.GetHandicapPredictions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHandicapPredictionsResponse(rsp)
+}
+
+// ListRoundTemplatesWithResponse request returning *ListRoundTemplatesResponse
+func (c *ClientWithResponses) ListRoundTemplatesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRoundTemplatesResponse, error) {
+	rsp, err := c.ListRoundTemplates(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRoundTemplatesResponse(rsp)
+}
+
+// ListRoundsWithResponse request returning *ListRoundsResponse
+func (c *ClientWithResponses) ListRoundsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRoundsResponse, error) {
+	rsp, err := c.ListRounds(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRoundsResponse(rsp)
+}
+
+// CreateRoundWithBodyWithResponse request with arbitrary body returning *CreateRoundResponse
+func (c *ClientWithResponses) CreateRoundWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoundResponse, error) {
+	rsp, err := c.CreateRoundWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoundResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRoundWithResponse(ctx context.Context, body CreateRoundJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoundResponse, error) {
+	rsp, err := c.CreateRound(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoundResponse(rsp)
+}
+
+// GetRoundWithResponse request returning *GetRoundResponse
+func (c *ClientWithResponses) GetRoundWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*GetRoundResponse, error) {
+	rsp, err := c.GetRound(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoundResponse(rsp)
+}
+
+// CompleteRoundWithResponse request returning *CompleteRoundResponse
+func (c *ClientWithResponses) CompleteRoundWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*CompleteRoundResponse, error) {
+	rsp, err := c.CompleteRound(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteRoundResponse(rsp)
+}
+
+// ListRoundCommentsWithResponse request returning *ListRoundCommentsResponse
+func (c *ClientWithResponses) ListRoundCommentsWithResponse(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*ListRoundCommentsResponse, error) {
+	rsp, err := c.ListRoundComments(ctx, roundId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRoundCommentsResponse(rsp)
+}
+
+// CreateRoundCommentWithBodyWithResponse request with arbitrary body returning *CreateRoundCommentResponse
+func (c *ClientWithResponses) CreateRoundCommentWithBodyWithResponse(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoundCommentResponse, error) {
+	rsp, err := c.CreateRoundCommentWithBody(ctx, roundId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoundCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRoundCommentWithResponse(ctx context.Context, roundId RoundId, body CreateRoundCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoundCommentResponse, error) {
+	rsp, err := c.CreateRoundComment(ctx, roundId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoundCommentResponse(rsp)
+}
+
+// ListSetsWithResponse request returning *ListSetsResponse
+func (c *ClientWithResponses) ListSetsWithResponse(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*ListSetsResponse, error) {
+	rsp, err := c.ListSets(ctx, roundId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSetsResponse(rsp)
+}
+
+// CreateSetWithBodyWithResponse request with arbitrary body returning *CreateSetResponse
+func (c *ClientWithResponses) CreateSetWithBodyWithResponse(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSetResponse, error) {
+	rsp, err := c.CreateSetWithBody(ctx, roundId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSetResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSetWithResponse(ctx context.Context, roundId RoundId, body CreateSetJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSetResponse, error) {
+	rsp, err := c.CreateSet(ctx, roundId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSetResponse(rsp)
+}
+
+// GetSetWithResponse request returning *GetSetResponse
+func (c *ClientWithResponses) GetSetWithResponse(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*GetSetResponse, error) {
+	rsp, err := c.GetSet(ctx, roundId, setId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSetResponse(rsp)
+}
+
+// ListSetCommentsWithResponse request returning *ListSetCommentsResponse
+func (c *ClientWithResponses) ListSetCommentsWithResponse(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*ListSetCommentsResponse, error) {
+	rsp, err := c.ListSetComments(ctx, roundId, setId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSetCommentsResponse(rsp)
+}
+
+// CreateSetCommentWithBodyWithResponse request with arbitrary body returning *CreateSetCommentResponse
+func (c *ClientWithResponses) CreateSetCommentWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSetCommentResponse, error) {
+	rsp, err := c.CreateSetCommentWithBody(ctx, roundId, setId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSetCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSetCommentWithResponse(ctx context.Context, roundId RoundId, setId SetId, body CreateSetCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSetCommentResponse, error) {
+	rsp, err := c.CreateSetComment(ctx, roundId, setId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSetCommentResponse(rsp)
+}
+
+// ListShotsWithResponse request returning *ListShotsResponse
+func (c *ClientWithResponses) ListShotsWithResponse(ctx context.Context, roundId RoundId, setId SetId, reqEditors ...RequestEditorFn) (*ListShotsResponse, error) {
+	rsp, err := c.ListShots(ctx, roundId, setId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListShotsResponse(rsp)
+}
+
+// CreateShotWithBodyWithResponse request with arbitrary body returning *CreateShotResponse
+func (c *ClientWithResponses) CreateShotWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateShotResponse, error) {
+	rsp, err := c.CreateShotWithBody(ctx, roundId, setId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateShotResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateShotWithResponse(ctx context.Context, roundId RoundId, setId SetId, body CreateShotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateShotResponse, error) {
+	rsp, err := c.CreateShot(ctx, roundId, setId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateShotResponse(rsp)
+}
+
+// CreateShotsBatchWithBodyWithResponse request with arbitrary body returning *CreateShotsBatchResponse
+func (c *ClientWithResponses) CreateShotsBatchWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateShotsBatchResponse, error) {
+	rsp, err := c.CreateShotsBatchWithBody(ctx, roundId, setId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateShotsBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateShotsBatchWithResponse(ctx context.Context, roundId RoundId, setId SetId, body CreateShotsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateShotsBatchResponse, error) {
+	rsp, err := c.CreateShotsBatch(ctx, roundId, setId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateShotsBatchResponse(rsp)
+}
+
+// DeleteShotWithResponse request returning *DeleteShotResponse
+func (c *ClientWithResponses) DeleteShotWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, params *DeleteShotParams, reqEditors ...RequestEditorFn) (*DeleteShotResponse, error) {
+	rsp, err := c.DeleteShot(ctx, roundId, setId, shotId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteShotResponse(rsp)
+}
+
+// GetShotWithResponse request returning *GetShotResponse
+func (c *ClientWithResponses) GetShotWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, reqEditors ...RequestEditorFn) (*GetShotResponse, error) {
+	rsp, err := c.GetShot(ctx, roundId, setId, shotId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetShotResponse(rsp)
+}
+
+// UpdateShotWithBodyWithResponse request with arbitrary body returning *UpdateShotResponse
+func (c *ClientWithResponses) UpdateShotWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateShotResponse, error) {
+	rsp, err := c.UpdateShotWithBody(ctx, roundId, setId, shotId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateShotResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateShotWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, body UpdateShotJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateShotResponse, error) {
+	rsp, err := c.UpdateShot(ctx, roundId, setId, shotId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateShotResponse(rsp)
+}
+
+// ListShotCommentsWithResponse request returning *ListShotCommentsResponse
+func (c *ClientWithResponses) ListShotCommentsWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, reqEditors ...RequestEditorFn) (*ListShotCommentsResponse, error) {
+	rsp, err := c.ListShotComments(ctx, roundId, setId, shotId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListShotCommentsResponse(rsp)
+}
+
+// CreateShotCommentWithBodyWithResponse request with arbitrary body returning *CreateShotCommentResponse
+func (c *ClientWithResponses) CreateShotCommentWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateShotCommentResponse, error) {
+	rsp, err := c.CreateShotCommentWithBody(ctx, roundId, setId, shotId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateShotCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateShotCommentWithResponse(ctx context.Context, roundId RoundId, setId SetId, shotId ShotId, body CreateShotCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateShotCommentResponse, error) {
+	rsp, err := c.CreateShotComment(ctx, roundId, setId, shotId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateShotCommentResponse(rsp)
+}
+
+// SignSetWithBodyWithResponse request with arbitrary body returning *SignSetResponse
+func (c *ClientWithResponses) SignSetWithBodyWithResponse(ctx context.Context, roundId RoundId, setId SetId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignSetResponse, error) {
+	rsp, err := c.SignSetWithBody(ctx, roundId, setId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignSetResponse(rsp)
+}
+
+func (c *ClientWithResponses) SignSetWithResponse(ctx context.Context, roundId RoundId, setId SetId, body SignSetJSONRequestBody, reqEditors ...RequestEditorFn) (*SignSetResponse, error) {
+	rsp, err := c.SignSet(ctx, roundId, setId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignSetResponse(rsp)
+}
+
+// ListSignaturesWithResponse request returning *ListSignaturesResponse
+func (c *ClientWithResponses) ListSignaturesWithResponse(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*ListSignaturesResponse, error) {
+	rsp, err := c.ListSignatures(ctx, roundId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSignaturesResponse(rsp)
+}
+
+// SignRoundWithBodyWithResponse request with arbitrary body returning *SignRoundResponse
+func (c *ClientWithResponses) SignRoundWithBodyWithResponse(ctx context.Context, roundId RoundId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SignRoundResponse, error) {
+	rsp, err := c.SignRoundWithBody(ctx, roundId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignRoundResponse(rsp)
+}
+
+func (c *ClientWithResponses) SignRoundWithResponse(ctx context.Context, roundId RoundId, body SignRoundJSONRequestBody, reqEditors ...RequestEditorFn) (*SignRoundResponse, error) {
+	rsp, err := c.SignRound(ctx, roundId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSignRoundResponse(rsp)
+}
+
+// VerifyRoundWithResponse request returning *VerifyRoundResponse
+func (c *ClientWithResponses) VerifyRoundWithResponse(ctx context.Context, roundId RoundId, reqEditors ...RequestEditorFn) (*VerifyRoundResponse, error) {
+	rsp, err := c.VerifyRound(ctx, roundId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyRoundResponse(rsp)
+}
+
+// ListTargetFacesWithResponse request returning *ListTargetFacesResponse
+func (c *ClientWithResponses) ListTargetFacesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTargetFacesResponse, error) {
+	rsp, err := c.ListTargetFaces(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTargetFacesResponse(rsp)
+}
+
+// CreateTargetFaceWithBodyWithResponse request with arbitrary body returning *CreateTargetFaceResponse
+func (c *ClientWithResponses) CreateTargetFaceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTargetFaceResponse, error) {
+	rsp, err := c.CreateTargetFaceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTargetFaceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTargetFaceWithResponse(ctx context.Context, body CreateTargetFaceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTargetFaceResponse, error) {
+	rsp, err := c.CreateTargetFace(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTargetFaceResponse(rsp)
+}
+
+// DeleteTargetFaceWithResponse request returning *DeleteTargetFaceResponse
+func (c *ClientWithResponses) DeleteTargetFaceWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*DeleteTargetFaceResponse, error) {
+	rsp, err := c.DeleteTargetFace(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTargetFaceResponse(rsp)
+}
+
+// GetTargetFaceWithResponse request returning *GetTargetFaceResponse
+func (c *ClientWithResponses) GetTargetFaceWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*GetTargetFaceResponse, error) {
+	rsp, err := c.GetTargetFace(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTargetFaceResponse(rsp)
+}
+
+// UpdateTargetFaceWithBodyWithResponse request with arbitrary body returning *UpdateTargetFaceResponse
+func (c *ClientWithResponses) UpdateTargetFaceWithBodyWithResponse(ctx context.Context, id Id, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTargetFaceResponse, error) {
+	rsp, err := c.UpdateTargetFaceWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTargetFaceResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTargetFaceWithResponse(ctx context.Context, id Id, body UpdateTargetFaceJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTargetFaceResponse, error) {
+	rsp, err := c.UpdateTargetFace(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTargetFaceResponse(rsp)
+}
+
+// ListTokensWithResponse request returning *ListTokensResponse
+func (c *ClientWithResponses) ListTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTokensResponse, error) {
+	rsp, err := c.ListTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTokensResponse(rsp)
+}
+
+// CreateTokenWithBodyWithResponse request with arbitrary body returning *CreateTokenResponse
+func (c *ClientWithResponses) CreateTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error) {
+	rsp, err := c.CreateTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTokenWithResponse(ctx context.Context, body CreateTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTokenResponse, error) {
+	rsp, err := c.CreateToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTokenResponse(rsp)
+}
+
+// RevokeTokenWithResponse request returning *RevokeTokenResponse
+func (c *ClientWithResponses) RevokeTokenWithResponse(ctx context.Context, id Id, reqEditors ...RequestEditorFn) (*RevokeTokenResponse, error) {
+	rsp, err := c.RevokeToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeTokenResponse(rsp)
+}
+
+// ParseGetFatigueAnalysisResponse parses an HTTP response from a GetFatigueAnalysisWithResponse call
+func ParseGetFatigueAnalysisResponse(rsp *http.Response) (*GetFatigueAnalysisResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetFatigueAnalysisResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FatigueAnalysis
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMyCoachesResponse parses an HTTP response from a ListMyCoachesWithResponse call
+func ParseListMyCoachesResponse(rsp *http.Response) (*ListMyCoachesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMyCoachesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CoachAthleteList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeCoachResponse parses an HTTP response from a RevokeCoachWithResponse call
+func ParseRevokeCoachResponse(rsp *http.Response) (*RevokeCoachResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeCoachResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAuditLogResponse parses an HTTP response from a ListAuditLogWithResponse call
+func ParseListAuditLogResponse(rsp *http.Response) (*ListAuditLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAuditLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuditLogEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClassificationsResponse parses an HTTP response from a ListClassificationsWithResponse call
+func ParseListClassificationsResponse(rsp *http.Response) (*ListClassificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClassificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ArcherClassification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClassificationRulesResponse parses an HTTP response from a ListClassificationRulesWithResponse call
+func ParseListClassificationRulesResponse(rsp *http.Response) (*ListClassificationRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClassificationRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClassificationRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCoachInvitationsResponse parses an HTTP response from a ListCoachInvitationsWithResponse call
+func ParseListCoachInvitationsResponse(rsp *http.Response) (*ListCoachInvitationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCoachInvitationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CoachInvitations
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseInviteCoachResponse parses an HTTP response from a InviteCoachWithResponse call
+func ParseInviteCoachResponse(rsp *http.Response) (*InviteCoachResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &InviteCoachResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CoachInvitationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRedeemInviteCodeResponse parses an HTTP response from a RedeemInviteCodeWithResponse call
+func ParseRedeemInviteCodeResponse(rsp *http.Response) (*RedeemInviteCodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RedeemInviteCodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CoachInvitationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAcceptCoachInvitationResponse parses an HTTP response from a AcceptCoachInvitationWithResponse call
+func ParseAcceptCoachInvitationResponse(rsp *http.Response) (*AcceptCoachInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AcceptCoachInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CoachInvitationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeclineCoachInvitationResponse parses an HTTP response from a DeclineCoachInvitationWithResponse call
+func ParseDeclineCoachInvitationResponse(rsp *http.Response) (*DeclineCoachInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeclineCoachInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CoachInvitationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeCoachInvitationResponse parses an HTTP response from a RevokeCoachInvitationWithResponse call
+func ParseRevokeCoachInvitationResponse(rsp *http.Response) (*RevokeCoachInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeCoachInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CoachInvitationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMyAthletesResponse parses an HTTP response from a ListMyAthletesWithResponse call
+func ParseListMyAthletesResponse(rsp *http.Response) (*ListMyAthletesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMyAthletesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CoachAthleteList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAthleteRoundsResponse parses an HTTP response from a ListAthleteRoundsWithResponse call
+func ParseListAthleteRoundsResponse(rsp *http.Response) (*ListAthleteRoundsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAthleteRoundsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []QualificationRound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCoachAthletesResponse parses an HTTP response from a ListCoachAthletesWithResponse call
+func ParseListCoachAthletesResponse(rsp *http.Response) (*ListCoachAthletesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCoachAthletesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CoachAthleteList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAddAthleteResponse parses an HTTP response from a AddAthleteWithResponse call
+func ParseAddAthleteResponse(rsp *http.Response) (*AddAthleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AddAthleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CoachAthlete
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRemoveAthleteResponse parses an HTTP response from a RemoveAthleteWithResponse call
+func ParseRemoveAthleteResponse(rsp *http.Response) (*RemoveAthleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RemoveAthleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCommentResponse parses an HTTP response from a DeleteCommentWithResponse call
+func ParseDeleteCommentResponse(rsp *http.Response) (*DeleteCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListHandicapsResponse parses an HTTP response from a ListHandicapsWithResponse call
+func ParseListHandicapsResponse(rsp *http.Response) (*ListHandicapsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListHandicapsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ArcherHandicap
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHandicapPredictionsResponse parses an HTTP response from a GetHandicapPredictionsWithResponse call
+func ParseGetHandicapPredictionsResponse(rsp *http.Response) (*GetHandicapPredictionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHandicapPredictionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HandicapPredictions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRoundTemplatesResponse parses an HTTP response from a ListRoundTemplatesWithResponse call
+func ParseListRoundTemplatesResponse(rsp *http.Response) (*ListRoundTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRoundTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RoundTemplate
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRoundsResponse parses an HTTP response from a ListRoundsWithResponse call
+func ParseListRoundsResponse(rsp *http.Response) (*ListRoundsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRoundsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []QualificationRound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRoundResponse parses an HTTP response from a CreateRoundWithResponse call
+func ParseCreateRoundResponse(rsp *http.Response) (*CreateRoundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRoundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest QualificationRound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRoundResponse parses an HTTP response from a GetRoundWithResponse call
+func ParseGetRoundResponse(rsp *http.Response) (*GetRoundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRoundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QualificationRound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompleteRoundResponse parses an HTTP response from a CompleteRoundWithResponse call
+func ParseCompleteRoundResponse(rsp *http.Response) (*CompleteRoundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteRoundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QualificationRound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRoundCommentsResponse parses an HTTP response from a ListRoundCommentsWithResponse call
+func ParseListRoundCommentsResponse(rsp *http.Response) (*ListRoundCommentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRoundCommentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CommentList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRoundCommentResponse parses an HTTP response from a CreateRoundCommentWithResponse call
+func ParseCreateRoundCommentResponse(rsp *http.Response) (*CreateRoundCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRoundCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CommentCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSetsResponse parses an HTTP response from a ListSetsWithResponse call
+func ParseListSetsResponse(rsp *http.Response) (*ListSetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Set
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSetResponse parses an HTTP response from a CreateSetWithResponse call
+func ParseCreateSetResponse(rsp *http.Response) (*CreateSetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Set
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSetResponse parses an HTTP response from a GetSetWithResponse call
+func ParseGetSetResponse(rsp *http.Response) (*GetSetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Set
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSetCommentsResponse parses an HTTP response from a ListSetCommentsWithResponse call
+func ParseListSetCommentsResponse(rsp *http.Response) (*ListSetCommentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSetCommentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CommentList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSetCommentResponse parses an HTTP response from a CreateSetCommentWithResponse call
+func ParseCreateSetCommentResponse(rsp *http.Response) (*CreateSetCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSetCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CommentCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListShotsResponse parses an HTTP response from a ListShotsWithResponse call
+func ParseListShotsResponse(rsp *http.Response) (*ListShotsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListShotsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Shot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateShotResponse parses an HTTP response from a CreateShotWithResponse call
+func ParseCreateShotResponse(rsp *http.Response) (*CreateShotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateShotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Shot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateShotsBatchResponse parses an HTTP response from a CreateShotsBatchWithResponse call
+func ParseCreateShotsBatchResponse(rsp *http.Response) (*CreateShotsBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateShotsBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest []Shot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteShotResponse parses an HTTP response from a DeleteShotWithResponse call
+func ParseDeleteShotResponse(rsp *http.Response) (*DeleteShotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteShotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetShotResponse parses an HTTP response from a GetShotWithResponse call
+func ParseGetShotResponse(rsp *http.Response) (*GetShotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetShotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Shot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateShotResponse parses an HTTP response from a UpdateShotWithResponse call
+func ParseUpdateShotResponse(rsp *http.Response) (*UpdateShotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateShotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Shot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListShotCommentsResponse parses an HTTP response from a ListShotCommentsWithResponse call
+func ParseListShotCommentsResponse(rsp *http.Response) (*ListShotCommentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListShotCommentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CommentList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateShotCommentResponse parses an HTTP response from a CreateShotCommentWithResponse call
+func ParseCreateShotCommentResponse(rsp *http.Response) (*CreateShotCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateShotCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CommentCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSignSetResponse parses an HTTP response from a SignSetWithResponse call
+func ParseSignSetResponse(rsp *http.Response) (*SignSetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignSetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ScoreSignature
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSignaturesResponse parses an HTTP response from a ListSignaturesWithResponse call
+func ParseListSignaturesResponse(rsp *http.Response) (*ListSignaturesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSignaturesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ScoreSignature
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSignRoundResponse parses an HTTP response from a SignRoundWithResponse call
+func ParseSignRoundResponse(rsp *http.Response) (*SignRoundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignRoundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ScoreSignature
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyRoundResponse parses an HTTP response from a VerifyRoundWithResponse call
+func ParseVerifyRoundResponse(rsp *http.Response) (*VerifyRoundResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyRoundResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RoundVerification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTargetFacesResponse parses an HTTP response from a ListTargetFacesWithResponse call
+func ParseListTargetFacesResponse(rsp *http.Response) (*ListTargetFacesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTargetFacesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TargetFace
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTargetFaceResponse parses an HTTP response from a CreateTargetFaceWithResponse call
+func ParseCreateTargetFaceResponse(rsp *http.Response) (*CreateTargetFaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTargetFaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TargetFace
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTargetFaceResponse parses an HTTP response from a DeleteTargetFaceWithResponse call
+func ParseDeleteTargetFaceResponse(rsp *http.Response) (*DeleteTargetFaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTargetFaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTargetFaceResponse parses an HTTP response from a GetTargetFaceWithResponse call
+func ParseGetTargetFaceResponse(rsp *http.Response) (*GetTargetFaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTargetFaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TargetFace
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTargetFaceResponse parses an HTTP response from a UpdateTargetFaceWithResponse call
+func ParseUpdateTargetFaceResponse(rsp *http.Response) (*UpdateTargetFaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTargetFaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TargetFace
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTokensResponse parses an HTTP response from a ListTokensWithResponse call
+func ParseListTokensResponse(rsp *http.Response) (*ListTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PersonalAccessToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTokenResponse parses an HTTP response from a CreateTokenWithResponse call
+func ParseCreateTokenResponse(rsp *http.Response) (*CreateTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreatedPersonalAccessToken
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeTokenResponse parses an HTTP response from a RevokeTokenWithResponse call
+func ParseRevokeTokenResponse(rsp *http.Response) (*RevokeTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
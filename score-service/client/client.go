@@ -0,0 +1,28 @@
+// Package client is a typed Go client for the /api routes, generated from the
+// OpenAPI document in internal/api/openapi. Run go generate after changing
+// the document; do not edit client.gen.go by hand.
+//
+//	c, err := client.NewWithToken("http://localhost:1323", token)
+//	res, err := c.ListRoundsWithResponse(ctx)
+//	if res.JSON200 != nil { ... }
+//
+// Error responses are decoded as problem details into
+// ApplicationproblemJSONDefault.
+package client
+
+//go:generate go run ./gen client.gen.go
+
+import (
+	"context"
+	"net/http"
+)
+
+// NewWithToken returns a client that sends token, a JWT or a personal access
+// token, as a bearer token with every request.
+func NewWithToken(server, token string, opts ...ClientOption) (*ClientWithResponses, error) {
+	auth := WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+	return NewClientWithResponses(server, append([]ClientOption{auth}, opts...)...)
+}
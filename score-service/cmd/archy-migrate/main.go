@@ -0,0 +1,336 @@
+// Command archy-migrate manages the score-service schema with the migrations
+// embedded in the binary, so it runs from any directory:
+//
+//	archy-migrate [-config file] <command>
+//
+// See usage for the commands. The database URL comes from the shared
+// configuration (DATABASE_URL or the config file).
+package main
+
+import (
+	"archy/scores/internal/config"
+	"archy/scores/internal/database/migrations"
+	"archy/scores/internal/database/seed"
+	"archy/scores/internal/db"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const usage = `Usage: archy-migrate [-config file] <command>
+
+Commands:
+  up                  apply every pending migration
+  down N              roll back the last N migrations
+  goto V              migrate up or down to version V
+  status              list the migrations and which are applied
+  create [-dir D] NAME
+                      add the next NNN_NAME.up.sql and .down.sql to D
+                      (default internal/database/migrations)
+  force V             record version V as applied and clean, after fixing
+                      a failed migration by hand
+  drop --force        drop every table, type and function in the database
+  backfill            recalculate set and round statistics from the shots
+  seed [flags]        add synthetic archers, rounds, sets and shots; the same
+                      flags, including -until, always give the same data,
+                      so seed an empty database. Flags:
+      -seed N         random seed (default 1)
+      -users N        number of archers (default 10)
+      -rounds N       rounds per archer (default 12)
+      -center-x MM    mean horizontal offset of the arrows (default 0)
+      -center-y MM    mean vertical offset of the arrows (default 0)
+      -spread-x MM    horizontal standard deviation at 70 m (default 60)
+      -spread-y MM    vertical standard deviation at 70 m (default 60)
+      -correlation R  correlation between x and y (default 0)
+      -fatigue F      growth of the spread by the last end (default 0.15)
+      -days N         days before -until the rounds are spread over (default 90)
+      -until DATE     last day of the rounds as YYYY-MM-DD (default today)
+      -prefix P       user ID prefix (default seed-archer-)
+`
+
+// errUsage reports a command line that does not match usage.
+var errUsage = errors.New("invalid command line")
+
+func main() {
+	flags := flag.NewFlagSet("archy-migrate", flag.ExitOnError)
+	flags.Usage = func() { fmt.Fprint(flags.Output(), usage) }
+	configPath := flags.String("config", "", "path to a YAML config file (default $CONFIG_FILE)")
+	flags.Parse(os.Args[1:])
+
+	err := run(*configPath, flags.Args(), os.Stdout)
+	if errors.Is(err, errUsage) {
+		fmt.Fprintf(os.Stderr, "archy-migrate: %v\n\n%s", err, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "archy-migrate: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(configPath string, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%w: missing command", errUsage)
+	}
+	cmd, args := args[0], args[1:]
+
+	// create works on the source tree and needs no database.
+	if cmd == "create" {
+		return create(args, out)
+	}
+
+	var force bool
+	var seedOpts seed.Options
+	switch cmd {
+	case "up", "status", "backfill":
+		if len(args) != 0 {
+			return fmt.Errorf("%w: %s takes no arguments", errUsage, cmd)
+		}
+	case "down", "goto", "force":
+		if len(args) != 1 {
+			return fmt.Errorf("%w: %s takes one number", errUsage, cmd)
+		}
+	case "drop":
+		flags := flag.NewFlagSet("drop", flag.ContinueOnError)
+		flags.SetOutput(io.Discard)
+		flags.BoolVar(&force, "force", false, "")
+		if err := flags.Parse(args); err != nil || flags.NArg() != 0 {
+			return fmt.Errorf("%w: drop takes only --force", errUsage)
+		}
+		if !force {
+			return errors.New("drop deletes every table and all data; run drop --force to confirm")
+		}
+	case "seed":
+		var err error
+		if seedOpts, err = seedOptions(args); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("%w: unknown command %q", errUsage, cmd)
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+	dbURL := cfg.Database.URL
+
+	if cmd == "backfill" {
+		rounds, err := backfillStatistics(context.Background(), dbURL)
+		if err != nil {
+			return fmt.Errorf("backfill failed: %w", err)
+		}
+		fmt.Fprintf(out, "✅ Recalculated statistics for %d round(s)\n", rounds)
+		return nil
+	}
+
+	if cmd == "seed" {
+		rounds, err := seedDatabase(context.Background(), dbURL, seedOpts)
+		if err != nil {
+			return fmt.Errorf("seed failed after %d round(s): %w", rounds, err)
+		}
+		fmt.Fprintf(out, "✅ Seeded %d round(s) for %d user(s)\n", rounds, seedOpts.Users)
+		return nil
+	}
+
+	m, err := migrations.New(dbURL)
+	if err != nil {
+		return fmt.Errorf("failed to create migrate instance: %w", err)
+	}
+	defer m.Close()
+
+	switch cmd {
+	case "up":
+		if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return fmt.Errorf("migration up failed: %w", err)
+		}
+		fmt.Fprintln(out, "✅ Migrations applied successfully")
+
+	case "down":
+		steps, err := strconv.Atoi(args[0])
+		if err != nil || steps < 1 {
+			return fmt.Errorf("%w: down takes a positive number of migrations, got %q", errUsage, args[0])
+		}
+		if err := m.Steps(-steps); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return fmt.Errorf("migration down failed: %w", err)
+		}
+		fmt.Fprintf(out, "✅ Rolled back %d migration(s)\n", steps)
+
+	case "goto":
+		version, err := strconv.ParseUint(args[0], 10, 0)
+		if err != nil {
+			return fmt.Errorf("%w: goto takes a version, got %q", errUsage, args[0])
+		}
+		if err := m.Migrate(uint(version)); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			return fmt.Errorf("migration to version %d failed: %w", version, err)
+		}
+		fmt.Fprintf(out, "✅ Migrated to version %d\n", version)
+
+	case "status":
+		return status(m, out)
+
+	case "force":
+		version, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("%w: force takes a version, got %q", errUsage, args[0])
+		}
+		if err := m.Force(version); err != nil {
+			return fmt.Errorf("force version failed: %w", err)
+		}
+		fmt.Fprintf(out, "✅ Forced migration to version %d\n", version)
+
+	case "drop":
+		if err := m.Drop(); err != nil {
+			return fmt.Errorf("drop failed: %w", err)
+		}
+		fmt.Fprintln(out, "✅ Database dropped")
+	}
+	return nil
+}
+
+// status prints every embedded migration and whether it is applied.
+func status(m *migrate.Migrate, out io.Writer) error {
+	list, err := migrations.List()
+	if err != nil {
+		return err
+	}
+	current, dirty, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return fmt.Errorf("failed to get version: %w", err)
+	}
+
+	for _, mig := range list {
+		state := "pending"
+		switch {
+		case mig.Version == current && dirty:
+			state = "failed, fix it and run force"
+		case mig.Version <= current:
+			state = "applied"
+		}
+		fmt.Fprintf(out, "%03d  %-32s %s\n", mig.Version, mig.Name, state)
+	}
+	if len(list) > 0 && current > list[len(list)-1].Version {
+		fmt.Fprintf(out, "Version %d is newer than this binary's migrations\n", current)
+	}
+	return nil
+}
+
+var migrationName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// create adds empty up and down files for the next migration to the source
+// tree; they are embedded on the next build.
+func create(args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("create", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	dir := flags.String("dir", filepath.Join("internal", "database", "migrations"), "")
+	if err := flags.Parse(args); err != nil || flags.NArg() != 1 {
+		return fmt.Errorf("%w: create takes a name", errUsage)
+	}
+	name := flags.Arg(0)
+	if !migrationName.MatchString(name) {
+		return fmt.Errorf("%w: name must be lower case letters, digits and underscores, got %q", errUsage, name)
+	}
+
+	entries, err := os.ReadDir(*dir)
+	if err != nil {
+		return fmt.Errorf("migrations directory: %w", err)
+	}
+	var next uint = 1
+	for _, entry := range entries {
+		if m, err := source.Parse(entry.Name()); err == nil && m.Version >= next {
+			next = m.Version + 1
+		}
+	}
+
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(*dir, fmt.Sprintf("%03d_%s.%s.sql", next, name, direction))
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "✅ Created %s\n", path)
+	}
+	return nil
+}
+
+// seedOptions parses the seed flags over the defaults.
+func seedOptions(args []string) (seed.Options, error) {
+	opts := seed.DefaultOptions()
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	flags.Int64Var(&opts.Seed, "seed", opts.Seed, "")
+	flags.IntVar(&opts.Users, "users", opts.Users, "")
+	flags.IntVar(&opts.Rounds, "rounds", opts.Rounds, "")
+	flags.Float64Var(&opts.CenterX, "center-x", opts.CenterX, "")
+	flags.Float64Var(&opts.CenterY, "center-y", opts.CenterY, "")
+	flags.Float64Var(&opts.SpreadX, "spread-x", opts.SpreadX, "")
+	flags.Float64Var(&opts.SpreadY, "spread-y", opts.SpreadY, "")
+	flags.Float64Var(&opts.Correlation, "correlation", opts.Correlation, "")
+	flags.Float64Var(&opts.Fatigue, "fatigue", opts.Fatigue, "")
+	flags.IntVar(&opts.Days, "days", opts.Days, "")
+	flags.Func("until", "", func(v string) error {
+		until, err := time.Parse(time.DateOnly, v)
+		opts.Until = until
+		return err
+	})
+	flags.StringVar(&opts.UserPrefix, "prefix", opts.UserPrefix, "")
+	if err := flags.Parse(args); err != nil || flags.NArg() != 0 {
+		return opts, fmt.Errorf("%w: seed takes only flags", errUsage)
+	}
+	if err := opts.Validate(); err != nil {
+		return opts, fmt.Errorf("%w: %v", errUsage, err)
+	}
+	return opts, nil
+}
+
+// seedDatabase inserts the synthetic dataset and returns how many rounds were
+// written.
+func seedDatabase(ctx context.Context, dbURL string, opts seed.Options) (int, error) {
+	pool, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return 0, err
+	}
+	defer pool.Close()
+
+	return seed.Generate(ctx, pool, opts)
+}
+
+// backfillStatistics recalculates the set and round statistics of every
+// round from its shots, one round per statement, and returns the rounds
+// processed. The scores of the shots are left as they were recorded.
+func backfillStatistics(ctx context.Context, dbURL string) (int, error) {
+	pool, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		return 0, err
+	}
+	defer pool.Close()
+
+	queries := db.New(pool)
+	roundIDs, err := queries.ListQualificationRoundIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, roundID := range roundIDs {
+		if err := queries.RefreshRoundStatistics(ctx, pgtype.UUID{Bytes: roundID, Valid: true}); err != nil {
+			return i, fmt.Errorf("round %s: %w", roundID, err)
+		}
+	}
+
+	return len(roundIDs), nil
+}
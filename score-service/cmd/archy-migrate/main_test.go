@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRun_RejectsInvalidCommandLines(t *testing.T) {
+	for _, args := range [][]string{
+		nil,
+		{"sideways"},
+		{"up", "now"},
+		{"down"},
+		{"goto", "1", "2"},
+		{"drop", "--yes"},
+		{"create"},
+		{"create", "Add Matches"},
+	} {
+		if err := run("", args, &bytes.Buffer{}); !errors.Is(err, errUsage) {
+			t.Errorf("run(%q) = %v, want a usage error", args, err)
+		}
+	}
+}
+
+func TestRun_DropRequiresForce(t *testing.T) {
+	err := run("", []string{"drop"}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "drop --force") {
+		t.Errorf("expected drop to ask for --force, got %v", err)
+	}
+}
+
+func TestRun_Create(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"001_initial.up.sql", "001_initial.down.sql", "011_score_signatures.up.sql", "README"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := run("", []string{"create", "-dir", dir, "matches"}, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	for _, want := range []string{"012_matches.up.sql", "012_matches.down.sql"} {
+		if !slices.Contains(names, want) || !strings.Contains(out.String(), want) {
+			t.Errorf("expected %s to be created, got %v:\n%s", want, names, out.String())
+		}
+	}
+
+	// The next migration with the same name gets its own number.
+	if err := run("", []string{"create", "-dir", dir, "matches"}, &out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "013_matches.up.sql")); err != nil {
+		t.Errorf("expected 013_matches.up.sql: %v", err)
+	}
+}
+
+func TestSeedOptions(t *testing.T) {
+	opts, err := seedOptions([]string{"-seed", "7", "-users", "3", "-spread-x", "40", "-correlation", "-0.3", "-until", "2025-06-30"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	until := time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC)
+	if opts.Seed != 7 || opts.Users != 3 || opts.SpreadX != 40 || opts.Correlation != -0.3 || !opts.Until.Equal(until) {
+		t.Errorf("flags not applied: %+v", opts)
+	}
+	if opts.Rounds != 12 || opts.SpreadY != 60 || opts.UserPrefix != "seed-archer-" {
+		t.Errorf("expected the defaults for the other options: %+v", opts)
+	}
+
+	for _, args := range [][]string{
+		{"extra"},
+		{"-users", "0"},
+		{"-correlation", "1"},
+		{"-spread", "40"},
+		{"-until", "30/06/2025"},
+	} {
+		if _, err := seedOptions(args); !errors.Is(err, errUsage) {
+			t.Errorf("seedOptions(%q) = %v, want a usage error", args, err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"archy/scores/internal/api/handlers"
+	"archy/scores/internal/database/migrations"
+	"archy/scores/jwt"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// readinessChecks lists the dependencies the server needs to serve requests.
+func readinessChecks(
+	pool *pgxpool.Pool,
+	schema *schemaVersion,
+	verifier *jwt.JWKVerifier,
+	jwksMaxAge time.Duration,
+) []handlers.HealthCheck {
+	return []handlers.HealthCheck{
+		{Name: "database", Check: pool.Ping},
+		{Name: "migrations", Check: schema.Check},
+		{Name: "jwks", Check: func(context.Context) error { return checkJWKS(verifier, jwksMaxAge) }},
+	}
+}
+
+// checkJWKS fails when the auth-service's key set was never fetched or is
+// older than maxAge. Offline mode uses static keys only and is always fresh.
+func checkJWKS(verifier *jwt.JWKVerifier, maxAge time.Duration) error {
+	fetchedAt, remote := verifier.KeysFetchedAt()
+	if !remote {
+		return nil
+	}
+	if fetchedAt.IsZero() {
+		return errors.New("auth-service key set not fetched yet")
+	}
+	if age := time.Since(fetchedAt); age > maxAge {
+		return fmt.Errorf("auth-service key set is %s old", age.Round(time.Second))
+	}
+	return nil
+}
+
+// schemaVersion checks the applied migrations with golang-migrate. It keeps
+// its own connection, opened on first use, so that a database that is down
+// at startup does not prevent the check from recovering.
+type schemaVersion struct {
+	url string
+	// latest is the last migration embedded in the binary.
+	latest uint
+	// version reads the applied version; driverVersion outside tests.
+	version func() (int, bool, error)
+
+	// busy holds a token while a check talks to the database. A check that
+	// hangs keeps it, so later checks give up with their context instead of
+	// queueing behind it.
+	busy   chan struct{}
+	driver database.Driver
+}
+
+func newSchemaVersion(databaseURL string) (*schemaVersion, error) {
+	url, err := migrations.DriverURL(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	list, err := migrations.List()
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.New("no migrations embedded")
+	}
+	s := &schemaVersion{url: url, latest: list[len(list)-1].Version, busy: make(chan struct{}, 1)}
+	s.version = s.driverVersion
+	return s, nil
+}
+
+// Check fails unless the migrations of this binary were applied and the last
+// one succeeded. A schema ahead of the binary is accepted, so that instances
+// of the previous release stay ready while a new one migrates.
+func (s *schemaVersion) Check(ctx context.Context) error {
+	select {
+	case s.busy <- struct{}{}:
+	case <-ctx.Done():
+		return fmt.Errorf("previous check still running: %w", ctx.Err())
+	}
+
+	type result struct {
+		version int
+		dirty   bool
+		err     error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer func() { <-s.busy }()
+		version, dirty, err := s.version()
+		done <- result{version, dirty, err}
+	}()
+
+	var r result
+	select {
+	case r = <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if r.err != nil {
+		return r.err
+	}
+	if r.version == database.NilVersion {
+		return errors.New("no migrations applied")
+	}
+	if r.dirty {
+		return fmt.Errorf("migration %d failed and left the schema dirty", r.version)
+	}
+	if uint(r.version) < s.latest {
+		return fmt.Errorf("schema is at migration %d, want %d", r.version, s.latest)
+	}
+	return nil
+}
+
+// driverVersion reads the applied version, connecting first if needed. It is
+// only called while holding busy.
+func (s *schemaVersion) driverVersion() (int, bool, error) {
+	if s.driver == nil {
+		driver, err := database.Open(s.url)
+		if err != nil {
+			return 0, false, err
+		}
+		s.driver = driver
+	}
+
+	version, dirty, err := s.driver.Version()
+	if err != nil {
+		// Reconnect on the next check.
+		s.driver.Close()
+		s.driver = nil
+	}
+	return version, dirty, err
+}
+
+// Close closes the connection unless a check is still using it, which only
+// happens when the database hangs; the process is exiting either way.
+func (s *schemaVersion) Close() error {
+	select {
+	case s.busy <- struct{}{}:
+	default:
+		return nil
+	}
+	defer func() { <-s.busy }()
+
+	if s.driver == nil {
+		return nil
+	}
+	err := s.driver.Close()
+	s.driver = nil
+	return err
+}
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"archy/scores/client"
+	"archy/scores/internal/api/openapi"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/db"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// loadSpec loads the 3.0 rendering of the OpenAPI document, which is what
+// kin-openapi can validate against.
+func loadSpec(t *testing.T) (*openapi3.T, routers.Router) {
+	t.Helper()
+
+	data, err := openapi.JSON30()
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		t.Fatalf("failed to load the OpenAPI document: %v", err)
+	}
+	router, err := legacy.NewRouter(doc)
+	if err != nil {
+		t.Fatalf("failed to route the OpenAPI document: %v", err)
+	}
+	return doc, router
+}
+
+func TestOpenAPI_Served(t *testing.T) {
+	e, _ := newTestServer(t)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 without authentication, got %d", rec.Code)
+	}
+	var doc struct {
+		OpenAPI string `json:"openapi"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil || doc.OpenAPI != "3.1.0" {
+		t.Errorf("expected an OpenAPI 3.1 document, got %.100s", rec.Body.String())
+	}
+}
+
+func TestOpenAPI_DescribesEveryRoute(t *testing.T) {
+	e, _ := newTestServer(t)
+	doc, _ := loadSpec(t)
+
+	described := make(map[string]bool)
+	for path, item := range doc.Paths.Map() {
+		for method := range item.Operations() {
+			described[method+" "+path] = true
+		}
+	}
+
+	for _, route := range e.Routes() {
+		// Groups register catch-all routes that only answer 404.
+		if !strings.HasPrefix(route.Path, "/api/") || route.Method == echo.RouteNotFound {
+			continue
+		}
+		path := pathParam.ReplaceAllStringFunc(route.Path, func(p string) string { return "{" + p[1:] + "}" })
+		key := route.Method + " " + path
+		if !described[key] {
+			t.Errorf("%s is not described in openapi.yaml", key)
+		}
+		delete(described, key)
+	}
+	for key := range described {
+		t.Errorf("%s is described in openapi.yaml but not registered", key)
+	}
+}
+
+func TestOpenAPI_ResponsesMatchSpec(t *testing.T) {
+	e, key := newTestServer(t)
+	_, router := loadSpec(t)
+	token := signTestToken(t, key, "user-1")
+	id := "00000000-0000-0000-0000-000000000001"
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		status int
+	}{
+		{http.MethodGet, "/api/rounds", "", http.StatusOK},
+		{http.MethodGet, "/api/rounds/" + id, "", http.StatusNotFound},
+		{http.MethodPost, "/api/rounds", `{"round_type": "tournament"}`, http.StatusBadRequest},
+		{http.MethodGet, "/api/rounds/" + id + "/sets", "", http.StatusNotFound},
+		{http.MethodGet, "/api/rounds/" + id + "/verification", "", http.StatusNotFound},
+		{http.MethodGet, "/api/analysis/fatigue", "", http.StatusOK},
+		{http.MethodGet, "/api/handicaps", "", http.StatusOK},
+		{http.MethodGet, "/api/handicaps/predictions?handicap=40", "", http.StatusOK},
+		{http.MethodGet, "/api/round-templates", "", http.StatusOK},
+		{http.MethodGet, "/api/classifications", "", http.StatusOK},
+		{http.MethodGet, "/api/classifications/rules", "", http.StatusOK},
+		{http.MethodGet, "/api/target-faces", "", http.StatusOK},
+		{http.MethodPost, "/api/target-faces", `{}`, http.StatusForbidden},
+		{http.MethodGet, "/api/coach/athletes", "", http.StatusOK},
+		{http.MethodGet, "/api/athlete/coaches", "", http.StatusOK},
+		{http.MethodGet, "/api/coach-invitations", "", http.StatusOK},
+		{http.MethodGet, "/api/tokens", "", http.StatusOK},
+		{http.MethodGet, "/api/audit-log", "", http.StatusOK},
+		{http.MethodGet, "/api/audit-log?entity_type=match", "", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+token)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.status {
+				t.Fatalf("expected %d, got %d: %s", tt.status, rec.Code, rec.Body.String())
+			}
+
+			route, params, err := router.FindRoute(req)
+			if err != nil {
+				t.Fatalf("no route in the OpenAPI document: %v", err)
+			}
+			input := &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{
+					Request:    req,
+					PathParams: params,
+					Route:      route,
+				},
+				Status: rec.Code,
+				Header: rec.Header(),
+				Body:   io.NopCloser(bytes.NewReader(rec.Body.Bytes())),
+			}
+			if err := openapi3filter.ValidateResponse(context.Background(), input); err != nil {
+				t.Errorf("response does not match the OpenAPI document: %v\n%s", err, rec.Body.String())
+			}
+		})
+	}
+}
+
+// The routes above only return empty lists, so the row types are checked
+// against their schemas directly, both with every nullable column null and
+// with every column set.
+func TestOpenAPI_SchemasMatchModels(t *testing.T) {
+	doc, _ := loadSpec(t)
+
+	now := time.Now()
+	id := uuid.New()
+	number := func(f float64) pgtype.Numeric {
+		var n pgtype.Numeric
+		_ = n.Scan(f)
+		return n
+	}
+	text := pgtype.Text{String: "text", Valid: true}
+	pgID := pgtype.UUID{Bytes: id, Valid: true}
+	at := pgtype.Timestamptz{Time: now, Valid: true}
+	signature := db.ScoreSignature{ID: id, RoundID: id, SetID: pgID, SignerRole: models.SignerJudge, Reason: text, SignedAt: now}
+
+	tests := []struct {
+		schema string
+		value  any
+	}{
+		{"QualificationRound", db.QualificationRound{}},
+		{"QualificationRound", db.QualificationRound{ID: id, AverageScore: number(9.25), StartTime: at, EndTime: at,
+			Notes: text, CompetitionID: pgID, DeletedAt: at, RoundTemplateID: pgID, Handicap: pgtype.Int4{Int32: 40, Valid: true},
+			Gender: text}},
+		{"Set", db.Set{}},
+		{"Set", db.Set{AverageScore: number(8.5), GroupingDiameter: number(41.2), GroupingCenterX: number(-3),
+			GroupingCenterY: number(2.75), ParentRoundID: pgID, ParentMatchID: pgID, DeletedAt: at}},
+		{"Shot", db.Shot{}},
+		{"Shot", db.Shot{X: number(-12.5), Y: number(3), DistanceFromCenter: number(12.85), Notes: text, DeletedAt: at}},
+		{"TargetFace", db.TargetFace{ZonesConfig: []byte(`[{"score":10,"radius":20}]`), Description: text}},
+		{"Comment", db.Comment{SetID: pgID, ShotID: pgID}},
+		{"CoachAthlete", db.CoachAthlete{GrantedBy: "admin"}},
+		{"CoachInvitation", db.CoachInvitation{Status: "pending"}},
+		{"CoachInvitation", db.CoachInvitation{Status: "accepted", CoachUserID: text, InviteCode: text, RespondedAt: at}},
+		{"CoachInvitations", models.CoachInvitationsResponse{Sent: []db.CoachInvitation{}, Received: []db.CoachInvitation{}}},
+		{"ScoreSignature", db.ScoreSignature{}},
+		{"RoundVerification", models.RoundVerificationResponse{
+			Sets:       []models.SetVerification{{SetID: id, SetNumber: 1, ShotCount: 6, Hash: "abc"}},
+			Signatures: []models.SignatureVerification{{ScoreSignature: signature, Valid: true}},
+		}},
+		{"ArcherHandicap", db.ArcherHandicap{}},
+		{"HandicapPredictions", models.HandicapPredictionsResponse{Predictions: []models.ScorePrediction{{}}}},
+		{"RoundTemplate", db.RoundTemplate{Description: text}},
+		{"ArcherClassification", db.ArcherClassification{}},
+		{"ClassificationRule", db.ClassificationRule{}},
+		{"FatigueAnalysis", models.FatigueAnalysisResponse{
+			BySetNumber:   []models.FatigueBucket{{Position: 1}},
+			ByArrowNumber: []models.FatigueBucket{},
+			Trends:        []models.FatigueTrend{{Dimension: "set_number", Metric: "score"}},
+		}},
+		{"PersonalAccessToken", models.PersonalAccessTokenResponse{Scopes: []string{}, ExpiresAt: &now}},
+		{"CreatedPersonalAccessToken", models.CreatedPersonalAccessTokenResponse{
+			PersonalAccessTokenResponse: models.PersonalAccessTokenResponse{Scopes: []string{"scores:read"}},
+			Token:                       "archy_pat_secret",
+		}},
+		{"AuditLogEntry", db.AuditLog{Action: "create", AfterData: json.RawMessage(`{"id":1}`)}},
+		{"AuditLogEntry", db.AuditLog{Action: "update", TokenID: text, RequestID: text, OwnerUserID: text,
+			BeforeData: json.RawMessage(`{"score":9}`), AfterData: json.RawMessage(`{"score":10}`), Reason: text}},
+	}
+
+	for _, tt := range tests {
+		schema, ok := doc.Components.Schemas[tt.schema]
+		if !ok {
+			t.Errorf("no schema %s", tt.schema)
+			continue
+		}
+
+		data, err := json.Marshal(tt.value)
+		if err != nil {
+			t.Fatalf("failed to encode %T: %v", tt.value, err)
+		}
+		var value any
+		if err := json.Unmarshal(data, &value); err != nil {
+			t.Fatalf("failed to decode %T: %v", tt.value, err)
+		}
+		if err := schema.Value.VisitJSON(value); err != nil {
+			t.Errorf("%T does not match schema %s: %v\n%s", tt.value, tt.schema, err, data)
+		}
+	}
+}
+
+func TestOpenAPI_GeneratedClient(t *testing.T) {
+	e, key := newTestServer(t)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+
+	c, err := client.NewWithToken(srv.URL, signTestToken(t, key, "user-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	faces, err := c.ListTargetFacesWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if faces.StatusCode() != http.StatusOK || faces.JSON200 == nil || len(*faces.JSON200) != 0 {
+		t.Errorf("expected no target faces, got %d: %s", faces.StatusCode(), faces.Body)
+	}
+
+	round, err := c.GetRoundWithResponse(ctx, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if round.StatusCode() != http.StatusNotFound || round.ApplicationproblemJSONDefault == nil || round.ApplicationproblemJSONDefault.Status != http.StatusNotFound {
+		t.Errorf("expected a not found problem, got %d: %s", round.StatusCode(), round.Body)
+	}
+
+	created, err := c.CreateRoundWithResponse(ctx, client.CreateRoundJSONRequestBody{RoundType: "tournament"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := created.ApplicationproblemJSONDefault; p == nil || p.Errors == nil || len(*p.Errors) == 0 {
+		t.Errorf("expected field errors, got %d: %s", created.StatusCode(), created.Body)
+	}
+}
@@ -0,0 +1,547 @@
+package main
+
+import (
+	"archy/scores/internal/api/handlers"
+	"archy/scores/internal/config"
+	"archy/scores/internal/core/logging"
+	"archy/scores/internal/core/ratelimit"
+	"archy/scores/internal/db"
+	"archy/scores/jwt"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/labstack/echo/v4"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	jwtx "github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// newTestKeys generates an Ed25519 key like the auth-service's better-auth jwt
+// plugin uses, and returns it with the public key set to verify it.
+func newTestKeys(t *testing.T) (jwk.Key, jwk.Set) {
+	t.Helper()
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	key, err := jwk.FromRaw(priv)
+	if err != nil {
+		t.Fatalf("failed to wrap key: %v", err)
+	}
+	_ = key.Set(jwk.KeyIDKey, "test-key")
+	_ = key.Set(jwk.AlgorithmKey, jwa.EdDSA)
+
+	pub, err := jwk.PublicKeyOf(key)
+	if err != nil {
+		t.Fatalf("failed to derive public key: %v", err)
+	}
+	set := jwk.NewSet()
+	_ = set.AddKey(pub)
+
+	return key, set
+}
+
+func signTestToken(t *testing.T, key jwk.Key, subject string) string {
+	t.Helper()
+	return signTestTokenWithClaims(t, key, subject, nil)
+}
+
+func signTestTokenWithClaims(t *testing.T, key jwk.Key, subject string, claims map[string]any) string {
+	t.Helper()
+
+	builder := jwtx.NewBuilder().
+		Subject(subject).
+		IssuedAt(time.Now()).
+		Expiration(time.Now().Add(time.Hour))
+	for name, value := range claims {
+		builder = builder.Claim(name, value)
+	}
+	token, err := builder.Build()
+	if err != nil {
+		t.Fatalf("failed to build token: %v", err)
+	}
+	signed, err := jwtx.Sign(token, jwtx.WithKey(jwa.EdDSA, key))
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return string(signed)
+}
+
+func newTestServer(t *testing.T) (*echo.Echo, jwk.Key) {
+	t.Helper()
+
+	// Tokens are minted locally; no auth-service is involved.
+	key, set := newTestKeys(t)
+	verifier := jwt.NewJWKVerifier("http://auth.invalid", jwt.WithStaticKeys(set), jwt.WithRemoteKeys(false))
+
+	e := echo.New()
+	registerRoutes(e, db.New(emptyDB{}), verifier, nil, nil)
+	return e, key
+}
+
+// emptyDB is a database without any rows, e.g. no locally granted roles.
+type emptyDB struct{}
+
+func (emptyDB) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+
+func (emptyDB) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	return &emptyRows{}, nil
+}
+
+func (emptyDB) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	return emptyRow{}
+}
+
+// failingDB fails every query, like a database that went away.
+type failingDB struct{ emptyDB }
+
+var errDatabaseDown = errors.New("dial tcp db.internal:5432: connection refused")
+
+func (failingDB) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	return nil, errDatabaseDown
+}
+
+type emptyRow struct{}
+
+func (emptyRow) Scan(...any) error { return pgx.ErrNoRows }
+
+type emptyRows struct{}
+
+func (*emptyRows) Close()                                       {}
+func (*emptyRows) Err() error                                   { return nil }
+func (*emptyRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (*emptyRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (*emptyRows) Next() bool                                   { return false }
+func (*emptyRows) Scan(...any) error                            { return pgx.ErrNoRows }
+func (*emptyRows) Values() ([]any, error)                       { return nil, pgx.ErrNoRows }
+func (*emptyRows) RawValues() [][]byte                          { return nil }
+func (*emptyRows) Conn() *pgx.Conn                              { return nil }
+
+var pathParam = regexp.MustCompile(`:[A-Za-z]+`)
+
+func TestAPIRoutes_RequireAuthentication(t *testing.T) {
+	e, _ := newTestServer(t)
+
+	tested := 0
+	for _, route := range e.Routes() {
+		if !strings.HasPrefix(route.Path, "/api/") || strings.Contains(route.Path, "*") {
+			continue
+		}
+		path := pathParam.ReplaceAllString(route.Path, "00000000-0000-0000-0000-000000000000")
+
+		for name, header := range map[string]string{
+			"missing token":          "",
+			"invalid token":          "Bearer invalid-token-12345",
+			"unknown personal token": "Bearer archy_pat_unknown",
+		} {
+			req := httptest.NewRequest(route.Method, path, nil)
+			if header != "" {
+				req.Header.Set("Authorization", header)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("%s %s with %s: expected 401, got %d", route.Method, route.Path, name, rec.Code)
+				continue
+			}
+			// The handlers' own "User not authenticated" fallback must not be what rejected the request.
+			var body map[string]any
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body["detail"] == "" ||
+				body["detail"] == "User not authenticated" {
+				t.Errorf("%s %s with %s: expected a rejection from the JWT middleware, got %s",
+					route.Method, route.Path, name, rec.Body.String())
+			}
+		}
+		tested++
+	}
+
+	if tested == 0 {
+		t.Fatal("no /api routes registered")
+	}
+}
+
+func TestAPIRoutes_AcceptValidToken(t *testing.T) {
+	e, key := newTestServer(t)
+
+	// An invalid round ID is rejected by the handler before any database access,
+	// so a 400 proves the request made it through the middleware.
+	req := httptest.NewRequest(http.MethodGet, "/api/rounds/not-a-uuid", nil)
+	req.Header.Set("Authorization", "Bearer "+signTestToken(t, key, "user-1"))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 from the handler, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestPublicRoot_NoAuthentication(t *testing.T) {
+	e, _ := newTestServer(t)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("expected 200, got %d", rec.Code)
+	}
+}
+
+func TestScopedRoutes_Authorization(t *testing.T) {
+	e, key := newTestServer(t)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		claims map[string]any
+		want   int
+	}{
+		{"target face without scope", http.MethodPost, "/api/target-faces", nil, http.StatusForbidden},
+		{"target face as coach", http.MethodPost, "/api/target-faces",
+			map[string]any{"roles": []string{"coach"}}, http.StatusForbidden},
+		// The empty body fails validation, which proves the request was authorized.
+		{"target face with scope", http.MethodPost, "/api/target-faces",
+			map[string]any{"scope": "targetfaces:write"}, http.StatusBadRequest},
+		{"target face as admin", http.MethodPut, "/api/target-faces/00000000-0000-0000-0000-000000000000",
+			map[string]any{"role": "user,admin"}, http.StatusBadRequest},
+		{"delete target face without scope", http.MethodDelete, "/api/target-faces/00000000-0000-0000-0000-000000000000",
+			map[string]any{"scope": "rounds:read"}, http.StatusForbidden},
+		{"list target faces needs no scope", http.MethodGet, "/api/target-faces", nil, http.StatusOK},
+		{"assign athlete without scope", http.MethodPost, "/api/coaches/coach-1/athletes", nil, http.StatusForbidden},
+		{"assign athlete as admin", http.MethodPost, "/api/coaches/coach-1/athletes",
+			map[string]any{"roles": []string{"admin"}}, http.StatusBadRequest},
+		{"round of another archer", http.MethodGet, "/api/rounds/00000000-0000-0000-0000-000000000000", nil, http.StatusNotFound},
+		{"audit log of own data", http.MethodGet, "/api/audit-log", nil, http.StatusOK},
+		{"audit log of own data by entity", http.MethodGet, "/api/audit-log?entity_type=shot&entity_id=00000000-0000-0000-0000-000000000000", nil, http.StatusOK},
+		{"audit log with unknown entity type", http.MethodGet, "/api/audit-log?entity_type=match", nil, http.StatusBadRequest},
+		{"audit log with invalid limit", http.MethodGet, "/api/audit-log?limit=-1", nil, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader("{}"))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+signTestTokenWithClaims(t, key, "user-1", tt.claims))
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("expected %d, got %d: %s", tt.want, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestCoachRoutes_RejectInvalidRequests(t *testing.T) {
+	e, key := newTestServer(t)
+
+	tests := []struct {
+		name string
+		path string
+		body string
+	}{
+		{"self invitation", "/api/coach-invitations", `{"coach_user_id": "user-1"}`},
+		{"redeem without code", "/api/coach-invitations/redeem", `{}`},
+		{"accept invalid id", "/api/coach-invitations/not-a-uuid/accept", `{}`},
+		{"empty comment", "/api/rounds/00000000-0000-0000-0000-000000000000/comments", `{"body": "  "}`},
+		{"shot comment with invalid shot id", "/api/rounds/00000000-0000-0000-0000-000000000000/sets/00000000-0000-0000-0000-000000000000/shots/x/comments", `{"body": "nice"}`},
+		{"signature with unknown role", "/api/rounds/00000000-0000-0000-0000-000000000000/signatures", `{"role": "captain"}`},
+		{"judge signature without reason", "/api/rounds/00000000-0000-0000-0000-000000000000/sets/00000000-0000-0000-0000-000000000000/signatures", `{"role": "judge"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+signTestToken(t, key, "user-1"))
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestErrorResponses_ProblemDetails(t *testing.T) {
+	e, key := newTestServer(t)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+		detail string
+		field  string
+	}{
+		{"invalid field", http.MethodPost, "/api/coach-invitations", `{"coach_user_id": "user-1"}`,
+			http.StatusBadRequest, "you cannot invite yourself as coach", "coach_user_id"},
+		{"invalid request", http.MethodPost, "/api/rounds",
+			`{"round_type": "tournament", "name": "WA 720", "distance": 70, "total_sets": 12, "shots_per_set": 6,
+			  "target_face_id": "00000000-0000-0000-0000-000000000001"}`,
+			http.StatusBadRequest, "round_type must be one of training, qualification, practice, warmup", "round_type"},
+		{"not found", http.MethodGet, "/api/rounds/00000000-0000-0000-0000-000000000000", "",
+			http.StatusNotFound, "not found", ""},
+		{"unknown route", http.MethodGet, "/api/nowhere", "", http.StatusNotFound, "Not Found", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+signTestToken(t, key, "user-1"))
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if ct := rec.Header().Get(echo.HeaderContentType); ct != handlers.MIMEProblemJSON {
+				t.Errorf("expected %s, got %s", handlers.MIMEProblemJSON, ct)
+			}
+			var p handlers.Problem
+			if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+				t.Fatalf("invalid response body: %v", err)
+			}
+			if rec.Code != tt.status || p.Status != tt.status || p.Title != http.StatusText(tt.status) ||
+				p.Detail != tt.detail || p.Instance != tt.path || p.RequestID == "" {
+				t.Errorf("unexpected problem %d: %s", rec.Code, rec.Body.String())
+			}
+			if tt.field != "" && (len(p.Errors) != 1 || p.Errors[0].Field != tt.field) {
+				t.Errorf("expected an error for %s, got %+v", tt.field, p.Errors)
+			}
+		})
+	}
+}
+
+func TestErrorResponses_KeepInternalDetailsServerSide(t *testing.T) {
+	var logs bytes.Buffer
+	logger, err := logging.New(&logs, logging.FormatJSON, slog.LevelInfo)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	previous := slog.Default()
+	slog.SetDefault(logger)
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	key, set := newTestKeys(t)
+	verifier := jwt.NewJWKVerifier("http://auth.invalid", jwt.WithStaticKeys(set), jwt.WithRemoteKeys(false))
+	e := echo.New()
+	registerRoutes(e, db.New(failingDB{}), verifier, nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/rounds", nil)
+	req.Header.Set("Authorization", "Bearer "+signTestToken(t, key, "user-1"))
+	req.Header.Set(echo.HeaderXRequestID, "req-42")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected 500, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if ct := rec.Header().Get(echo.HeaderContentType); ct != handlers.MIMEProblemJSON {
+		t.Errorf("expected a problem response, got %s", ct)
+	}
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("invalid response body: %v", err)
+	}
+	if body["request_id"] != "req-42" || body["status"] != float64(500) || strings.Contains(rec.Body.String(), "db.internal") {
+		t.Errorf("expected only the request ID in the response, got %s", rec.Body.String())
+	}
+	for _, want := range []string{errDatabaseDown.Error(), `"request_id":"req-42"`, `"user_id":"user-1"`} {
+		if !strings.Contains(logs.String(), want) {
+			t.Errorf("expected %s in the logs:\n%s", want, logs.String())
+		}
+	}
+
+	// Errors from middleware carry the request ID too.
+	req = httptest.NewRequest(http.MethodGet, "/api/rounds", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-43")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized || !strings.Contains(rec.Body.String(), `"request_id":"req-43"`) {
+		t.Errorf("expected a 401 with the request ID, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestJWTOptionsFromConfig_OfflineMode(t *testing.T) {
+	key, set := newTestKeys(t)
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("failed to marshal key set: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write key file: %v", err)
+	}
+
+	t.Setenv("DATABASE_URL", "postgres://archy@localhost:5432/archy")
+	t.Setenv("AUTH_SERVICE_URL", "")
+	t.Setenv("JWT_JWKS_FILE", path)
+
+	cfg, err := config.Load("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	options, err := jwtOptionsFromConfig(cfg.Auth)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	verifier := jwt.NewJWKVerifier(cfg.Auth.ServiceURL, options...)
+	if err := verifier.Initialize(); err != nil {
+		t.Fatalf("offline mode should not contact the auth-service: %v", err)
+	}
+
+	issuer := config.DefaultAuthServiceURL
+	token := signTestTokenWithClaims(t, key, "user-1", map[string]any{"iss": issuer, "aud": issuer})
+	if _, err := verifier.VerifyToken(token); err != nil {
+		t.Errorf("token rejected in offline mode: %v", err)
+	}
+}
+
+func TestNewRateLimiters(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*config.RateLimit)
+		wantNil bool
+	}{
+		{"defaults", func(*config.RateLimit) {}, false},
+		{"disabled", func(r *config.RateLimit) { r.Enabled = false }, true},
+		{"postgres store", func(r *config.RateLimit) { r.Store = "postgres" }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Default().RateLimit
+			tt.modify(&cfg)
+
+			callers, addresses, err := newRateLimiters(cfg, db.New(emptyDB{}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (callers == nil) != tt.wantNil || (addresses == nil) != tt.wantNil {
+				t.Errorf("limiters = %v, %v, wantNil %v", callers, addresses, tt.wantNil)
+			}
+		})
+	}
+}
+
+// Requests with invalid tokens are counted against the client address before
+// they reach authentication.
+func TestRegisterRoutes_AddressLimitBeforeAuthentication(t *testing.T) {
+	_, set := newTestKeys(t)
+	verifier := jwt.NewJWKVerifier("http://auth.invalid", jwt.WithStaticKeys(set), jwt.WithRemoteKeys(false))
+	addresses := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.PerMinute(2), ratelimit.PerMinute(2), nil)
+
+	e := echo.New()
+	registerRoutes(e, db.New(emptyDB{}), verifier, nil, addresses)
+
+	request := func(ip string) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/rounds", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer not-a-token")
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	for range 2 {
+		if code := request("10.0.0.1"); code != http.StatusUnauthorized {
+			t.Fatalf("expected 401 within the budget, got %d", code)
+		}
+	}
+	if code := request("10.0.0.1"); code != http.StatusTooManyRequests {
+		t.Errorf("expected 429 once the address budget is spent, got %d", code)
+	}
+	if code := request("10.0.0.2"); code != http.StatusUnauthorized {
+		t.Errorf("expected another address to have its own budget, got %d", code)
+	}
+}
+
+// A client cannot pick its own address bucket with forwarding headers, either
+// directly or through a trusted proxy.
+func TestIPExtractor_IgnoresForgedHeaders(t *testing.T) {
+	_, proxy, err := net.ParseCIDR("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name       string
+		proxies    []*net.IPNet
+		remoteAddr string
+		forwarded  string // appended by the proxy after the client's header
+	}{
+		{"direct", nil, "203.0.113.7", ""},
+		{"behind a trusted proxy", []*net.IPNet{proxy}, "10.0.0.1", "203.0.113.7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, set := newTestKeys(t)
+			verifier := jwt.NewJWKVerifier("http://auth.invalid", jwt.WithStaticKeys(set), jwt.WithRemoteKeys(false))
+			addresses := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.PerMinute(2), ratelimit.PerMinute(2), nil)
+
+			e := echo.New()
+			e.IPExtractor = ipExtractor(tt.proxies)
+			registerRoutes(e, db.New(emptyDB{}), verifier, nil, addresses)
+
+			codes := make([]int, 3)
+			for i := range codes {
+				forged := fmt.Sprintf("198.51.100.%d", i+1)
+				req := httptest.NewRequest(http.MethodGet, "/api/rounds", nil)
+				req.Header.Set(echo.HeaderAuthorization, "Bearer not-a-token")
+				req.Header.Set(echo.HeaderXRealIP, forged)
+				if tt.forwarded != "" {
+					forged += ", " + tt.forwarded
+				}
+				req.Header.Set(echo.HeaderXForwardedFor, forged)
+				req.RemoteAddr = tt.remoteAddr + ":1234"
+				rec := httptest.NewRecorder()
+				e.ServeHTTP(rec, req)
+				codes[i] = rec.Code
+			}
+
+			if codes[2] != http.StatusTooManyRequests {
+				t.Errorf("expected forged addresses to share one bucket, got %v", codes)
+			}
+		})
+	}
+}
+
+func TestCORS(t *testing.T) {
+	e, _ := newTestServer(t)
+	e.Use(corsMiddleware([]string{"https://archy.example"}))
+
+	req := httptest.NewRequest(http.MethodOptions, "/api/rounds", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://archy.example")
+	req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodPost)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected the preflight to succeed without a token, got %d", rec.Code)
+	}
+	if got := rec.Header().Get(echo.HeaderAccessControlAllowOrigin); got != "https://archy.example" {
+		t.Errorf("expected the origin to be allowed, got %q", got)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"archy/scores/internal/config"
+	"archy/scores/internal/core/tracing"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+)
+
+func TestSetupTracing_ExportsToCollector(t *testing.T) {
+	var exports atomic.Int32
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/traces" {
+			exports.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	shutdown, err := setupTracing(context.Background(), config.Tracing{
+		Enabled:     true,
+		Endpoint:    collector.URL,
+		ServiceName: "score-service-test",
+		SampleRatio: 1,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, span := tracing.Start(context.Background(), "ShotService.CreateShot")
+	span.End()
+
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("flushing spans failed: %v", err)
+	}
+	if exports.Load() == 0 {
+		t.Error("expected spans to be sent to the collector's /v1/traces endpoint")
+	}
+}
+
+func TestSetupTracing_Disabled(t *testing.T) {
+	previous := otel.GetTracerProvider()
+
+	shutdown, err := setupTracing(context.Background(), config.Tracing{Endpoint: "not a URL"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if otel.GetTracerProvider() != previous {
+		t.Error("expected the tracer provider to be left alone when tracing is disabled")
+	}
+}
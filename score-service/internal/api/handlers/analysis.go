@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AnalysisHandler struct {
+	service *services.AnalysisService
+}
+
+func NewAnalysisHandler(service *services.AnalysisService) *AnalysisHandler {
+	return &AnalysisHandler{service: service}
+}
+
+func (h *AnalysisHandler) RegisterRoutes(g *echo.Group) {
+	group := g.Group("/analysis")
+
+	group.GET("/fatigue", h.GetFatigueAnalysis)
+}
+
+// GetFatigueAnalysis reports score and grouping trends by end and arrow order
+// GET /api/analysis/fatigue?round_type=qualification&distance=70
+func (h *AnalysisHandler) GetFatigueAnalysis(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	var filter models.FatigueAnalysisFilter
+	if roundType := c.QueryParam("round_type"); roundType != "" {
+		filter.RoundType = &roundType
+	}
+	if distanceParam := c.QueryParam("distance"); distanceParam != "" {
+		distance, err := strconv.Atoi(distanceParam)
+		if err != nil || distance <= 0 {
+			return apperr.Validation("Distance must be a positive integer")
+		}
+		filter.Distance = &distance
+	}
+
+	analysis, err := h.service.GetFatigueAnalysis(c.Request().Context(), externalUserID, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, analysis)
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+var auditEntityTypes = map[string]bool{
+	services.AuditEntityRound:      true,
+	services.AuditEntitySet:        true,
+	services.AuditEntityShot:       true,
+	services.AuditEntityTargetFace: true,
+}
+
+type AuditLogHandler struct {
+	service *services.AuditService
+}
+
+func NewAuditLogHandler(service *services.AuditService) *AuditLogHandler {
+	return &AuditLogHandler{service: service}
+}
+
+func (h *AuditLogHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/audit-log", h.ListEntries)
+}
+
+// ListEntries returns changes the current user may see, newest first
+// GET /api/audit-log?entity_type=shot&entity_id=...&user_id=...&actor_id=...&before_id=...&limit=50
+func (h *AuditLogHandler) ListEntries(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	// Валидация
+	filter := models.AuditLogFilter{
+		EntityType:  c.QueryParam("entity_type"),
+		UserID:      c.QueryParam("user_id"),
+		ActorUserID: c.QueryParam("actor_id"),
+	}
+	if filter.EntityType != "" && !auditEntityTypes[filter.EntityType] {
+		return apperr.Validation("Entity type must be one of round, set, shot or target_face")
+	}
+	if param := c.QueryParam("entity_id"); param != "" {
+		id, err := uuid.Parse(param)
+		if err != nil {
+			return apperr.Validation("Invalid entity ID")
+		}
+		filter.EntityID = &id
+	}
+	if param := c.QueryParam("before_id"); param != "" {
+		id, err := strconv.ParseInt(param, 10, 64)
+		if err != nil || id <= 0 {
+			return apperr.Validation("Before ID must be a positive integer")
+		}
+		filter.BeforeID = &id
+	}
+	if param := c.QueryParam("limit"); param != "" {
+		limit, err := strconv.Atoi(param)
+		if err != nil || limit <= 0 {
+			return apperr.Validation("Limit must be a positive integer")
+		}
+		filter.Limit = limit
+	}
+
+	entries, err := h.service.ListEntries(c.Request().Context(), externalUserID, filter)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, entries)
+}
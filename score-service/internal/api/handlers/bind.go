@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/validation"
+
+	"github.com/labstack/echo/v4"
+)
+
+// bind decodes the request body into req and checks it against its
+// validate tags.
+func bind(c echo.Context, req any) error {
+	if err := c.Bind(req); err != nil {
+		return apperr.Validation("Invalid request format")
+	}
+	return validation.Struct(req)
+}
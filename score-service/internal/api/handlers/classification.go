@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+type ClassificationHandler struct {
+	service *services.ClassificationService
+}
+
+func NewClassificationHandler(service *services.ClassificationService) *ClassificationHandler {
+	return &ClassificationHandler{service: service}
+}
+
+func (h *ClassificationHandler) RegisterRoutes(g *echo.Group) {
+	group := g.Group("/classifications")
+
+	group.GET("", h.ListClassifications)
+	group.GET("/rules", h.ListRules)
+}
+
+// ListClassifications returns the classifications earned by the user
+// GET /api/classifications
+func (h *ClassificationHandler) ListClassifications(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	classifications, err := h.service.GetArcherClassifications(c.Request().Context(), externalUserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, classifications)
+}
+
+// ListRules returns the classification thresholds
+// GET /api/classifications/rules?round_template_id=...&bow_class=recurve
+func (h *ClassificationHandler) ListRules(c echo.Context) error {
+	roundTemplateID := pgtype.UUID{}
+	if param := c.QueryParam("round_template_id"); param != "" {
+		if err := roundTemplateID.Scan(param); err != nil {
+			return apperr.Validation("Invalid round template ID format")
+		}
+	}
+
+	bowClass := pgtype.Text{}
+	if param := c.QueryParam("bow_class"); param != "" {
+		if !models.IsValidBowClass(param) {
+			return apperr.Validation("Bow class must be one of recurve, compound, barebow, longbow")
+		}
+		bowClass = pgtype.Text{String: param, Valid: true}
+	}
+
+	rules, err := h.service.ListRules(c.Request().Context(), roundTemplateID, bowClass)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, rules)
+}
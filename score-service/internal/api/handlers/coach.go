@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"archy/scores/internal/api/middleware"
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CoachHandler struct {
+	service *services.CoachService
+	rounds  *services.QualificationRoundService
+	access  *services.AccessService
+}
+
+func NewCoachHandler(
+	service *services.CoachService,
+	rounds *services.QualificationRoundService,
+	access *services.AccessService,
+) *CoachHandler {
+	return &CoachHandler{service: service, rounds: rounds, access: access}
+}
+
+func (h *CoachHandler) RegisterRoutes(g *echo.Group) {
+	coach := g.Group("/coach/athletes")
+	coach.GET("", h.ListMyAthletes)
+	coach.GET("/:athleteId/rounds", h.ListAthleteRounds)
+
+	admin := g.Group("/coaches/:coachId/athletes", middleware.RequireScope(h.access, auth.ScopeCoachesWrite))
+	admin.GET("", h.ListAthletes)
+	admin.POST("", h.AddAthlete)
+	admin.DELETE("/:athleteId", h.RemoveAthlete)
+}
+
+// ListMyAthletes returns the athletes coached by the current user
+// GET /api/coach/athletes
+func (h *CoachHandler) ListMyAthletes(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	athletes, err := h.service.ListAthletes(c.Request().Context(), externalUserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, athletes)
+}
+
+// ListAthleteRounds returns the rounds of an athlete the current user coaches
+// GET /api/coach/athletes/:athleteId/rounds
+func (h *CoachHandler) ListAthleteRounds(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	rounds, err := h.rounds.GetAthleteRounds(c.Request().Context(), externalUserID, c.Param("athleteId"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, rounds)
+}
+
+// ListAthletes returns the athletes of any coach; requires the coaches:write scope
+// GET /api/coaches/:coachId/athletes
+func (h *CoachHandler) ListAthletes(c echo.Context) error {
+	athletes, err := h.service.ListAthletes(c.Request().Context(), c.Param("coachId"))
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, athletes)
+}
+
+// AddAthlete assigns an athlete to a coach; requires the coaches:write scope
+// POST /api/coaches/:coachId/athletes
+func (h *CoachHandler) AddAthlete(c echo.Context) error {
+	coachUserID := c.Param("coachId")
+
+	var req models.AddAthleteRequest
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+	if req.AthleteUserID == coachUserID {
+		return apperr.InvalidField("athlete_user_id", "a coach cannot coach themselves")
+	}
+
+	link, err := h.service.AddAthlete(c.Request().Context(), coachUserID, req.AthleteUserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, link)
+}
+
+// RemoveAthlete unassigns an athlete from a coach; requires the coaches:write scope
+// DELETE /api/coaches/:coachId/athletes/:athleteId
+func (h *CoachHandler) RemoveAthlete(c echo.Context) error {
+	err := h.service.RemoveAthlete(c.Request().Context(), c.Param("coachId"), c.Param("athleteId"))
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type CoachInvitationHandler struct {
+	service *services.CoachService
+}
+
+func NewCoachInvitationHandler(service *services.CoachService) *CoachInvitationHandler {
+	return &CoachInvitationHandler{service: service}
+}
+
+func (h *CoachInvitationHandler) RegisterRoutes(g *echo.Group) {
+	invitations := g.Group("/coach-invitations")
+	invitations.GET("", h.ListInvitations)
+	invitations.POST("", h.InviteCoach)
+	invitations.POST("/redeem", h.RedeemInviteCode)
+	invitations.POST("/:id/accept", h.AcceptInvitation)
+	invitations.POST("/:id/decline", h.DeclineInvitation)
+	invitations.POST("/:id/revoke", h.RevokeInvitation)
+
+	coaches := g.Group("/athlete/coaches")
+	coaches.GET("", h.ListMyCoaches)
+	coaches.DELETE("/:coachId", h.RevokeCoach)
+}
+
+// InviteCoach invites a coach by user ID, or creates an invite code when no
+// coach is given
+// POST /api/coach-invitations
+func (h *CoachInvitationHandler) InviteCoach(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	var req models.InviteCoachRequest
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	inv, err := h.service.InviteCoach(c.Request().Context(), externalUserID, req.CoachUserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, inv)
+}
+
+// ListInvitations returns invitations sent as athlete and received as coach
+// GET /api/coach-invitations
+func (h *CoachInvitationHandler) ListInvitations(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	invitations, err := h.service.ListInvitations(c.Request().Context(), externalUserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, invitations)
+}
+
+// RedeemInviteCode accepts an invitation by its invite code
+// POST /api/coach-invitations/redeem
+func (h *CoachInvitationHandler) RedeemInviteCode(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	var req models.RedeemInviteCodeRequest
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	inv, err := h.service.RedeemInviteCode(c.Request().Context(), externalUserID, req.InviteCode)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, inv)
+}
+
+// AcceptInvitation accepts an invitation addressed to the current user
+// POST /api/coach-invitations/:id/accept
+func (h *CoachInvitationHandler) AcceptInvitation(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return apperr.Validation("Invalid invitation ID format")
+	}
+
+	inv, err := h.service.AcceptInvitation(c.Request().Context(), externalUserID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, inv)
+}
+
+// DeclineInvitation declines an invitation addressed to the current user
+// POST /api/coach-invitations/:id/decline
+func (h *CoachInvitationHandler) DeclineInvitation(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return apperr.Validation("Invalid invitation ID format")
+	}
+
+	inv, err := h.service.DeclineInvitation(c.Request().Context(), externalUserID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, inv)
+}
+
+// RevokeInvitation withdraws an invitation sent by the current user
+// POST /api/coach-invitations/:id/revoke
+func (h *CoachInvitationHandler) RevokeInvitation(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return apperr.Validation("Invalid invitation ID format")
+	}
+
+	inv, err := h.service.RevokeInvitation(c.Request().Context(), externalUserID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, inv)
+}
+
+// ListMyCoaches returns the coaches who can read the current user's rounds
+// GET /api/athlete/coaches
+func (h *CoachInvitationHandler) ListMyCoaches(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	coaches, err := h.service.ListCoaches(c.Request().Context(), externalUserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, coaches)
+}
+
+// RevokeCoach removes a coach's access to the current user's rounds
+// DELETE /api/athlete/coaches/:coachId
+func (h *CoachInvitationHandler) RevokeCoach(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	if err := h.service.RevokeCoach(c.Request().Context(), externalUserID, c.Param("coachId")); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
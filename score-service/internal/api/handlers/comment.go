@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type CommentHandler struct {
+	service *services.CommentService
+}
+
+func NewCommentHandler(service *services.CommentService) *CommentHandler {
+	return &CommentHandler{service: service}
+}
+
+func (h *CommentHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/rounds/:roundId/comments", h.ListRoundComments)
+	g.POST("/rounds/:roundId/comments", h.CreateRoundComment)
+	g.GET("/rounds/:roundId/sets/:setId/comments", h.ListSetComments)
+	g.POST("/rounds/:roundId/sets/:setId/comments", h.CreateSetComment)
+	g.GET("/rounds/:roundId/sets/:setId/shots/:shotId/comments", h.ListShotComments)
+	g.POST("/rounds/:roundId/sets/:setId/shots/:shotId/comments", h.CreateShotComment)
+	g.DELETE("/comments/:id", h.DeleteComment)
+}
+
+// parseIDParams parses the named path parameters as UUIDs, in order.
+func parseIDParams(c echo.Context, names ...string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(names))
+	for i, name := range names {
+		id, err := uuid.Parse(c.Param(name + "Id"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s ID format", name)
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func bindCommentBody(c echo.Context) (string, error) {
+	var req models.CreateCommentRequest
+	if err := bind(c, &req); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(req.Body), nil
+}
+
+// ListRoundComments returns the comments on a round
+// GET /api/rounds/:roundId/comments
+func (h *CommentHandler) ListRoundComments(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+
+	comments, err := h.service.ListRoundComments(c.Request().Context(), externalUserID, ids[0])
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, comments)
+}
+
+// CreateRoundComment comments on a round
+// POST /api/rounds/:roundId/comments
+func (h *CommentHandler) CreateRoundComment(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+	body, err := bindCommentBody(c)
+	if err != nil {
+		return err
+	}
+
+	comment, err := h.service.CreateRoundComment(c.Request().Context(), externalUserID, ids[0], body)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, comment)
+}
+
+// ListSetComments returns the comments on a set
+// GET /api/rounds/:roundId/sets/:setId/comments
+func (h *CommentHandler) ListSetComments(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round", "set")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+
+	comments, err := h.service.ListSetComments(c.Request().Context(), externalUserID, ids[0], ids[1])
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, comments)
+}
+
+// CreateSetComment comments on a set
+// POST /api/rounds/:roundId/sets/:setId/comments
+func (h *CommentHandler) CreateSetComment(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round", "set")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+	body, err := bindCommentBody(c)
+	if err != nil {
+		return err
+	}
+
+	comment, err := h.service.CreateSetComment(c.Request().Context(), externalUserID, ids[0], ids[1], body)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, comment)
+}
+
+// ListShotComments returns the comments on a shot
+// GET /api/rounds/:roundId/sets/:setId/shots/:shotId/comments
+func (h *CommentHandler) ListShotComments(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round", "set", "shot")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+
+	comments, err := h.service.ListShotComments(c.Request().Context(), externalUserID, ids[0], ids[1], ids[2])
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, comments)
+}
+
+// CreateShotComment comments on a single shot
+// POST /api/rounds/:roundId/sets/:setId/shots/:shotId/comments
+func (h *CommentHandler) CreateShotComment(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round", "set", "shot")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+	body, err := bindCommentBody(c)
+	if err != nil {
+		return err
+	}
+
+	comment, err := h.service.CreateShotComment(c.Request().Context(), externalUserID, ids[0], ids[1], ids[2], body)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, comment)
+}
+
+// DeleteComment deletes a comment written by the current user
+// DELETE /api/comments/:id
+func (h *CommentHandler) DeleteComment(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return apperr.Validation("Invalid comment ID format")
+	}
+
+	if err := h.service.DeleteComment(c.Request().Context(), externalUserID, id); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
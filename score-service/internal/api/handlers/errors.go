@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/requestid"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+// MIMEProblemJSON is the media type of RFC 7807 problem details.
+const MIMEProblemJSON = "application/problem+json"
+
+// Problem is an RFC 7807 problem details body. Type is always about:blank,
+// so Title is the status text and Detail says what went wrong.
+type Problem struct {
+	Type      string              `json:"type"`
+	Title     string              `json:"title"`
+	Status    int                 `json:"status"`
+	Detail    string              `json:"detail,omitempty"`
+	Instance  string              `json:"instance,omitempty"`
+	RequestID string              `json:"request_id,omitempty"`
+	Errors    []apperr.FieldError `json:"errors,omitempty"`
+}
+
+// errNotAuthenticated is returned by handlers reached without a user, which
+// only happens if a route is mounted outside the authenticated group.
+var errNotAuthenticated = apperr.Unauthorized("User not authenticated")
+
+// kindStatus maps a domain error kind to its HTTP status.
+var kindStatus = map[apperr.Kind]int{
+	apperr.KindValidation:   http.StatusBadRequest,
+	apperr.KindUnauthorized: http.StatusUnauthorized,
+	apperr.KindForbidden:    http.StatusForbidden,
+	apperr.KindNotFound:     http.StatusNotFound,
+	apperr.KindConflict:     http.StatusConflict,
+}
+
+// newProblem describes err. Domain errors and echo.HTTPErrors are shown as
+// they are; a pgx.ErrNoRows that escaped its service is a missing resource;
+// anything else is an internal failure without details.
+func newProblem(err error) Problem {
+	status, detail := http.StatusInternalServerError, ""
+	var fields []apperr.FieldError
+
+	var he *echo.HTTPError
+	if e, ok := apperr.As(err); ok {
+		status, detail, fields = kindStatus[e.Kind], err.Error(), e.Fields
+	} else if errors.Is(err, pgx.ErrNoRows) {
+		status, detail = http.StatusNotFound, "not found"
+	} else if errors.As(err, &he) {
+		status = he.Code
+		if he.Message != nil {
+			detail = fmt.Sprint(he.Message)
+		}
+	}
+
+	return Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Errors: fields,
+	}
+}
+
+// HTTPErrorHandler renders every error returned by handlers and middleware
+// as application/problem+json. Internal failures are logged with the request
+// ID, which is all the client gets to quote when reporting them.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+	ctx := c.Request().Context()
+
+	p := newProblem(err)
+	p.Instance = c.Request().URL.Path
+	p.RequestID = requestid.FromContext(ctx)
+	if p.Status >= http.StatusInternalServerError {
+		slog.ErrorContext(ctx, "request failed", "status", p.Status, "error", err)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(p.Status)
+	} else {
+		c.Response().Header().Set(echo.HeaderContentType, MIMEProblemJSON)
+		err = c.JSON(p.Status, p)
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to write error response", "error", err)
+	}
+}
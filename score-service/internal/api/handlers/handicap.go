@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/handicap"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+type HandicapHandler struct {
+	service *services.HandicapService
+}
+
+func NewHandicapHandler(service *services.HandicapService) *HandicapHandler {
+	return &HandicapHandler{service: service}
+}
+
+func (h *HandicapHandler) RegisterRoutes(g *echo.Group) {
+	group := g.Group("/handicaps")
+
+	group.GET("", h.GetHandicaps)
+	group.GET("/predictions", h.GetPredictions)
+
+	g.GET("/round-templates", h.ListRoundTemplates)
+}
+
+// GetHandicaps returns the user's rolling handicap for every bow class
+// GET /api/handicaps
+func (h *HandicapHandler) GetHandicaps(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	handicaps, err := h.service.GetArcherHandicaps(c.Request().Context(), externalUserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, handicaps)
+}
+
+// GetPredictions predicts scores for every round template, either for an explicit
+// handicap or for the user's rolling handicap in a bow class
+// GET /api/handicaps/predictions?handicap=35
+// GET /api/handicaps/predictions?bow_class=recurve
+func (h *HandicapHandler) GetPredictions(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	res := models.HandicapPredictionsResponse{}
+	if param := c.QueryParam("handicap"); param != "" {
+		value, err := strconv.Atoi(param)
+		if err != nil || value < handicap.MinHandicap || value > handicap.MaxHandicap {
+			return apperr.Validation("Handicap must be an integer between 0 and 150")
+		}
+		res.Handicap = value
+	} else {
+		bowClass := c.QueryParam("bow_class")
+		if bowClass == "" {
+			bowClass = models.BowClassRecurve
+		}
+		if !models.IsValidBowClass(bowClass) {
+			return apperr.Validation("Bow class must be one of recurve, compound, barebow, longbow")
+		}
+
+		rolling, err := h.service.GetArcherHandicap(c.Request().Context(), externalUserID, bowClass)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperr.NotFound("No handicap for this bow class yet")
+		}
+		if err != nil {
+			return err
+		}
+		res.Handicap = int(rolling.Handicap)
+		res.BowClass = bowClass
+	}
+
+	predictions, err := h.service.PredictScores(c.Request().Context(), res.Handicap)
+	if err != nil {
+		return err
+	}
+	res.Predictions = predictions
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ListRoundTemplates returns the standard rounds used for predictions
+// GET /api/round-templates
+func (h *HandicapHandler) ListRoundTemplates(c echo.Context) error {
+	templates, err := h.service.ListRoundTemplates(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, templates)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// healthCheckTimeout bounds each readiness check.
+const healthCheckTimeout = 2 * time.Second
+
+// HealthCheck is a dependency the service needs to serve requests.
+type HealthCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type HealthHandler struct {
+	checks   []HealthCheck
+	draining atomic.Bool
+}
+
+func NewHealthHandler(checks ...HealthCheck) *HealthHandler {
+	return &HealthHandler{checks: checks}
+}
+
+// RegisterRoutes mounts the probes on the root; they need no authentication.
+func (h *HealthHandler) RegisterRoutes(e *echo.Echo) {
+	e.GET("/healthz", h.Liveness)
+	e.GET("/readyz", h.Readiness)
+}
+
+// Drain makes readiness fail so load balancers stop sending requests while
+// the server shuts down.
+func (h *HealthHandler) Drain() {
+	h.draining.Store(true)
+}
+
+// Liveness reports that the process is running
+// GET /healthz
+func (h *HealthHandler) Liveness(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// Readiness runs every check concurrently and reports 503 if any fails. The
+// probe needs no authentication, so it only says which checks failed; why is
+// logged.
+// GET /readyz
+func (h *HealthHandler) Readiness(c echo.Context) error {
+	if h.draining.Load() {
+		return c.JSON(http.StatusServiceUnavailable, map[string]any{
+			"status": "shutting down",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), healthCheckTimeout)
+	defer cancel()
+
+	// Checks that ignore ctx are abandoned when it expires.
+	type result struct {
+		name string
+		err  error
+	}
+	done := make(chan result, len(h.checks))
+	for _, check := range h.checks {
+		go func() {
+			done <- result{check.Name, check.Check(ctx)}
+		}()
+	}
+
+	results := make(map[string]string, len(h.checks))
+	for _, check := range h.checks {
+		results[check.Name] = "timed out"
+	}
+	ready := true
+collect:
+	for range h.checks {
+		select {
+		case r := <-done:
+			results[r.name] = "ok"
+			if r.err != nil {
+				slog.WarnContext(ctx, "readiness check failed", "check", r.name, "error", r.err)
+				results[r.name] = "failed"
+				ready = false
+			}
+		case <-ctx.Done():
+			ready = false
+			break collect
+		}
+	}
+	for name, result := range results {
+		if result == "timed out" {
+			slog.WarnContext(ctx, "readiness check timed out", "check", name)
+		}
+	}
+
+	status, code := "ok", http.StatusOK
+	if !ready {
+		status, code = "unavailable", http.StatusServiceUnavailable
+	}
+	return c.JSON(code, map[string]any{
+		"status": status,
+		"checks": results,
+	})
+}
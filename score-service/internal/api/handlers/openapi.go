@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"archy/scores/internal/api/openapi"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// OpenAPI serves the OpenAPI 3.1 document describing the /api routes; it needs
+// no authentication.
+// GET /openapi.json
+func OpenAPI(c echo.Context) error {
+	doc, err := openapi.JSON()
+	if err != nil {
+		return err
+	}
+
+	return c.Blob(http.StatusOK, echo.MIMEApplicationJSON, doc)
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"archy/scores/internal/core/apperr"
 	"archy/scores/internal/core/models"
 	"archy/scores/internal/core/services"
 	"net/http"
@@ -18,55 +19,24 @@ func NewQualificationRoundHandler(service *services.QualificationRoundService) *
 	return &QualificationRoundHandler{service: service}
 }
 
-func (h *QualificationRoundHandler) RegisterRoutes(e *echo.Echo) {
-	group := e.Group("/api/rounds")
+func (h *QualificationRoundHandler) RegisterRoutes(g *echo.Group) {
+	group := g.Group("/rounds")
 
 	group.GET("", h.GetUserRounds)
 	group.POST("", h.CreateRound)
 	group.GET("/:id", h.GetRound)
+	group.POST("/:id/complete", h.CompleteRound)
 }
 
 func (h *QualificationRoundHandler) CreateRound(c echo.Context) error {
 	externalUserID, ok := c.Get("external_user_id").(string)
 	if !ok || externalUserID == "" {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return errNotAuthenticated
 	}
 
 	var req models.CreateQualificationRoundRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error":   "Invalid request format",
-			"details": err.Error(),
-		})
-	}
-
-	// Валидация
-	if req.RoundType == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Round type is required",
-		})
-	}
-	if req.Name == "" {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Round name is required",
-		})
-	}
-	if req.Distance <= 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Distance must be positive",
-		})
-	}
-	if req.TotalSets <= 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Total sets must be positive",
-		})
-	}
-	if req.ShotsPerSet <= 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Shots per set must be positive",
-		})
+	if err := bind(c, &req); err != nil {
+		return err
 	}
 
 	// Устанавливаем время начала
@@ -75,10 +45,7 @@ func (h *QualificationRoundHandler) CreateRound(c echo.Context) error {
 
 	round, err := h.service.CreateQualificationRound(c.Request().Context(), externalUserID, req)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error":   "Failed to create round",
-			"details": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, round)
@@ -87,17 +54,12 @@ func (h *QualificationRoundHandler) CreateRound(c echo.Context) error {
 func (h *QualificationRoundHandler) GetUserRounds(c echo.Context) error {
 	externalUserID, ok := c.Get("external_user_id").(string)
 	if !ok || externalUserID == "" {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return errNotAuthenticated
 	}
 
 	rounds, err := h.service.GetQualificationRoundsForUser(c.Request().Context(), externalUserID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error":   "Failed to fetch rounds",
-			"details": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, rounds)
@@ -106,23 +68,38 @@ func (h *QualificationRoundHandler) GetUserRounds(c echo.Context) error {
 func (h *QualificationRoundHandler) GetRound(c echo.Context) error {
 	externalUserID, ok := c.Get("external_user_id").(string)
 	if !ok || externalUserID == "" {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return errNotAuthenticated
 	}
 
 	roundID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid round ID format",
-		})
+		return apperr.Validation("Invalid round ID format")
 	}
 
 	round, err := h.service.GetQualificationRound(c.Request().Context(), externalUserID, roundID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": "Round not found or access denied",
-		})
+		return err
+	}
+
+	return c.JSON(http.StatusOK, round)
+}
+
+// CompleteRound finishes a round and rates it with a handicap
+// POST /api/rounds/:id/complete
+func (h *QualificationRoundHandler) CompleteRound(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	roundID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return apperr.Validation("Invalid round ID format")
+	}
+
+	round, err := h.service.CompleteQualificationRound(c.Request().Context(), externalUserID, roundID)
+	if err != nil {
+		return err
 	}
 
 	return c.JSON(http.StatusOK, round)
@@ -1,12 +1,12 @@
 package handlers
 
 import (
+	"archy/scores/internal/core/apperr"
 	"archy/scores/internal/core/models"
 	"archy/scores/internal/core/services"
 	"net/http"
 
 	"github.com/google/uuid"
-	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/labstack/echo/v4"
 )
 
@@ -18,8 +18,8 @@ func NewSetHandler(service *services.SetService) *SetHandler {
 	return &SetHandler{service: service}
 }
 
-func (h *SetHandler) RegisterRoutes(e *echo.Echo) {
-	group := e.Group("/api/rounds/:roundId/sets")
+func (h *SetHandler) RegisterRoutes(g *echo.Group) {
+	group := g.Group("/rounds/:roundId/sets")
 
 	group.GET("", h.ListSets)
 	group.POST("", h.CreateSet)
@@ -30,30 +30,22 @@ func (h *SetHandler) RegisterRoutes(e *echo.Echo) {
 func (h *SetHandler) CreateSet(c echo.Context) error {
 	externalUserID, ok := c.Get("external_user_id").(string)
 	if !ok || externalUserID == "" {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return errNotAuthenticated
 	}
 
-	var req models.CreateSetRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request format",
-		})
+	roundID, err := uuid.Parse(c.Param("roundId"))
+	if err != nil {
+		return apperr.Validation("Invalid round ID format")
 	}
 
-	if req.SetNumber <= 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Set number must be positive",
-		})
+	var req models.CreateSetRequest
+	if err := bind(c, &req); err != nil {
+		return err
 	}
 
-	set, err := h.service.CreateSet(c.Request().Context(), req)
+	set, err := h.service.CreateSet(c.Request().Context(), externalUserID, roundID, req)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error":   "Failed to create set",
-			"details": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, set)
@@ -62,25 +54,17 @@ func (h *SetHandler) CreateSet(c echo.Context) error {
 func (h *SetHandler) ListSets(c echo.Context) error {
 	externalUserID, ok := c.Get("external_user_id").(string)
 	if !ok || externalUserID == "" {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return errNotAuthenticated
 	}
 
-	roundID := pgtype.UUID{}
-	err := roundID.Scan(c.Param("roundId"))
+	roundID, err := uuid.Parse(c.Param("roundId"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid round ID format",
-		})
+		return apperr.Validation("Invalid round ID format")
 	}
 
-	sets, err := h.service.GetSetsForQualificationRound(c.Request().Context(), roundID)
+	sets, err := h.service.GetSetsForQualificationRound(c.Request().Context(), externalUserID, roundID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error":   "Failed to fetch sets",
-			"details": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, sets)
@@ -89,23 +73,22 @@ func (h *SetHandler) ListSets(c echo.Context) error {
 func (h *SetHandler) GetSet(c echo.Context) error {
 	externalUserID, ok := c.Get("external_user_id").(string)
 	if !ok || externalUserID == "" {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return errNotAuthenticated
+	}
+
+	roundID, err := uuid.Parse(c.Param("roundId"))
+	if err != nil {
+		return apperr.Validation("Invalid round ID format")
 	}
 
 	setID, err := uuid.Parse(c.Param("setId"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid set ID format",
-		})
+		return apperr.Validation("Invalid set ID format")
 	}
 
-	set, err := h.service.GetSet(c.Request().Context(), setID)
+	set, err := h.service.GetSet(c.Request().Context(), externalUserID, roundID, setID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": "Set not found or access denied",
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, set)
@@ -114,24 +97,22 @@ func (h *SetHandler) GetSet(c echo.Context) error {
 func (h *SetHandler) GetSetShots(c echo.Context) error {
 	externalUserID, ok := c.Get("external_user_id").(string)
 	if !ok || externalUserID == "" {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return errNotAuthenticated
+	}
+
+	roundID, err := uuid.Parse(c.Param("roundId"))
+	if err != nil {
+		return apperr.Validation("Invalid round ID format")
 	}
 
 	setID, err := uuid.Parse(c.Param("setId"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid set ID format",
-		})
+		return apperr.Validation("Invalid set ID format")
 	}
 
-	shots, err := h.service.GetSetShots(c.Request().Context(), setID)
+	shots, err := h.service.GetSetShots(c.Request().Context(), externalUserID, roundID, setID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error":   "Failed to fetch shots",
-			"details": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, shots)
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"archy/scores/internal/core/apperr"
 	"archy/scores/internal/core/models"
 	"archy/scores/internal/core/services"
 	"net/http"
@@ -17,135 +18,102 @@ func NewShotHandler(service *services.ShotService) *ShotHandler {
 	return &ShotHandler{service: service}
 }
 
-func (h *ShotHandler) RegisterRoutes(e *echo.Echo) {
-	group := e.Group("/api/rounds/:roundId/sets/:setId/shots")
+func (h *ShotHandler) RegisterRoutes(g *echo.Group) {
+	group := g.Group("/rounds/:roundId/sets/:setId/shots")
 
 	group.GET("", h.ListShots)
 	group.POST("", h.CreateShot)
-	//group.POST("/batch", h.CreateShotsBatch)
+	group.POST("/batch", h.CreateShotsBatch)
 	group.GET("/:shotId", h.GetShot)
+	group.PUT("/:shotId", h.UpdateShot)
+	group.DELETE("/:shotId", h.DeleteShot)
 }
 
 func (h *ShotHandler) CreateShot(c echo.Context) error {
 	externalUserID, ok := c.Get("external_user_id").(string)
 	if !ok || externalUserID == "" {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return errNotAuthenticated
 	}
 
-	setID, err := uuid.Parse(c.Param("setId"))
+	roundID, err := uuid.Parse(c.Param("roundId"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid set ID format",
-		})
+		return apperr.Validation("Invalid round ID format")
 	}
 
-	var req models.CreateShotRequest
-	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid request format",
-		})
+	setID, err := uuid.Parse(c.Param("setId"))
+	if err != nil {
+		return apperr.Validation("Invalid set ID format")
 	}
 
-	// Валидация координат
-	if req.X == 0 && req.Y == 0 {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Coordinates cannot be both zero",
-		})
+	var req models.CreateShotRequest
+	if err := bind(c, &req); err != nil {
+		return err
 	}
 
 	shot, err := h.service.CreateShot(
 		c.Request().Context(),
+		externalUserID,
+		roundID,
 		setID,
 		req,
 	)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error":   "Failed to create shot",
-			"details": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusCreated, shot)
 }
 
-//// CreateShotsBatch создает несколько выстрелов за раз
-//// POST /api/rounds/:roundId/sets/:setId/shots/batch
-//func (h *ShotHandler) CreateShotsBatch(c echo.Context) error {
-//	externalUserID, ok := c.Get("external_user_id").(string)
-//	if !ok || externalUserID == "" {
-//		return c.JSON(http.StatusUnauthorized, map[string]string{
-//			"error": "User not authenticated",
-//		})
-//	}
-//
-//	roundID, err := uuid.Parse(c.Param("roundId"))
-//	if err != nil {
-//		return c.JSON(http.StatusBadRequest, map[string]string{
-//			"error": "Invalid round ID format",
-//		})
-//	}
-//
-//	setID, err := uuid.Parse(c.Param("setId"))
-//	if err != nil {
-//		return c.JSON(http.StatusBadRequest, map[string]string{
-//			"error": "Invalid set ID format",
-//		})
-//	}
-//
-//	var req models.CreateShotsBatchRequest
-//	if err := c.Bind(&req); err != nil {
-//		return c.JSON(http.StatusBadRequest, map[string]string{
-//			"error": "Invalid request format",
-//		})
-//	}
-//
-//	// Валидация
-//	if len(req.Shots) == 0 {
-//		return c.JSON(http.StatusBadRequest, map[string]string{
-//			"error": "No shots provided",
-//		})
-//	}
-//
-//	if len(req.Shots) > 12 {
-//		return c.JSON(http.StatusBadRequest, map[string]string{
-//			"error": "Maximum 12 shots per batch",
-//		})
-//	}
-//
-//	shots, err := h.service.CreateShotsBatch(c.Request().Context(), externalUserID, roundID, setID, req)
-//	if err != nil {
-//		return c.JSON(http.StatusInternalServerError, map[string]string{
-//			"error":   "Failed to create shots",
-//			"details": err.Error(),
-//		})
-//	}
-//
-//	return c.JSON(http.StatusCreated, shots)
-//}
+// CreateShotsBatch создает несколько выстрелов за раз
+// POST /api/rounds/:roundId/sets/:setId/shots/batch
+func (h *ShotHandler) CreateShotsBatch(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	roundID, err := uuid.Parse(c.Param("roundId"))
+	if err != nil {
+		return apperr.Validation("Invalid round ID format")
+	}
+
+	setID, err := uuid.Parse(c.Param("setId"))
+	if err != nil {
+		return apperr.Validation("Invalid set ID format")
+	}
+
+	var req models.CreateShotsBatchRequest
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	shots, err := h.service.CreateShotsBatch(c.Request().Context(), externalUserID, roundID, setID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, shots)
+}
 
 func (h *ShotHandler) ListShots(c echo.Context) error {
 	externalUserID, ok := c.Get("external_user_id").(string)
 	if !ok || externalUserID == "" {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return errNotAuthenticated
+	}
+
+	roundID, err := uuid.Parse(c.Param("roundId"))
+	if err != nil {
+		return apperr.Validation("Invalid round ID format")
 	}
 
 	setID, err := uuid.Parse(c.Param("setId"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid set ID format",
-		})
+		return apperr.Validation("Invalid set ID format")
 	}
 
-	shots, err := h.service.GetShotsBySet(c.Request().Context(), setID)
+	shots, err := h.service.GetShotsBySet(c.Request().Context(), externalUserID, roundID, setID)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{
-			"error":   "Failed to fetch shots",
-			"details": err.Error(),
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, shots)
@@ -154,24 +122,76 @@ func (h *ShotHandler) ListShots(c echo.Context) error {
 func (h *ShotHandler) GetShot(c echo.Context) error {
 	externalUserID, ok := c.Get("external_user_id").(string)
 	if !ok || externalUserID == "" {
-		return c.JSON(http.StatusUnauthorized, map[string]string{
-			"error": "User not authenticated",
-		})
+		return errNotAuthenticated
+	}
+
+	roundID, err := uuid.Parse(c.Param("roundId"))
+	if err != nil {
+		return apperr.Validation("Invalid round ID format")
+	}
+
+	setID, err := uuid.Parse(c.Param("setId"))
+	if err != nil {
+		return apperr.Validation("Invalid set ID format")
 	}
 
 	shotID, err := uuid.Parse(c.Param("shotId"))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{
-			"error": "Invalid shot ID format",
-		})
+		return apperr.Validation("Invalid shot ID format")
 	}
 
-	shot, err := h.service.GetShot(c.Request().Context(), shotID)
+	shot, err := h.service.GetShot(c.Request().Context(), externalUserID, roundID, setID, shotID)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, map[string]string{
-			"error": "Shot not found or access denied",
-		})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, shot)
 }
+
+// UpdateShot moves a shot or changes its notes; judges give a reason to
+// change a signed end
+// PUT /api/rounds/:roundId/sets/:setId/shots/:shotId
+func (h *ShotHandler) UpdateShot(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round", "set", "shot")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+
+	var req models.UpdateShotRequest
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	shot, err := h.service.UpdateShot(c.Request().Context(), externalUserID, ids[0], ids[1], ids[2], req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, shot)
+}
+
+// DeleteShot removes a shot; judges give a reason to change a signed end
+// DELETE /api/rounds/:roundId/sets/:setId/shots/:shotId?reason=...
+func (h *ShotHandler) DeleteShot(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round", "set", "shot")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+
+	err = h.service.DeleteShot(c.Request().Context(), externalUserID, ids[0], ids[1], ids[2], c.QueryParam("reason"))
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
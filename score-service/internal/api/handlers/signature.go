@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type SignatureHandler struct {
+	service *services.SignatureService
+}
+
+func NewSignatureHandler(service *services.SignatureService) *SignatureHandler {
+	return &SignatureHandler{service: service}
+}
+
+func (h *SignatureHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/rounds/:roundId/signatures", h.ListSignatures)
+	g.POST("/rounds/:roundId/signatures", h.SignRound)
+	g.POST("/rounds/:roundId/sets/:setId/signatures", h.SignSet)
+	g.GET("/rounds/:roundId/verification", h.VerifyRound)
+}
+
+// SignRound signs the whole round as archer, witness or judge
+// POST /api/rounds/:roundId/signatures
+func (h *SignatureHandler) SignRound(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+	var req models.SignScoresRequest
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	return h.sign(c, externalUserID, ids[0], nil, req)
+}
+
+// SignSet signs one end of a round as archer, witness or judge
+// POST /api/rounds/:roundId/sets/:setId/signatures
+func (h *SignatureHandler) SignSet(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round", "set")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+	var req models.SignScoresRequest
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	return h.sign(c, externalUserID, ids[0], &ids[1], req)
+}
+
+func (h *SignatureHandler) sign(
+	c echo.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+	setID *uuid.UUID,
+	req models.SignScoresRequest,
+) error {
+	signature, err := h.service.Sign(c.Request().Context(), externalUserID, roundID, setID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, signature)
+}
+
+// ListSignatures returns the signatures on a round
+// GET /api/rounds/:roundId/signatures
+func (h *SignatureHandler) ListSignatures(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+
+	signatures, err := h.service.ListSignatures(c.Request().Context(), externalUserID, ids[0])
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, signatures)
+}
+
+// VerifyRound returns a tamper-evident hash of the round's shots and checks
+// its signatures against it
+// GET /api/rounds/:roundId/verification
+func (h *SignatureHandler) VerifyRound(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	ids, err := parseIDParams(c, "round")
+	if err != nil {
+		return apperr.Validation(err.Error())
+	}
+
+	verification, err := h.service.VerifyRound(c.Request().Context(), externalUserID, ids[0])
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, verification)
+}
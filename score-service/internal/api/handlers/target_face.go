@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"archy/scores/internal/api/middleware"
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type TargetFaceHandler struct {
+	service *services.TargetFaceService
+	access  *services.AccessService
+}
+
+func NewTargetFaceHandler(service *services.TargetFaceService, access *services.AccessService) *TargetFaceHandler {
+	return &TargetFaceHandler{service: service, access: access}
+}
+
+func (h *TargetFaceHandler) RegisterRoutes(g *echo.Group) {
+	group := g.Group("/target-faces")
+	canWrite := middleware.RequireScope(h.access, auth.ScopeTargetFacesWrite)
+
+	group.GET("", h.ListTargetFaces)
+	group.GET("/:id", h.GetTargetFace)
+	group.POST("", h.CreateTargetFace, canWrite)
+	group.PUT("/:id", h.UpdateTargetFace, canWrite)
+	group.DELETE("/:id", h.DeleteTargetFace, canWrite)
+}
+
+// ListTargetFaces returns every available target face
+// GET /api/target-faces
+func (h *TargetFaceHandler) ListTargetFaces(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	faces, err := h.service.ListTargetFaces(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, faces)
+}
+
+// GetTargetFace returns a single target face
+// GET /api/target-faces/:id
+func (h *TargetFaceHandler) GetTargetFace(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return apperr.Validation("Invalid target face ID format")
+	}
+
+	face, err := h.service.GetTargetFace(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, face)
+}
+
+// CreateTargetFace adds a target face; requires the targetfaces:write scope
+// POST /api/target-faces
+func (h *TargetFaceHandler) CreateTargetFace(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	var req models.TargetFaceRequest
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	face, err := h.service.CreateTargetFace(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, face)
+}
+
+// UpdateTargetFace replaces a target face; requires the targetfaces:write scope
+// PUT /api/target-faces/:id
+func (h *TargetFaceHandler) UpdateTargetFace(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return apperr.Validation("Invalid target face ID format")
+	}
+
+	var req models.TargetFaceRequest
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	face, err := h.service.UpdateTargetFace(c.Request().Context(), id, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, face)
+}
+
+// DeleteTargetFace removes a target face; requires the targetfaces:write scope
+// DELETE /api/target-faces/:id
+func (h *TargetFaceHandler) DeleteTargetFace(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return apperr.Validation("Invalid target face ID format")
+	}
+
+	if err := h.service.DeleteTargetFace(c.Request().Context(), id); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
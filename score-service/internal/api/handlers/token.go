@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type TokenHandler struct {
+	service *services.TokenService
+}
+
+func NewTokenHandler(service *services.TokenService) *TokenHandler {
+	return &TokenHandler{service: service}
+}
+
+func (h *TokenHandler) RegisterRoutes(g *echo.Group) {
+	group := g.Group("/tokens")
+
+	group.GET("", h.ListTokens)
+	group.POST("", h.CreateToken)
+	group.DELETE("/:id", h.RevokeToken)
+}
+
+// CreateToken issues a personal access token; the token is only returned once
+// POST /api/tokens
+func (h *TokenHandler) CreateToken(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	var req models.CreatePersonalAccessTokenRequest
+	if err := bind(c, &req); err != nil {
+		return err
+	}
+
+	token, err := h.service.CreateToken(c.Request().Context(), externalUserID, req)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, token)
+}
+
+// ListTokens returns the user's active personal access tokens, without the tokens themselves
+// GET /api/tokens
+func (h *TokenHandler) ListTokens(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	tokens, err := h.service.ListTokens(c.Request().Context(), externalUserID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, tokens)
+}
+
+// RevokeToken revokes a personal access token
+// DELETE /api/tokens/:id
+func (h *TokenHandler) RevokeToken(c echo.Context) error {
+	externalUserID, ok := c.Get("external_user_id").(string)
+	if !ok || externalUserID == "" {
+		return errNotAuthenticated
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return apperr.Validation("Invalid token ID format")
+	}
+
+	if err := h.service.RevokeToken(c.Request().Context(), externalUserID, id); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
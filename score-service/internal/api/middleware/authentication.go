@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/services"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PersonalTokenAuthenticator resolves personal access tokens to a principal.
+type PersonalTokenAuthenticator interface {
+	Authenticate(ctx context.Context, token string) (*auth.Principal, error)
+}
+
+// Authenticate accepts both bearer JWTs from the auth-service, checked by
+// jwtMiddleware, and personal access tokens issued by this service.
+func Authenticate(jwtMiddleware echo.MiddlewareFunc, tokens PersonalTokenAuthenticator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		withJWT := jwtMiddleware(Principal()(next))
+
+		return func(c echo.Context) error {
+			token, ok := strings.CutPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+			if !ok || !strings.HasPrefix(token, services.PersonalTokenPrefix) {
+				return withJWT(c)
+			}
+
+			p, err := tokens.Authenticate(c.Request().Context(), token)
+			if errors.Is(err, services.ErrInvalidPersonalToken) {
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid token: "+err.Error())
+			}
+			if err != nil {
+				return echo.NewHTTPError(http.StatusServiceUnavailable, "Authentication temporarily unavailable").SetInternal(err)
+			}
+
+			setPrincipal(c, p)
+			c.Set("external_user_id", p.UserID)
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/services"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+type fakeTokens map[string]*auth.Principal
+
+func (f fakeTokens) Authenticate(_ context.Context, token string) (*auth.Principal, error) {
+	if token == "archy_pat_broken" {
+		return nil, errors.New("database unavailable")
+	}
+	p, ok := f[token]
+	if !ok {
+		return nil, services.ErrInvalidPersonalToken
+	}
+	return p, nil
+}
+
+// fakeJWT accepts the bearer token "jwt" only.
+func fakeJWT(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if c.Request().Header.Get("Authorization") != "Bearer jwt" {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid token")
+		}
+		c.Set("external_user_id", "jwt-user")
+		return next(c)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	tokens := fakeTokens{
+		"archy_pat_readonly": {UserID: "pat-user", TokenType: auth.TokenTypePersonal, Scopes: []string{auth.ScopeScoresRead}},
+		"archy_pat_full": {UserID: "pat-user", TokenType: auth.TokenTypePersonal,
+			Scopes: []string{auth.ScopeScoresRead, auth.ScopeScoresWrite}},
+	}
+
+	e := echo.New()
+	g := e.Group("", Authenticate(fakeJWT, tokens), RequireScoresScope())
+	handler := func(c echo.Context) error {
+		return c.String(http.StatusOK, c.Get("external_user_id").(string))
+	}
+	g.GET("/", handler)
+	g.POST("/", handler)
+
+	tests := []struct {
+		name     string
+		method   string
+		token    string
+		wantCode int
+		wantUser string
+	}{
+		{"jwt", http.MethodPost, "jwt", http.StatusOK, "jwt-user"},
+		{"invalid jwt", http.MethodGet, "not-a-jwt", http.StatusUnauthorized, ""},
+		{"personal token", http.MethodPost, "archy_pat_full", http.StatusOK, "pat-user"},
+		{"unknown personal token", http.MethodGet, "archy_pat_unknown", http.StatusUnauthorized, ""},
+		{"token store down", http.MethodGet, "archy_pat_broken", http.StatusServiceUnavailable, ""},
+		{"read-only token reads", http.MethodGet, "archy_pat_readonly", http.StatusOK, "pat-user"},
+		{"read-only token writes", http.MethodPost, "archy_pat_readonly", http.StatusForbidden, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Fatalf("expected %d, got %d: %s", tt.wantCode, rec.Code, rec.Body.String())
+			}
+			if tt.wantUser != "" && rec.Body.String() != tt.wantUser {
+				t.Errorf("expected user %q, got %q", tt.wantUser, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Package middleware holds echo middleware shared by the API routes.
+package middleware
+
+import (
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/services"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// PrincipalKey is the echo context key holding the *auth.Principal.
+const PrincipalKey = "principal"
+
+// Principal builds the caller's principal from the token set by the JWT
+// middleware and stores it in the echo context and the request context, so
+// services can make authorization decisions.
+func Principal() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token, ok := c.Get("token").(jwt.Token)
+			if !ok {
+				return next(c)
+			}
+
+			setPrincipal(c, auth.FromToken(token))
+			return next(c)
+		}
+	}
+}
+
+func setPrincipal(c echo.Context, p *auth.Principal) {
+	c.Set(PrincipalKey, p)
+	c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(), p)))
+}
+
+// RequireScoresScope limits callers to reading unless they hold
+// scores:write, and to nothing unless they hold scores:read. Users signed in
+// with a JWT hold both; personal access tokens may not.
+func RequireScoresScope() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			p, ok := c.Get(PrincipalKey).(*auth.Principal)
+			if !ok {
+				return next(c)
+			}
+
+			scope := auth.ScopeScoresWrite
+			switch c.Request().Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				scope = auth.ScopeScoresRead
+			}
+			if !p.HasScope(scope) {
+				return echo.NewHTTPError(http.StatusForbidden, "Missing required scope: "+scope)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RequireScope rejects callers that hold scope neither in their token nor
+// through a role granted to them.
+func RequireScope(access *services.AccessService, scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			p, ok := c.Get(PrincipalKey).(*auth.Principal)
+			if !ok || p.UserID == "" {
+				return echo.NewHTTPError(http.StatusUnauthorized, "User not authenticated")
+			}
+
+			allowed, err := access.HasScope(c.Request().Context(), p, scope)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check permissions").SetInternal(err)
+			}
+			if !allowed {
+				return echo.NewHTTPError(http.StatusForbidden, "Missing required scope: "+scope)
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"archy/scores/internal/core/metrics"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Metrics records the rate, errors and duration of every request, labelled
+// by the route as registered. Requests that match no route share the
+// "unmatched" label so that scanners cannot create unbounded series; a 404
+// below a known route is labelled with that route. For the same reason
+// methods outside the standard ones are labelled "OTHER".
+func Metrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			metrics.HTTPRequestsInFlight.Inc()
+			defer metrics.HTTPRequestsInFlight.Dec()
+
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				// Let the error handler write the response now so that its
+				// status code is the one recorded.
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+			method := metricMethod(c.Request().Method)
+			metrics.HTTPRequests.WithLabelValues(method, route, strconv.Itoa(c.Response().Status)).Inc()
+			metrics.HTTPRequestDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+			return nil
+		}
+	}
+}
+
+// metricMethod returns the label for a request method; clients may send any
+// token as the method.
+func metricMethod(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace:
+		return method
+	}
+	return "OTHER"
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"archy/scores/internal/core/metrics"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics(t *testing.T) {
+	e := echo.New()
+	e.Use(Metrics())
+	e.GET("/metrics-test/rounds/:roundId", func(c echo.Context) error {
+		if c.Param("roundId") == "missing" {
+			return echo.NewHTTPError(http.StatusNotFound, "round not found")
+		}
+		return c.NoContent(http.StatusOK)
+	})
+
+	countMethod := func(method, route, status string) float64 {
+		return testutil.ToFloat64(metrics.HTTPRequests.WithLabelValues(method, route, status))
+	}
+	count := func(route, status string) float64 { return countMethod(http.MethodGet, route, status) }
+	const route = "/metrics-test/rounds/:roundId"
+	okBefore, notFoundBefore, unmatchedBefore := count(route, "200"), count(route, "404"), count("unmatched", "404")
+	otherBefore := countMethod("OTHER", "unmatched", "404")
+
+	for _, path := range []string{"/metrics-test/rounds/1", "/metrics-test/rounds/2", "/metrics-test/rounds/missing", "/no/such/route"} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	if got := count(route, "200") - okBefore; got != 2 {
+		t.Errorf("expected 2 successful requests on the route, got %v", got)
+	}
+	if got := count(route, "404") - notFoundBefore; got != 1 {
+		t.Errorf("expected the handler's error status to be recorded once, got %v", got)
+	}
+	if got := count("unmatched", "404") - unmatchedBefore; got != 1 {
+		t.Errorf("expected the unknown path to be recorded as unmatched, got %v", got)
+	}
+
+	// Made-up methods share one label.
+	for _, method := range []string{"BREW", "SCAN-1", "SCAN-2"} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(method, "/no/such/route", nil))
+	}
+	if got := countMethod("OTHER", "unmatched", "404") - otherBefore; got != 3 {
+		t.Errorf("expected unknown methods to be recorded as OTHER, got %v", got)
+	}
+	if metrics.HTTPRequests.DeleteLabelValues("BREW", "unmatched", "404") {
+		t.Error("expected no series labelled with an unknown method")
+	}
+
+	if got := testutil.ToFloat64(metrics.HTTPRequestsInFlight); got != 0 {
+		t.Errorf("expected no requests in flight, got %v", got)
+	}
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/ratelimit"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RateLimit counts requests against the caller's budget for the route and
+// rejects them with 429 once it is spent. Callers are identified by user when
+// authenticated and by client IP otherwise, so it belongs after Authenticate.
+// The budget is reported in RateLimit-Limit, RateLimit-Remaining and
+// RateLimit-Reset headers, plus Retry-After on rejection. If the bucket store
+// fails the request is let through.
+func RateLimit(limiter *ratelimit.Limiter) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			res, err := limiter.Allow(c.Request().Context(), rateLimitCaller(c), c.Request().Method, c.Path())
+			if err != nil {
+				slog.WarnContext(c.Request().Context(), "Rate limiting unavailable, allowing request", "error", err)
+				return next(c)
+			}
+
+			h := c.Response().Header()
+			h.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+			h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			h.Set("RateLimit-Reset", ceilSeconds(res.Reset))
+
+			if !res.Allowed {
+				h.Set("Retry-After", ceilSeconds(res.RetryAfter))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "Rate limit exceeded")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RateLimitAddress counts requests against the budget of the client IP. It
+// belongs in front of Authenticate, so that requests with bad or expired
+// tokens are rejected before they cost a signature check or a token lookup.
+// Rejections and store failures are handled as in RateLimit.
+func RateLimitAddress(limiter *ratelimit.Limiter) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			res, err := limiter.Allow(c.Request().Context(), "addr:"+c.RealIP(), c.Request().Method, c.Path())
+			if err != nil {
+				slog.WarnContext(c.Request().Context(), "Rate limiting unavailable, allowing request", "error", err)
+				return next(c)
+			}
+			if !res.Allowed {
+				h := c.Response().Header()
+				h.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+				h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+				h.Set("RateLimit-Reset", ceilSeconds(res.Reset))
+				h.Set("Retry-After", ceilSeconds(res.RetryAfter))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "Rate limit exceeded")
+			}
+			return next(c)
+		}
+	}
+}
+
+func rateLimitCaller(c echo.Context) string {
+	if p, ok := c.Get(PrincipalKey).(*auth.Principal); ok && p.UserID != "" {
+		return "user:" + p.UserID
+	}
+	if userID, ok := c.Get("external_user_id").(string); ok && userID != "" {
+		return "user:" + userID
+	}
+	return "ip:" + c.RealIP()
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/ratelimit"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+type brokenStore struct{}
+
+func (brokenStore) Take(context.Context, string, ratelimit.Limit) (ratelimit.Result, error) {
+	return ratelimit.Result{}, errors.New("database unavailable")
+}
+
+func TestRateLimit(t *testing.T) {
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.PerMinute(2), ratelimit.PerMinute(1), nil)
+
+	e := echo.New()
+	setUser := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if user := c.Request().Header.Get("X-User"); user != "" {
+				setPrincipal(c, &auth.Principal{UserID: user})
+			}
+			return next(c)
+		}
+	}
+	e.GET("/", func(c echo.Context) error { return c.NoContent(http.StatusOK) }, setUser, RateLimit(limiter))
+
+	request := func(user, ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-User", user)
+		req.RemoteAddr = ip + ":1234"
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := request("alice", "10.0.0.1")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if rec.Header().Get("RateLimit-Limit") != "2" || rec.Header().Get("RateLimit-Remaining") != "1" ||
+		rec.Header().Get("RateLimit-Reset") != "30" {
+		t.Errorf("unexpected headers: %v", rec.Header())
+	}
+
+	// The same user from another address shares the budget.
+	request("alice", "10.0.0.2")
+	rec = request("alice", "10.0.0.3")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429, got %d", rec.Code)
+	}
+	if rec.Header().Get("Retry-After") != "30" || rec.Header().Get("RateLimit-Remaining") != "0" {
+		t.Errorf("unexpected headers: %v", rec.Header())
+	}
+
+	// Anonymous callers are limited per IP.
+	if rec := request("", "10.0.0.1"); rec.Code != http.StatusOK {
+		t.Fatalf("expected anonymous caller to have its own budget, got %d", rec.Code)
+	}
+}
+
+func TestRateLimit_StoreDownAllowsRequests(t *testing.T) {
+	limiter := ratelimit.NewLimiter(brokenStore{}, ratelimit.PerMinute(1), ratelimit.PerMinute(1), nil)
+
+	e := echo.New()
+	e.GET("/", func(c echo.Context) error { return c.NoContent(http.StatusOK) }, RateLimit(limiter))
+
+	for range 3 {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected 200, got %d", rec.Code)
+		}
+	}
+}
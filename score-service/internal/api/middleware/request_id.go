@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"archy/scores/internal/core/requestid"
+	"regexp"
+
+	"github.com/labstack/echo/v4"
+	echomw "github.com/labstack/echo/v4/middleware"
+)
+
+// validRequestID matches the caller's request IDs that are kept. Anything
+// longer or with other characters is replaced, as it would not fit the audit
+// log or could smuggle text into it.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestID assigns every request an ID, reusing the caller's X-Request-Id
+// header when it is a valid ID. The ID is echoed in the response and stored
+// in the request context for the audit log.
+func RequestID() echo.MiddlewareFunc {
+	assign := echomw.RequestIDWithConfig(echomw.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, id string) {
+			c.SetRequest(c.Request().WithContext(requestid.WithID(c.Request().Context(), id)))
+		},
+	})
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		handler := assign(next)
+		return func(c echo.Context) error {
+			h := c.Request().Header
+			if id := h.Get(echo.HeaderXRequestID); id != "" && !validRequestID.MatchString(id) {
+				h.Del(echo.HeaderXRequestID)
+			}
+			return handler(c)
+		}
+	}
+}
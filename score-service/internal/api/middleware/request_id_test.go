@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"archy/scores/internal/core/requestid"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestRequestID(t *testing.T) {
+	e := echo.New()
+	e.Use(RequestID())
+	e.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, requestid.FromContext(c.Request().Context()))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-123")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Body.String() != "req-123" || rec.Header().Get(echo.HeaderXRequestID) != "req-123" {
+		t.Errorf("expected the caller's request ID, got body %q and header %q",
+			rec.Body.String(), rec.Header().Get(echo.HeaderXRequestID))
+	}
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Body.String() == "" || rec.Body.String() != rec.Header().Get(echo.HeaderXRequestID) {
+		t.Errorf("expected a generated request ID, got body %q and header %q",
+			rec.Body.String(), rec.Header().Get(echo.HeaderXRequestID))
+	}
+
+	for _, id := range []string{strings.Repeat("a", 65), strings.Repeat("a", 300), "req 123", "req-123\u202e", "<script>"} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(echo.HeaderXRequestID, id)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		got := rec.Header().Get(echo.HeaderXRequestID)
+		if got == id || got == "" || rec.Body.String() != got {
+			t.Errorf("expected %q to be replaced by a generated ID, got body %q and header %q", id, rec.Body.String(), got)
+		}
+	}
+}
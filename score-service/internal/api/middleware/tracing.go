@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+)
+
+// Tracing starts a server span for every request, continuing the caller's
+// trace when a traceparent header is sent. Probes and metric scrapes are
+// not traced.
+func Tracing(service string) echo.MiddlewareFunc {
+	return otelecho.Middleware(service, otelecho.WithSkipper(func(c echo.Context) bool {
+		path := c.Request().URL.Path
+		return path == "/metrics" || path == "/healthz" || path == "/readyz"
+	}))
+}
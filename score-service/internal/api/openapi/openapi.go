@@ -0,0 +1,76 @@
+// Package openapi holds the OpenAPI 3.1 description of the /api routes. The
+// document is written by hand in openapi.yaml next to this file and must be
+// updated together with the handlers and request models; the server tests
+// fail when a route or a response drifts from it.
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed openapi.yaml
+var document []byte
+
+// JSON returns the document as JSON, as served at /openapi.json.
+var JSON = sync.OnceValues(func() ([]byte, error) {
+	doc, err := decode()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+})
+
+// JSON30 returns the document rewritten as OpenAPI 3.0 for tools that do not
+// read 3.1 yet, such as the client generator and kin-openapi's validator: a
+// type list of one type and "null" becomes that type with nullable set.
+var JSON30 = sync.OnceValues(func() ([]byte, error) {
+	doc, err := decode()
+	if err != nil {
+		return nil, err
+	}
+	doc["openapi"] = "3.0.3"
+	if err := downgrade(doc); err != nil {
+		return nil, err
+	}
+	return json.Marshal(doc)
+})
+
+func decode() (map[string]any, error) {
+	var doc map[string]any
+	if err := yaml.Unmarshal(document, &doc); err != nil {
+		return nil, fmt.Errorf("openapi: parse openapi.yaml: %w", err)
+	}
+	return doc, nil
+}
+
+func downgrade(node any) error {
+	switch n := node.(type) {
+	case map[string]any:
+		if types, ok := n["type"].([]any); ok {
+			i := slices.Index(types, any("null"))
+			if i < 0 || len(types) != 2 {
+				return fmt.Errorf("openapi: cannot express type %v in 3.0", types)
+			}
+			n["type"] = types[1-i]
+			n["nullable"] = true
+		}
+		for _, v := range n {
+			if err := downgrade(v); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, v := range n {
+			if err := downgrade(v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
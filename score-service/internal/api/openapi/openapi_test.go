@@ -0,0 +1,55 @@
+package openapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func load(t *testing.T, data []byte) *openapi3.T {
+	t.Helper()
+
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		t.Fatalf("failed to load the document: %v", err)
+	}
+	return doc
+}
+
+// kin-openapi only validates 3.0 documents, so the 3.1 document is checked
+// through its 3.0 rendering below.
+func TestJSON_Loads(t *testing.T) {
+	data, err := JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc := load(t, data)
+	if doc.OpenAPI != "3.1.0" {
+		t.Errorf("expected OpenAPI 3.1.0, got %s", doc.OpenAPI)
+	}
+	if doc.Paths.Find("/api/rounds") == nil {
+		t.Error("expected /api/rounds to be described")
+	}
+}
+
+func TestJSON30_IsValid(t *testing.T) {
+	data, err := JSON30()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc := load(t, data)
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatalf("the document is invalid: %v", err)
+	}
+	if doc.OpenAPI != "3.0.3" {
+		t.Errorf("expected OpenAPI 3.0.3, got %s", doc.OpenAPI)
+	}
+
+	notes := doc.Components.Schemas["Shot"].Value.Properties["notes"].Value
+	if !notes.Type.Is(openapi3.TypeString) || !notes.Nullable {
+		t.Errorf("expected notes to be a nullable string, got type %v nullable %v", notes.Type, notes.Nullable)
+	}
+}
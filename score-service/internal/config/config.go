@@ -0,0 +1,436 @@
+// Package config loads the score-service settings shared by the server and
+// archy-migrate. Settings start from defaults, are overridden by an
+// optional YAML file and then by environment variables, and are validated
+// before use.
+package config
+
+import (
+	"archy/scores/internal/core/logging"
+	"archy/scores/internal/core/ratelimit"
+	"archy/scores/jwt"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"gopkg.in/yaml.v3"
+)
+
+// Defaults for local development. The database has no default, so that no
+// credentials are built in; see config.example.yaml for the local one.
+const (
+	DefaultAddress        = ":1323"
+	DefaultAuthServiceURL = "http://localhost:3000"
+	DefaultOTLPEndpoint   = "http://localhost:4318"
+	DefaultServiceName    = "score-service"
+)
+
+type Config struct {
+	Database  Database  `yaml:"database"`
+	Server    Server    `yaml:"server"`
+	Auth      Auth      `yaml:"auth"`
+	RateLimit RateLimit `yaml:"rate_limit"`
+	Metrics   Metrics   `yaml:"metrics"`
+	Tracing   Tracing   `yaml:"tracing"`
+	Log       Log       `yaml:"log"`
+}
+
+type Database struct {
+	URL string `yaml:"url"`
+	// Pool settings; zero keeps the pgxpool default.
+	MaxConns        int32         `yaml:"max_conns"`
+	MinConns        int32         `yaml:"min_conns"`
+	MaxConnLifetime time.Duration `yaml:"max_conn_lifetime"`
+	MaxConnIdleTime time.Duration `yaml:"max_conn_idle_time"`
+	// MigrateOnStart applies pending migrations before the server starts
+	// listening. Instances starting together take turns.
+	MigrateOnStart bool `yaml:"migrate_on_start"`
+}
+
+// PoolConfig returns the pgxpool settings for the database.
+func (d Database) PoolConfig() (*pgxpool.Config, error) {
+	pc, err := pgxpool.ParseConfig(d.URL)
+	if err != nil {
+		return nil, err
+	}
+	if d.MaxConns > 0 {
+		pc.MaxConns = d.MaxConns
+	}
+	if d.MinConns > 0 {
+		pc.MinConns = d.MinConns
+	}
+	if d.MaxConnLifetime > 0 {
+		pc.MaxConnLifetime = d.MaxConnLifetime
+	}
+	if d.MaxConnIdleTime > 0 {
+		pc.MaxConnIdleTime = d.MaxConnIdleTime
+	}
+	return pc, nil
+}
+
+type Server struct {
+	Address string `yaml:"address"`
+	// TLS is served when both files are set.
+	TLSCertFile string `yaml:"tls_cert_file"`
+	TLSKeyFile  string `yaml:"tls_key_file"`
+	// CORSOrigins may call the API from a browser; "*" allows any origin.
+	CORSOrigins []string `yaml:"cors_origins"`
+	// TrustedProxies are the addresses or CIDR ranges of reverse proxies
+	// whose X-Forwarded-For entries name the client. Without them clients
+	// are identified by the address they connect from.
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	// ShutdownTimeout is how long in-flight requests may take to finish
+	// after SIGTERM.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+// TLS reports whether the server should serve HTTPS.
+func (s Server) TLS() bool {
+	return s.TLSCertFile != "" && s.TLSKeyFile != ""
+}
+
+// TrustedProxyRanges parses TrustedProxies; a single address is a range of
+// one.
+func (s Server) TrustedProxyRanges() ([]*net.IPNet, error) {
+	ranges := make([]*net.IPNet, 0, len(s.TrustedProxies))
+	for _, proxy := range s.TrustedProxies {
+		if ip := net.ParseIP(proxy); ip != nil {
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			ranges = append(ranges, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an address or CIDR range", proxy)
+		}
+		ranges = append(ranges, ipNet)
+	}
+	return ranges, nil
+}
+
+type Auth struct {
+	ServiceURL string `yaml:"service_url"`
+	// Issuer and Audience default to ServiceURL, as better-auth's jwt plugin
+	// uses its base URL for both; "-" skips the check.
+	Issuer            string        `yaml:"issuer"`
+	Audience          string        `yaml:"audience"`
+	ClockSkew         time.Duration `yaml:"clock_skew"`
+	AllowedAlgorithms []string      `yaml:"allowed_algorithms"`
+	RequiredClaims    []string      `yaml:"required_claims"`
+	// JWKSFile holds verification keys as a JWKS or PEM file. Without a
+	// service URL the keys are used alone and the auth-service is never
+	// contacted.
+	JWKSFile           string        `yaml:"jwks_file"`
+	JWKSKeyID          string        `yaml:"jwks_key_id"`
+	RefreshInterval    time.Duration `yaml:"jwks_refresh_interval"`
+	KidRefetchInterval time.Duration `yaml:"jwks_kid_refetch_interval"`
+	// JWKSMaxAge is how old the auth-service's key set may get before the
+	// service reports itself not ready. Stale keys are still used.
+	JWKSMaxAge time.Duration `yaml:"jwks_max_age"`
+
+	// RemoteKeys reports whether keys are fetched from the auth-service.
+	RemoteKeys bool `yaml:"-"`
+}
+
+type RateLimit struct {
+	Enabled bool `yaml:"enabled"`
+	// Budgets such as "300/m"; see ratelimit.ParseLimit.
+	Read  string `yaml:"read"`
+	Write string `yaml:"write"`
+	// Routes gives routes their own budget, keyed by "METHOD /path" with the
+	// path as registered, e.g. "POST /api/rounds/:roundId/sets".
+	Routes map[string]string `yaml:"routes"`
+	// Store is "memory" (per instance) or "postgres" (shared by all instances).
+	Store string `yaml:"store"`
+	// PerAddress is the budget of reads and, separately, writes from one
+	// client IP, counted before authentication.
+	PerAddress string `yaml:"per_address"`
+}
+
+// Limits parses the configured budgets.
+func (r RateLimit) Limits() (read, write ratelimit.Limit, routes map[string]ratelimit.Limit, err error) {
+	if read, err = ratelimit.ParseLimit(r.Read); err != nil {
+		return read, write, nil, fmt.Errorf("read: %w", err)
+	}
+	if write, err = ratelimit.ParseLimit(r.Write); err != nil {
+		return read, write, nil, fmt.Errorf("write: %w", err)
+	}
+
+	routes = make(map[string]ratelimit.Limit, len(r.Routes))
+	for route, budget := range r.Routes {
+		method, path, ok := strings.Cut(strings.TrimSpace(route), " ")
+		if !ok || !strings.HasPrefix(strings.TrimSpace(path), "/") {
+			return read, write, nil, fmt.Errorf("route %q: expected \"METHOD /path\"", route)
+		}
+		limit, err := ratelimit.ParseLimit(budget)
+		if err != nil {
+			return read, write, nil, fmt.Errorf("route %q: %w", route, err)
+		}
+		routes[strings.ToUpper(method)+" "+strings.TrimSpace(path)] = limit
+	}
+	return read, write, routes, nil
+}
+
+// AddressLimit parses the per-address budget.
+func (r RateLimit) AddressLimit() (ratelimit.Limit, error) {
+	limit, err := ratelimit.ParseLimit(r.PerAddress)
+	if err != nil {
+		return limit, fmt.Errorf("per_address: %w", err)
+	}
+	return limit, nil
+}
+
+type Metrics struct {
+	// Enabled serves Prometheus metrics on /metrics, without authentication;
+	// keep the port off the public internet or block the path at the proxy.
+	Enabled bool `yaml:"enabled"`
+}
+
+type Tracing struct {
+	// Enabled exports OpenTelemetry spans over OTLP/HTTP.
+	Enabled bool `yaml:"enabled"`
+	// Endpoint is the collector's base URL; http:// sends without TLS.
+	Endpoint    string `yaml:"endpoint"`
+	ServiceName string `yaml:"service_name"`
+	// SampleRatio is the share of new traces recorded, from 0 to 1. Traces
+	// started by a caller follow the caller's decision.
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+type Log struct {
+	// Level is debug, info, warn or error.
+	Level string `yaml:"level"`
+	// Format is json or text.
+	Format string `yaml:"format"`
+}
+
+// Default returns the settings used when nothing is configured.
+func Default() *Config {
+	return &Config{
+		Server: Server{
+			Address:         DefaultAddress,
+			ShutdownTimeout: 15 * time.Second,
+		},
+		Auth: Auth{
+			ClockSkew:          jwt.DefaultClockSkew,
+			RefreshInterval:    jwt.DefaultRefreshInterval,
+			KidRefetchInterval: jwt.DefaultKidRefetchInterval,
+			JWKSMaxAge:         time.Hour,
+		},
+		RateLimit: RateLimit{
+			Enabled:    true,
+			Read:       "300/m",
+			Write:      "60/m",
+			Store:      "memory",
+			PerAddress: "1200/m",
+		},
+		Metrics: Metrics{Enabled: true},
+		Tracing: Tracing{
+			Endpoint:    DefaultOTLPEndpoint,
+			ServiceName: DefaultServiceName,
+			SampleRatio: 1,
+		},
+		Log: Log{Level: "info", Format: logging.FormatJSON},
+	}
+}
+
+// Load reads the YAML file at path, or at CONFIG_FILE when path is empty,
+// applies environment variables and validates the result. Without a file
+// only defaults and environment variables are used.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	if path == "" {
+		path = os.Getenv("CONFIG_FILE")
+	}
+	if path != "" {
+		if err := cfg.readFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+	cfg.resolve()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// readFile overrides settings with those in a YAML file. Unknown keys are
+// rejected so that typos do not go unnoticed.
+func (c *Config) readFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// resolve fills settings that default to other settings.
+func (c *Config) resolve() {
+	a := &c.Auth
+	a.RemoteKeys = a.ServiceURL != "" || a.JWKSFile == ""
+	if a.ServiceURL == "" {
+		a.ServiceURL = DefaultAuthServiceURL
+	}
+	for _, v := range []*string{&a.Issuer, &a.Audience} {
+		switch *v {
+		case "":
+			*v = a.ServiceURL
+		case "-":
+			*v = ""
+		}
+	}
+}
+
+// Validate reports every invalid setting at once.
+func (c *Config) Validate() error {
+	var errs []error
+	fail := func(setting string, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", setting, fmt.Sprintf(format, args...)))
+	}
+
+	d := c.Database
+	if d.URL == "" {
+		fail("database.url", "is required")
+	} else if _, err := pgxpool.ParseConfig(d.URL); err != nil {
+		fail("database.url", "%v", err)
+	}
+	if d.MaxConns < 0 || d.MinConns < 0 {
+		fail("database.max_conns", "pool sizes must not be negative")
+	}
+	if d.MaxConns > 0 && d.MinConns > d.MaxConns {
+		fail("database.min_conns", "must not exceed max_conns (%d)", d.MaxConns)
+	}
+	if d.MaxConnLifetime < 0 || d.MaxConnIdleTime < 0 {
+		fail("database.max_conn_lifetime", "durations must not be negative")
+	}
+
+	s := c.Server
+	if s.Address == "" {
+		fail("server.address", "is required")
+	}
+	if s.ShutdownTimeout <= 0 {
+		fail("server.shutdown_timeout", "must be positive")
+	}
+	if (s.TLSCertFile == "") != (s.TLSKeyFile == "") {
+		fail("server.tls_cert_file", "tls_cert_file and tls_key_file must be set together")
+	}
+	for _, file := range []string{s.TLSCertFile, s.TLSKeyFile} {
+		if file == "" {
+			continue
+		}
+		if _, err := os.Stat(file); err != nil {
+			fail("server.tls", "%v", err)
+		}
+	}
+	for _, origin := range s.CORSOrigins {
+		if origin == "*" {
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") {
+			fail("server.cors_origins", "%q is not an origin such as https://archy.example", origin)
+		}
+	}
+
+	if _, err := s.TrustedProxyRanges(); err != nil {
+		fail("server.trusted_proxies", "%v", err)
+	}
+
+	a := c.Auth
+	if u, err := url.Parse(a.ServiceURL); err != nil || u.Scheme == "" || u.Host == "" {
+		fail("auth.service_url", "%q is not an absolute URL", a.ServiceURL)
+	}
+	if a.ClockSkew < 0 {
+		fail("auth.clock_skew", "must not be negative")
+	}
+	if a.RefreshInterval <= 0 {
+		fail("auth.jwks_refresh_interval", "must be positive")
+	}
+	if a.KidRefetchInterval < 0 {
+		fail("auth.jwks_kid_refetch_interval", "must not be negative")
+	}
+	if a.JWKSMaxAge < a.RefreshInterval {
+		fail("auth.jwks_max_age", "must be at least jwks_refresh_interval (%s)", a.RefreshInterval)
+	}
+	if _, err := a.Algorithms(); err != nil {
+		fail("auth.allowed_algorithms", "%v", err)
+	}
+	if a.JWKSFile != "" {
+		if _, err := jwt.LoadKeySetFile(a.JWKSFile, a.JWKSKeyID); err != nil {
+			fail("auth.jwks_file", "%v", err)
+		}
+	}
+
+	r := c.RateLimit
+	if r.Enabled {
+		if _, _, _, err := r.Limits(); err != nil {
+			fail("rate_limit", "%v", err)
+		}
+		if _, err := r.AddressLimit(); err != nil {
+			fail("rate_limit", "%v", err)
+		}
+		if r.Store != "memory" && r.Store != "postgres" {
+			fail("rate_limit.store", "must be memory or postgres, got %q", r.Store)
+		}
+	}
+
+	t := c.Tracing
+	if t.Enabled {
+		if u, err := url.Parse(t.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			fail("tracing.endpoint", "%q is not an http(s) URL", t.Endpoint)
+		}
+		if t.ServiceName == "" {
+			fail("tracing.service_name", "is required")
+		}
+	}
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		fail("tracing.sample_ratio", "must be between 0 and 1")
+	}
+
+	l := c.Log
+	if _, err := logging.ParseLevel(l.Level); err != nil {
+		fail("log.level", "must be debug, info, warn or error, got %q", l.Level)
+	}
+	if l.Format != logging.FormatJSON && l.Format != logging.FormatText {
+		fail("log.format", "must be json or text, got %q", l.Format)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Algorithms parses AllowedAlgorithms; nil keeps the verifier's default.
+func (a Auth) Algorithms() ([]jwa.SignatureAlgorithm, error) {
+	var algs []jwa.SignatureAlgorithm
+	for _, name := range a.AllowedAlgorithms {
+		var alg jwa.SignatureAlgorithm
+		if err := alg.Accept(strings.TrimSpace(name)); err != nil {
+			return nil, err
+		}
+		algs = append(algs, alg)
+	}
+	return algs, nil
+}
@@ -0,0 +1,190 @@
+package config
+
+import (
+	"archy/scores/internal/core/ratelimit"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	return path
+}
+
+// testDatabaseURL stands in for the one setting without a default.
+const testDatabaseURL = "postgres://archy@localhost:5432/archy"
+
+func TestLoad_Defaults(t *testing.T) {
+	t.Setenv("DATABASE_URL", testDatabaseURL)
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatalf("defaults should be valid: %v", err)
+	}
+
+	if cfg.Server.Address != DefaultAddress || cfg.Database.URL != testDatabaseURL {
+		t.Errorf("unexpected defaults: %+v", cfg)
+	}
+	if cfg.Auth.ServiceURL != DefaultAuthServiceURL || cfg.Auth.Issuer != DefaultAuthServiceURL ||
+		cfg.Auth.Audience != DefaultAuthServiceURL || !cfg.Auth.RemoteKeys {
+		t.Errorf("unexpected auth defaults: %+v", cfg.Auth)
+	}
+}
+
+func TestLoad_FileThenEnv(t *testing.T) {
+	path := writeConfigFile(t, `
+database:
+  url: postgres://file@db:5432/archy
+  max_conns: 20
+  max_conn_idle_time: 5m
+server:
+  address: ":8080"
+  cors_origins: [https://archy.example]
+  trusted_proxies: [10.0.0.0/8, "::1"]
+auth:
+  service_url: https://auth.archy.example
+  audience: "-"
+  jwks_refresh_interval: 1h
+rate_limit:
+  write: 10/m
+  per_address: 100/m
+  routes:
+    POST /api/rounds/:roundId/sets/:setId/shots/batch: 20/m
+`)
+	t.Setenv("DATABASE_URL", "postgres://env@db:5432/archy")
+	t.Setenv("DB_MIN_CONNS", "2")
+	t.Setenv("DB_MIGRATE_ON_START", "true")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.Database.URL != "postgres://env@db:5432/archy" {
+		t.Errorf("expected the environment to win over the file, got %q", cfg.Database.URL)
+	}
+	if cfg.Database.MaxConns != 20 || cfg.Database.MinConns != 2 || cfg.Database.MaxConnIdleTime != 5*time.Minute ||
+		!cfg.Database.MigrateOnStart {
+		t.Errorf("unexpected pool settings: %+v", cfg.Database)
+	}
+	if cfg.Server.Address != ":8080" || len(cfg.Server.CORSOrigins) != 1 {
+		t.Errorf("unexpected server settings: %+v", cfg.Server)
+	}
+	proxies, err := cfg.Server.TrustedProxyRanges()
+	if err != nil || len(proxies) != 2 || proxies[0].String() != "10.0.0.0/8" || proxies[1].String() != "::1/128" {
+		t.Errorf("unexpected trusted proxies: %v, %v", proxies, err)
+	}
+	if cfg.Auth.Issuer != "https://auth.archy.example" || cfg.Auth.Audience != "" || cfg.Auth.RefreshInterval != time.Hour {
+		t.Errorf("unexpected auth settings: %+v", cfg.Auth)
+	}
+
+	read, write, routes, err := cfg.RateLimit.Limits()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if read != ratelimit.PerMinute(300) || write != ratelimit.PerMinute(10) ||
+		routes["POST /api/rounds/:roundId/sets/:setId/shots/batch"] != ratelimit.PerMinute(20) {
+		t.Errorf("unexpected budgets: %v %v %v", read, write, routes)
+	}
+	if address, err := cfg.RateLimit.AddressLimit(); err != nil || address != ratelimit.PerMinute(100) {
+		t.Errorf("unexpected address budget: %v, %v", address, err)
+	}
+
+	pool, err := cfg.Database.PoolConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pool.MaxConns != 20 || pool.MinConns != 2 || pool.ConnConfig.User != "env" {
+		t.Errorf("unexpected pool config: max %d, min %d, user %q", pool.MaxConns, pool.MinConns, pool.ConnConfig.User)
+	}
+}
+
+func TestLoad_ConfigFileFromEnv(t *testing.T) {
+	t.Setenv("DATABASE_URL", testDatabaseURL)
+	t.Setenv("CONFIG_FILE", writeConfigFile(t, "server:\n  address: \":9090\"\n"))
+
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Server.Address != ":9090" {
+		t.Errorf("expected CONFIG_FILE to be read, got %q", cfg.Server.Address)
+	}
+}
+
+func TestLoad_MissingKeyFile(t *testing.T) {
+	t.Setenv("JWT_JWKS_FILE", filepath.Join(t.TempDir(), "missing.pem"))
+
+	_, err := Load("")
+	if err == nil || !strings.Contains(err.Error(), "auth.jwks_file") {
+		t.Fatalf("expected an error naming the key file setting, got %v", err)
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		env  map[string]string
+		want []string
+	}{
+		{"no database", "", map[string]string{"DATABASE_URL": ""}, []string{"database.url: is required"}},
+		{"unknown key", "server:\n  adress: \":80\"\n", nil, []string{"field adress not found"}},
+		{"unparsable env", "", map[string]string{"DB_MAX_CONNS": "lots", "JWT_CLOCK_SKEW": "soon"},
+			[]string{"DB_MAX_CONNS must be an integer", "JWT_CLOCK_SKEW must be a duration"}},
+		{"every invalid setting is reported", "", map[string]string{
+			"DB_MIN_CONNS":                "10",
+			"DB_MAX_CONNS":                "5",
+			"TLS_CERT_FILE":               "cert.pem",
+			"CORS_ALLOWED_ORIGINS":        "archy.example",
+			"TRUSTED_PROXIES":             "10.0.0.0/8,proxy.internal",
+			"JWT_ALLOWED_ALGORITHMS":      "none-such",
+			"RATE_LIMIT_WRITE":            "fast",
+			"RATE_LIMIT_STORE":            "redis",
+			"RATE_LIMIT_PER_ADDRESS":      "many",
+			"TRACING_ENABLED":             "true",
+			"OTEL_EXPORTER_OTLP_ENDPOINT": "localhost:4318",
+			"TRACING_SAMPLE_RATIO":        "2",
+			"LOG_LEVEL":                   "loud",
+		}, []string{
+			"database.min_conns", "server.tls_cert_file", "server.cors_origins", "server.trusted_proxies",
+			"auth.allowed_algorithms", "rate_limit: write", "rate_limit: per_address", "rate_limit.store",
+			"tracing.endpoint", "tracing.sample_ratio", "log.level",
+		}},
+		{"bad route", "", map[string]string{"RATE_LIMIT_ROUTES": "/api/rounds=10/m"}, []string{`route "/api/rounds"`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			path := ""
+			if tt.file != "" {
+				path = writeConfigFile(t, tt.file)
+			}
+
+			_, err := Load(path)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected %q in error:\n%v", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestLoad_ExampleFile(t *testing.T) {
+	if _, err := Load(filepath.Join("..", "..", "config.example.yaml")); err != nil {
+		t.Errorf("config.example.yaml should be valid: %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// applyEnv overrides settings with environment variables. Variables that are
+// unset or empty leave the setting alone.
+func (c *Config) applyEnv() error {
+	e := &envReader{}
+
+	e.string("DATABASE_URL", &c.Database.URL)
+	e.int32("DB_MAX_CONNS", &c.Database.MaxConns)
+	e.int32("DB_MIN_CONNS", &c.Database.MinConns)
+	e.duration("DB_MAX_CONN_LIFETIME", &c.Database.MaxConnLifetime)
+	e.duration("DB_MAX_CONN_IDLE_TIME", &c.Database.MaxConnIdleTime)
+	e.bool("DB_MIGRATE_ON_START", &c.Database.MigrateOnStart)
+
+	e.string("LISTEN_ADDR", &c.Server.Address)
+	e.string("TLS_CERT_FILE", &c.Server.TLSCertFile)
+	e.string("TLS_KEY_FILE", &c.Server.TLSKeyFile)
+	e.list("CORS_ALLOWED_ORIGINS", ",", &c.Server.CORSOrigins)
+	e.list("TRUSTED_PROXIES", ",", &c.Server.TrustedProxies)
+	e.duration("SHUTDOWN_TIMEOUT", &c.Server.ShutdownTimeout)
+
+	e.string("AUTH_SERVICE_URL", &c.Auth.ServiceURL)
+	e.string("JWT_ISSUER", &c.Auth.Issuer)
+	e.string("JWT_AUDIENCE", &c.Auth.Audience)
+	e.duration("JWT_CLOCK_SKEW", &c.Auth.ClockSkew)
+	e.list("JWT_ALLOWED_ALGORITHMS", ",", &c.Auth.AllowedAlgorithms)
+	e.list("JWT_REQUIRED_CLAIMS", ",", &c.Auth.RequiredClaims)
+	e.string("JWT_JWKS_FILE", &c.Auth.JWKSFile)
+	e.string("JWT_JWKS_KEY_ID", &c.Auth.JWKSKeyID)
+	e.duration("JWKS_REFRESH_INTERVAL", &c.Auth.RefreshInterval)
+	e.duration("JWKS_KID_REFETCH_INTERVAL", &c.Auth.KidRefetchInterval)
+	e.duration("JWKS_MAX_AGE", &c.Auth.JWKSMaxAge)
+
+	e.bool("RATE_LIMIT_ENABLED", &c.RateLimit.Enabled)
+	e.string("RATE_LIMIT_READ", &c.RateLimit.Read)
+	e.string("RATE_LIMIT_WRITE", &c.RateLimit.Write)
+	e.string("RATE_LIMIT_STORE", &c.RateLimit.Store)
+	e.string("RATE_LIMIT_PER_ADDRESS", &c.RateLimit.PerAddress)
+	e.routes("RATE_LIMIT_ROUTES", &c.RateLimit.Routes)
+
+	e.bool("METRICS_ENABLED", &c.Metrics.Enabled)
+
+	e.bool("TRACING_ENABLED", &c.Tracing.Enabled)
+	e.string("OTEL_EXPORTER_OTLP_ENDPOINT", &c.Tracing.Endpoint)
+	e.string("OTEL_SERVICE_NAME", &c.Tracing.ServiceName)
+	e.float("TRACING_SAMPLE_RATIO", &c.Tracing.SampleRatio)
+
+	e.string("LOG_LEVEL", &c.Log.Level)
+	e.string("LOG_FORMAT", &c.Log.Format)
+
+	return errors.Join(e.errs...)
+}
+
+// envReader reads typed environment variables and collects parse errors.
+type envReader struct {
+	errs []error
+}
+
+func (e *envReader) lookup(name string) (string, bool) {
+	v := strings.TrimSpace(os.Getenv(name))
+	return v, v != ""
+}
+
+func (e *envReader) fail(name, v, want string) {
+	e.errs = append(e.errs, fmt.Errorf("%s must be %s, got %q", name, want, v))
+}
+
+func (e *envReader) string(name string, dst *string) {
+	if v, ok := e.lookup(name); ok {
+		*dst = v
+	}
+}
+
+func (e *envReader) int32(name string, dst *int32) {
+	v, ok := e.lookup(name)
+	if !ok {
+		return
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		e.fail(name, v, "an integer")
+		return
+	}
+	*dst = int32(n)
+}
+
+func (e *envReader) bool(name string, dst *bool) {
+	v, ok := e.lookup(name)
+	if !ok {
+		return
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		e.fail(name, v, "true or false")
+		return
+	}
+	*dst = b
+}
+
+func (e *envReader) float(name string, dst *float64) {
+	v, ok := e.lookup(name)
+	if !ok {
+		return
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		e.fail(name, v, "a number")
+		return
+	}
+	*dst = f
+}
+
+func (e *envReader) duration(name string, dst *time.Duration) {
+	v, ok := e.lookup(name)
+	if !ok {
+		return
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		e.fail(name, v, "a duration such as 30s")
+		return
+	}
+	*dst = d
+}
+
+func (e *envReader) list(name, sep string, dst *[]string) {
+	v, ok := e.lookup(name)
+	if !ok {
+		return
+	}
+	var values []string
+	for _, item := range strings.Split(v, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	*dst = values
+}
+
+// routes reads a semicolon-separated list of "METHOD /path=N/unit".
+func (e *envReader) routes(name string, dst *map[string]string) {
+	v, ok := e.lookup(name)
+	if !ok {
+		return
+	}
+	routes := map[string]string{}
+	for _, entry := range strings.Split(v, ";") {
+		if entry = strings.TrimSpace(entry); entry == "" {
+			continue
+		}
+		route, budget, ok := strings.Cut(entry, "=")
+		if !ok {
+			e.fail(name, entry, `a list of "METHOD /path=N/unit"`)
+			return
+		}
+		routes[strings.TrimSpace(route)] = strings.TrimSpace(budget)
+	}
+	*dst = routes
+}
@@ -0,0 +1,103 @@
+// Package apperr defines the errors services return when a request cannot be
+// served as asked: something is missing, not allowed, invalid or in conflict
+// with the current state. The HTTP layer renders them as problem details
+// with their message; any other error is an internal failure whose details
+// stay in the logs.
+package apperr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind classifies an Error; each kind maps to one HTTP status.
+type Kind int
+
+const (
+	KindValidation Kind = iota + 1
+	KindUnauthorized
+	KindForbidden
+	KindNotFound
+	KindConflict
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindValidation:
+		return "validation"
+	case KindUnauthorized:
+		return "unauthorized"
+	case KindForbidden:
+		return "forbidden"
+	case KindNotFound:
+		return "not_found"
+	case KindConflict:
+		return "conflict"
+	default:
+		return "internal"
+	}
+}
+
+// FieldError explains why one field of a request is invalid. Field is the
+// JSON name of the field, or a query parameter.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error is a failure the caller can act on. Its message, and that of any
+// error wrapping it, is shown to the caller, so it must not carry internal
+// details. Services usually return package-level sentinels, optionally
+// wrapped with fmt.Errorf("%w: ...") to say which value was wrong.
+type Error struct {
+	Kind    Kind
+	Message string
+	Fields  []FieldError
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func newError(kind Kind, format string, args []any) *Error {
+	return &Error{Kind: kind, Message: fmt.Sprintf(format, args...)}
+}
+
+// NotFound reports a resource that does not exist, or that the caller may
+// not know exists.
+func NotFound(format string, args ...any) *Error {
+	return newError(KindNotFound, format, args)
+}
+
+// Forbidden reports a resource the caller can see but not act on.
+func Forbidden(format string, args ...any) *Error {
+	return newError(KindForbidden, format, args)
+}
+
+// Unauthorized reports a request without valid credentials.
+func Unauthorized(format string, args ...any) *Error {
+	return newError(KindUnauthorized, format, args)
+}
+
+// Conflict reports a request that clashes with the resource's state, e.g.
+// changing signed scores.
+func Conflict(format string, args ...any) *Error {
+	return newError(KindConflict, format, args)
+}
+
+// Validation reports an invalid request, optionally field by field.
+func Validation(message string, fields ...FieldError) *Error {
+	return &Error{Kind: KindValidation, Message: message, Fields: fields}
+}
+
+// InvalidField reports a single invalid field.
+func InvalidField(field, message string) *Error {
+	return Validation(message, FieldError{Field: field, Message: message})
+}
+
+// As returns the *Error in err's chain, if any.
+func As(err error) (*Error, bool) {
+	var e *Error
+	ok := errors.As(err, &e)
+	return e, ok
+}
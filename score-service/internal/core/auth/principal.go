@@ -0,0 +1,174 @@
+// Package auth describes who is making a request and what they may do.
+package auth
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+// Roles known to the service. Roles come from the token's "roles" or "role"
+// claim and from the user_roles table.
+const (
+	RoleCoach = "coach"
+	RoleAdmin = "admin"
+	RoleJudge = "judge"
+)
+
+// Scopes required by routes. Scopes come from the token's "scope" or "scopes"
+// claim or are implied by a role.
+const (
+	ScopeScoresRead       = "scores:read"
+	ScopeScoresWrite      = "scores:write"
+	ScopeTargetFacesWrite = "targetfaces:write"
+	ScopeCoachesWrite     = "coaches:write"
+)
+
+// UserScopes are held by every user signed in with a JWT. Personal access
+// tokens only hold the scopes they were created with.
+var UserScopes = []string{ScopeScoresRead, ScopeScoresWrite}
+
+// Token types a principal can be authenticated with.
+const (
+	TokenTypeJWT      = "jwt"
+	TokenTypePersonal = "personal"
+)
+
+// RoleScopes lists the scopes each role implies. Admins hold every scope.
+var RoleScopes = map[string][]string{
+	RoleCoach: {},
+	RoleJudge: {},
+	RoleAdmin: {ScopeTargetFacesWrite, ScopeCoachesWrite},
+}
+
+// Principal is the authenticated caller.
+type Principal struct {
+	UserID    string
+	TokenType string
+	TokenID   string // jti of a JWT or ID of a personal access token
+	Roles     []string
+	Scopes    []string
+
+	// LocalRolesLoaded reports whether roles from the user_roles table have
+	// been merged into Roles.
+	LocalRolesLoaded bool
+}
+
+// FromToken builds a principal from a verified token.
+func FromToken(token jwt.Token) *Principal {
+	p := &Principal{
+		UserID:    token.Subject(),
+		TokenType: TokenTypeJWT,
+		TokenID:   token.JwtID(),
+	}
+
+	for _, name := range []string{"roles", "role"} {
+		if v, ok := token.Get(name); ok {
+			p.AddRoles(claimValues(v, ",")...)
+		}
+	}
+	for _, name := range []string{"scope", "scopes", "scp"} {
+		if v, ok := token.Get(name); ok {
+			p.Scopes = appendUnique(p.Scopes, claimValues(v, " ")...)
+		}
+	}
+
+	return p
+}
+
+// AddRoles grants additional roles to the principal.
+func (p *Principal) AddRoles(roles ...string) {
+	p.Roles = appendUnique(p.Roles, roles...)
+}
+
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// IsPersonalToken reports whether the principal authenticated with a personal
+// access token rather than a JWT from the auth-service.
+func (p *Principal) IsPersonalToken() bool {
+	return p.TokenType == TokenTypePersonal
+}
+
+// HasScope reports whether the principal holds scope directly or through one
+// of its roles. Personal access tokens are limited to their own scopes, and
+// a scope that only a role implies is honoured while the user still holds
+// such a role, so removing the role takes it away from existing tokens.
+func (p *Principal) HasScope(scope string) bool {
+	if p.IsPersonalToken() {
+		return slices.Contains(p.Scopes, scope) && (!IsRoleScope(scope) || p.hasRoleScope(scope))
+	}
+	return slices.Contains(p.Scopes, scope) || slices.Contains(UserScopes, scope) || p.hasRoleScope(scope)
+}
+
+// IsRoleScope reports whether scope is implied by a role rather than held by
+// every user.
+func IsRoleScope(scope string) bool {
+	for _, scopes := range RoleScopes {
+		if slices.Contains(scopes, scope) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Principal) hasRoleScope(scope string) bool {
+	for _, role := range p.Roles {
+		if slices.Contains(RoleScopes[role], scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// claimValues reads a claim that is either a list of strings or a single
+// string of values separated by sep, as used by the OAuth "scope" claim and
+// better-auth's admin plugin "role" claim.
+func claimValues(v any, sep string) []string {
+	var raw []string
+	switch v := v.(type) {
+	case string:
+		raw = strings.Split(v, sep)
+	case []string:
+		raw = v
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				raw = append(raw, s)
+			}
+		}
+	}
+
+	values := make([]string, 0, len(raw))
+	for _, s := range raw {
+		if s = strings.TrimSpace(s); s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+func appendUnique(dst []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(dst, v) {
+			dst = append(dst, v)
+		}
+	}
+	return dst
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying p.
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the principal stored by WithPrincipal.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+func TestFromToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		claims     map[string]any
+		wantRoles  []string
+		wantScopes []string
+	}{
+		{
+			name:   "no roles or scopes",
+			claims: map[string]any{},
+		},
+		{
+			name:      "roles array",
+			claims:    map[string]any{"roles": []any{"coach", "admin"}},
+			wantRoles: []string{"coach", "admin"},
+		},
+		{
+			name:      "comma separated role",
+			claims:    map[string]any{"role": "user, coach"},
+			wantRoles: []string{"user", "coach"},
+		},
+		{
+			name:       "space separated scope",
+			claims:     map[string]any{"scope": "targetfaces:write  rounds:read"},
+			wantScopes: []string{"targetfaces:write", "rounds:read"},
+		},
+		{
+			name:       "scopes array and duplicates",
+			claims:     map[string]any{"scopes": []any{"a", "a"}, "scp": "b"},
+			wantScopes: []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := jwt.New()
+			_ = token.Set(jwt.SubjectKey, "user-1")
+			_ = token.Set(jwt.JwtIDKey, "jti-1")
+			for k, v := range tt.claims {
+				_ = token.Set(k, v)
+			}
+
+			p := FromToken(token)
+			if p.UserID != "user-1" || p.TokenID != "jti-1" {
+				t.Errorf("got user %q token %q", p.UserID, p.TokenID)
+			}
+			if !slices.Equal(p.Roles, tt.wantRoles) {
+				t.Errorf("roles = %v, want %v", p.Roles, tt.wantRoles)
+			}
+			if !slices.Equal(p.Scopes, tt.wantScopes) {
+				t.Errorf("scopes = %v, want %v", p.Scopes, tt.wantScopes)
+			}
+		})
+	}
+}
+
+func TestPrincipal_HasScope(t *testing.T) {
+	admin := &Principal{Roles: []string{RoleAdmin}}
+	coach := &Principal{Roles: []string{RoleCoach}}
+	scoped := &Principal{Scopes: []string{ScopeTargetFacesWrite}}
+
+	if !admin.HasScope(ScopeTargetFacesWrite) || !admin.HasScope(ScopeCoachesWrite) {
+		t.Error("admin should hold every scope")
+	}
+	if coach.HasScope(ScopeTargetFacesWrite) {
+		t.Error("coach should not be able to write target faces")
+	}
+	if !scoped.HasScope(ScopeTargetFacesWrite) || scoped.HasScope(ScopeCoachesWrite) {
+		t.Error("scopes from the token should be honoured exactly")
+	}
+}
+
+func TestPrincipal_HasScope_PersonalToken(t *testing.T) {
+	readOnly := &Principal{TokenType: TokenTypePersonal, Scopes: []string{ScopeScoresRead}}
+	adminToken := &Principal{TokenType: TokenTypePersonal, Roles: []string{RoleAdmin}}
+	session := &Principal{TokenType: TokenTypeJWT}
+	faces := &Principal{TokenType: TokenTypePersonal, Scopes: []string{ScopeTargetFacesWrite}}
+
+	if !readOnly.HasScope(ScopeScoresRead) || readOnly.HasScope(ScopeScoresWrite) {
+		t.Error("personal token should hold exactly its own scopes")
+	}
+	if adminToken.HasScope(ScopeTargetFacesWrite) {
+		t.Error("personal token should not inherit scopes from roles")
+	}
+	if !session.HasScope(ScopeScoresRead) || !session.HasScope(ScopeScoresWrite) {
+		t.Error("signed in users should hold the user scopes")
+	}
+	if faces.HasScope(ScopeTargetFacesWrite) {
+		t.Error("personal token should lose a role scope once the role is gone")
+	}
+	faces.AddRoles(RoleAdmin)
+	if !faces.HasScope(ScopeTargetFacesWrite) || faces.HasScope(ScopeCoachesWrite) {
+		t.Error("personal token should keep a role scope while the role is held")
+	}
+}
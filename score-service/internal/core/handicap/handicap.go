@@ -0,0 +1,141 @@
+// Package handicap implements the Archery GB (2023) handicap scheme: a handicap
+// number describes an archer's angular dispersion, which together with the
+// target face geometry and distance gives an expected score for any round.
+package handicap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// MinHandicap and MaxHandicap bound the published Archery GB scale.
+	MinHandicap = 0
+	MaxHandicap = 150
+
+	// ArrowDiameter is the nominal arrow diameter in mm used by Archery GB tables.
+	ArrowDiameter = 5.5
+
+	// Archery GB 2023 scheme parameters.
+	datum     = 6.0
+	step      = 3.5    // percent increase in dispersion per handicap point
+	angle0    = 5.0e-4 // radians
+	kDistance = 0.00365
+)
+
+var ErrInvalidFace = errors.New("invalid target face zones")
+
+// Zone is a single scoring ring of a target face, as stored in target_faces.zones_config.
+type Zone struct {
+	Score  int     `json:"score"`
+	Radius float64 `json:"radius"` // outer radius in mm
+}
+
+// Face is a set of scoring zones ordered from the centre outwards.
+type Face []Zone
+
+// ParseFace decodes target_faces.zones_config into a Face.
+func ParseFace(zonesConfig []byte) (Face, error) {
+	var zones []Zone
+	if err := json.Unmarshal(zonesConfig, &zones); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFace, err)
+	}
+	if len(zones) == 0 {
+		return nil, ErrInvalidFace
+	}
+	for _, z := range zones {
+		if z.Radius <= 0 || z.Score < 0 {
+			return nil, ErrInvalidFace
+		}
+	}
+
+	sort.Slice(zones, func(i, j int) bool { return zones[i].Radius < zones[j].Radius })
+	return zones, nil
+}
+
+// MaxScore returns the highest score of a single arrow on the face.
+func (f Face) MaxScore() int {
+	best := 0
+	for _, z := range f {
+		if z.Score > best {
+			best = z.Score
+		}
+	}
+	return best
+}
+
+// Dispersion returns the RMS radial group size in mm for a handicap at a
+// distance given in meters.
+func Dispersion(handicap, distance float64) float64 {
+	sigmaTheta := angle0 * math.Pow(1+step/100, handicap+datum) * math.Exp(kDistance*distance)
+	return distance * 1000 * sigmaTheta
+}
+
+// ExpectedArrowScore is the mean score of one arrow shot with the given handicap.
+// Arrow impacts are modelled as a bivariate normal distribution around the centre,
+// so the probability of landing within radius r is 1 - exp(-r²/σ²).
+func ExpectedArrowScore(face Face, distance, handicap float64) float64 {
+	sigma := Dispersion(handicap, distance)
+
+	expected := 0.0
+	for i, z := range face {
+		next := 0
+		if i+1 < len(face) {
+			next = face[i+1].Score
+		}
+		r := z.Radius + ArrowDiameter/2
+		expected += float64(z.Score-next) * (1 - math.Exp(-(r*r)/(sigma*sigma)))
+	}
+	return expected
+}
+
+// ExpectedScore is the expected total score of a round of the given number of arrows.
+func ExpectedScore(face Face, distance float64, arrows int, handicap float64) float64 {
+	return float64(arrows) * ExpectedArrowScore(face, distance, handicap)
+}
+
+// PredictScore rounds ExpectedScore to a whole score, as in published score tables.
+func PredictScore(face Face, distance float64, arrows int, handicap int) int {
+	return int(math.Round(ExpectedScore(face, distance, arrows, float64(handicap))))
+}
+
+// FromScore returns the handicap achieved by shooting score over the given
+// number of arrows: the lowest whole handicap whose predicted score does not
+// exceed the score shot, clamped to the published scale.
+func FromScore(face Face, distance float64, arrows, score int) (int, error) {
+	if arrows <= 0 {
+		return 0, errors.New("arrows must be positive")
+	}
+	if score < 0 || score > arrows*face.MaxScore() {
+		return 0, fmt.Errorf("score %d is outside 0-%d", score, arrows*face.MaxScore())
+	}
+
+	// Predicted scores fall monotonically with the handicap, so binary search
+	// for the first handicap that the score reaches.
+	lo, hi := MinHandicap, MaxHandicap
+	for lo < hi {
+		mid := (lo + hi) / 2
+		if PredictScore(face, distance, arrows, mid) <= score {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, nil
+}
+
+// Rolling returns the rolling handicap from the most recent round handicaps:
+// their average, rounded up.
+func Rolling(handicaps []int) int {
+	if len(handicaps) == 0 {
+		return MaxHandicap
+	}
+	sum := 0
+	for _, h := range handicaps {
+		sum += h
+	}
+	return int(math.Ceil(float64(sum) / float64(len(handicaps))))
+}
@@ -0,0 +1,69 @@
+package handicap
+
+import "testing"
+
+var wa122 = []byte(`[
+	{"score": 10, "radius": 61.0}, {"score": 9, "radius": 122.0},
+	{"score": 8, "radius": 183.0}, {"score": 7, "radius": 244.0},
+	{"score": 6, "radius": 305.0}, {"score": 5, "radius": 366.0},
+	{"score": 4, "radius": 427.0}, {"score": 3, "radius": 488.0},
+	{"score": 2, "radius": 549.0}, {"score": 1, "radius": 610.0}
+]`)
+
+func TestPredictScore_Monotonic(t *testing.T) {
+	face, err := ParseFace(wa122)
+	if err != nil {
+		t.Fatalf("failed to parse face: %v", err)
+	}
+
+	prev := PredictScore(face, 70, 72, MinHandicap)
+	for h := MinHandicap + 1; h <= MaxHandicap; h++ {
+		score := PredictScore(face, 70, 72, h)
+		if score > prev {
+			t.Fatalf("predicted score increased from %d to %d at handicap %d", prev, score, h)
+		}
+		prev = score
+	}
+
+	if best := PredictScore(face, 70, 72, MinHandicap); best < 690 || best > 720 {
+		t.Errorf("expected an elite score at handicap 0, got %d", best)
+	}
+	if worst := PredictScore(face, 70, 72, MaxHandicap); worst > 10 {
+		t.Errorf("expected a near-zero score at handicap 150, got %d", worst)
+	}
+}
+
+func TestFromScore_RoundTrip(t *testing.T) {
+	face, err := ParseFace(wa122)
+	if err != nil {
+		t.Fatalf("failed to parse face: %v", err)
+	}
+
+	for _, h := range []int{5, 20, 35, 50, 80} {
+		score := PredictScore(face, 70, 72, h)
+		got, err := FromScore(face, 70, 72, score)
+		if err != nil {
+			t.Fatalf("FromScore(%d): %v", score, err)
+		}
+		// Neighbouring handicaps can share a predicted score; the lowest one wins.
+		if got > h || PredictScore(face, 70, 72, got) != score {
+			t.Errorf("FromScore(%d) = %d, want %d", score, got, h)
+		}
+	}
+
+	if got, _ := FromScore(face, 70, 72, 720); got != MinHandicap {
+		t.Errorf("a perfect score should give handicap %d, got %d", MinHandicap, got)
+	}
+	if _, err := FromScore(face, 70, 72, 721); err == nil {
+		t.Error("expected an error for a score above the maximum")
+	}
+}
+
+func TestRolling(t *testing.T) {
+	if got := Rolling([]int{40, 41, 43}); got != 42 {
+		t.Errorf("Rolling = %d, want 42", got)
+	}
+	if got := Rolling(nil); got != MaxHandicap {
+		t.Errorf("Rolling(nil) = %d, want %d", got, MaxHandicap)
+	}
+}
@@ -0,0 +1,54 @@
+package logging
+
+import (
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/requestid"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+)
+
+func TestNew_TagsRecordsFromContext(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, FormatJSON, slog.LevelInfo)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx := requestid.WithID(context.Background(), "req-1")
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{UserID: "user-1"})
+	logger.With("component", "test").InfoContext(ctx, "shot recorded", "shots", 3)
+	logger.DebugContext(ctx, "below the level")
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("expected exactly one JSON record, got %q: %v", buf.String(), err)
+	}
+	for key, want := range map[string]any{
+		"msg": "shot recorded", "request_id": "req-1", "user_id": "user-1", "component": "test", "shots": float64(3),
+	} {
+		if record[key] != want {
+			t.Errorf("%s = %v, want %v", key, record[key], want)
+		}
+	}
+	if _, ok := record["trace_id"]; ok {
+		t.Error("expected no trace_id outside a span")
+	}
+}
+
+func TestNew_UnknownFormat(t *testing.T) {
+	if _, err := New(&bytes.Buffer{}, "xml", slog.LevelInfo); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	if level, err := ParseLevel("warn"); err != nil || level != slog.LevelWarn {
+		t.Errorf("ParseLevel(warn) = %v, %v", level, err)
+	}
+	if _, err := ParseLevel("loud"); err == nil {
+		t.Error("expected an error for an unknown level")
+	}
+}
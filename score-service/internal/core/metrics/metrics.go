@@ -0,0 +1,118 @@
+// Package metrics holds the score-service's Prometheus collectors. They are
+// registered with Registry, which Handler serves on /metrics.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "archy"
+
+// Registry holds every collector of the service, plus the Go runtime and
+// process collectors.
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+// HTTP requests, labelled by the route as registered, e.g.
+// /api/rounds/:roundId, so that IDs do not create new series.
+var (
+	HTTPRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "HTTP requests by method, route and status code.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by method and route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	HTTPRequestsInFlight = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "HTTP requests currently being served.",
+	})
+)
+
+// DBQueryDuration times database queries by their sqlc query name.
+var DBQueryDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: "db",
+	Name:      "query_duration_seconds",
+	Help:      "Database query latency by sqlc query name and outcome.",
+	Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+}, []string{"query", "result"})
+
+// JWKSFetches counts requests for the auth-service's key set.
+var JWKSFetches = factory.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "jwks",
+	Name:      "fetches_total",
+	Help:      "Requests for the auth-service's key set by result (success or failure).",
+}, []string{"result"})
+
+// Domain counters.
+var (
+	RoundsCreated = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rounds_created_total",
+		Help:      "Qualification rounds created.",
+	})
+
+	RoundsCompleted = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rounds_completed_total",
+		Help:      "Qualification rounds completed.",
+	})
+
+	SetsRecorded = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sets_recorded_total",
+		Help:      "Sets (ends) recorded.",
+	})
+
+	ShotsRecorded = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "shots_recorded_total",
+		Help:      "Shots recorded, singly or in batches.",
+	})
+
+	ScoresSigned = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "score_signatures_total",
+		Help:      "Score sign-offs by signer role.",
+	}, []string{"role"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// ObserveJWKSFetch counts a key set request; it is passed to
+// jwt.WithFetchHook.
+func ObserveJWKSFetch(err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	JWKSFetches.WithLabelValues(result).Inc()
+}
+
+// Handler serves the registry in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestObserveJWKSFetch(t *testing.T) {
+	success := testutil.ToFloat64(JWKSFetches.WithLabelValues("success"))
+	failure := testutil.ToFloat64(JWKSFetches.WithLabelValues("failure"))
+
+	ObserveJWKSFetch(nil)
+	ObserveJWKSFetch(errors.New("connection refused"))
+	ObserveJWKSFetch(errors.New("connection refused"))
+
+	if got := testutil.ToFloat64(JWKSFetches.WithLabelValues("success")) - success; got != 1 {
+		t.Errorf("expected 1 success, got %v", got)
+	}
+	if got := testutil.ToFloat64(JWKSFetches.WithLabelValues("failure")) - failure; got != 2 {
+		t.Errorf("expected 2 failures, got %v", got)
+	}
+}
+
+func TestPoolCollector(t *testing.T) {
+	// The pool connects lazily, so no database is needed to read its stats.
+	pool, err := pgxpool.New(context.Background(), "postgres://archy@localhost:1/archy?pool_max_conns=7")
+	if err != nil {
+		t.Fatalf("failed to create pool: %v", err)
+	}
+	defer pool.Close()
+
+	collector := NewPoolCollector(pool)
+	if n := testutil.CollectAndCount(collector); n != 12 {
+		t.Errorf("expected 12 pool metrics, got %d", n)
+	}
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP archy_db_pool_max_connections Maximum size of the pool.
+# TYPE archy_db_pool_max_connections gauge
+archy_db_pool_max_connections 7
+`), "archy_db_pool_max_connections"); err != nil {
+		t.Error(err)
+	}
+}
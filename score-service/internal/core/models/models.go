@@ -1,43 +1,95 @@
 package models
 
 import (
+	"archy/scores/internal/db"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Round types.
+const (
+	RoundTypeTraining      = "training"
+	RoundTypeQualification = "qualification"
+	RoundTypePractice      = "practice"
+	RoundTypeWarmup        = "warmup"
+)
+
+var RoundTypes = []string{RoundTypeTraining, RoundTypeQualification, RoundTypePractice, RoundTypeWarmup}
+
+// Bow classes used for handicaps and classifications.
+const (
+	BowClassRecurve  = "recurve"
+	BowClassCompound = "compound"
+	BowClassBarebow  = "barebow"
+	BowClassLongbow  = "longbow"
+)
+
+var BowClasses = []string{BowClassRecurve, BowClassCompound, BowClassBarebow, BowClassLongbow}
+
+func IsValidBowClass(bowClass string) bool {
+	for _, c := range BowClasses {
+		if c == bowClass {
+			return true
+		}
+	}
+	return false
+}
+
+// Age categories and genders used for classifications.
+var (
+	AgeCategories = []string{"adult", "50+", "u21", "u18", "u16", "u15", "u14", "u12"}
+	Genders       = []string{"male", "female"}
+)
+
+const AgeCategoryAdult = "adult"
+
+// Request models are checked by the validation package against their
+// validate tags before they reach a service.
+
 type CreateQualificationRoundRequest struct {
-	RoundType    string     `json:"round_type"` // training, qualification, practice, warmup
-	Name         string     `json:"name"`
-	Distance     int        `json:"distance"` // in meters
-	TotalSets    int        `json:"total_sets"`
-	ShotsPerSet  int        `json:"shots_per_set"`
-	TargetFaceID uuid.UUID  `json:"target_face_id"`
-	Notes        string     `json:"notes,omitempty"`
-	StartTime    *time.Time `json:"start_time,omitempty"`
+	RoundType       string     `json:"round_type" validate:"required,round_type"` // training, qualification, practice, warmup
+	Name            string     `json:"name" validate:"required,notblank,max=100"`
+	Distance        int        `json:"distance" validate:"min=1,max=100"` // in meters
+	TotalSets       int        `json:"total_sets" validate:"min=1,max=100"`
+	ShotsPerSet     int        `json:"shots_per_set" validate:"min=1,max=12"`
+	TargetFaceID    uuid.UUID  `json:"target_face_id" validate:"required"`
+	BowClass        string     `json:"bow_class,omitempty" validate:"omitempty,bow_class"` // recurve (default), compound, barebow, longbow
+	RoundTemplateID *uuid.UUID `json:"round_template_id,omitempty"`
+	AgeCategory     string     `json:"age_category,omitempty" validate:"omitempty,age_category"` // adult (default), 50+, u21, u18, u16, u15, u14, u12
+	Gender          string     `json:"gender,omitempty" validate:"omitempty,gender"`             // male, female; required for classifications
+	Notes           string     `json:"notes,omitempty" validate:"max=2000"`
+	StartTime       *time.Time `json:"start_time,omitempty"`
 }
 
 type CreateSetRequest struct {
-	SetNumber     int       `json:"set_number"`
-	MaxShots      *int      `json:"max_shots,omitempty"`
-	ParentRoundId uuid.UUID `json:"parent_round_id"`
+	SetNumber     int       `json:"set_number" validate:"min=1,max=100"`
+	MaxShots      *int      `json:"max_shots,omitempty" validate:"omitempty,min=1,max=12"` // defaults to the round's shots_per_set
+	ParentRoundId uuid.UUID `json:"parent_round_id"`                                       // ignored, the round is taken from the URL
+	Reason        string    `json:"reason,omitempty" validate:"max=1000"`                  // required for a judge adding to a signed round
 }
 
+// Shot coordinates are offsets from the centre of the face in mm; the
+// largest face is 1220 mm across.
 type CreateShotRequest struct {
-	X     float64 `json:"x"` // horizontal offset in mm
-	Y     float64 `json:"y"` // vertical offset in mm
-	Score int8    `json:"score"`
-	Notes string  `json:"notes,omitempty"`
+	X      *float64 `json:"x" validate:"required,min=-700,max=700"` // horizontal offset in mm
+	Y      *float64 `json:"y" validate:"required,min=-700,max=700"` // vertical offset in mm
+	Score  int8     `json:"score"`                                  // ignored, the score is computed from the coordinates
+	Notes  string   `json:"notes,omitempty" validate:"max=1000"`
+	Reason string   `json:"reason,omitempty" validate:"max=1000"` // required for a judge adding to a signed end
 }
 
 type CreateShotsBatchRequest struct {
-	Shots []CreateShotRequest `json:"shots"`
+	Shots  []CreateShotRequest `json:"shots" validate:"min=1,max=12,dive"`
+	Reason string              `json:"reason,omitempty" validate:"max=1000"` // required for a judge adding to a signed end
 }
 
 type UpdateShotRequest struct {
-	X     *float64 `json:"x,omitempty"`
-	Y     *float64 `json:"y,omitempty"`
-	Notes *string  `json:"notes,omitempty"`
+	X      *float64 `json:"x,omitempty" validate:"required_without_all=Y Notes,omitempty,min=-700,max=700"`
+	Y      *float64 `json:"y,omitempty" validate:"omitempty,min=-700,max=700"`
+	Notes  *string  `json:"notes,omitempty" validate:"omitempty,max=1000"`
+	Reason string   `json:"reason,omitempty" validate:"max=1000"` // required for a judge changing a signed end
 }
 
 type QualificationRoundResponse struct {
@@ -93,3 +145,156 @@ type ShotResponse struct {
 	CreatedAt          time.Time `json:"created_at"`
 	UpdatedAt          time.Time `json:"updated_at"`
 }
+
+type FatigueAnalysisFilter struct {
+	RoundType *string
+	Distance  *int
+}
+
+type FatigueBucket struct {
+	Position         int      `json:"position"` // set_number or arrow order within an end
+	Arrows           int      `json:"arrows"`
+	AverageScore     float64  `json:"average_score"`
+	AverageDistance  float64  `json:"average_distance_from_center"` // in mm
+	GroupingDiameter *float64 `json:"grouping_diameter,omitempty"`  // in mm (2 * standard deviation)
+}
+
+type FatigueTrend struct {
+	Dimension   string  `json:"dimension"` // set_number, arrow_number
+	Metric      string  `json:"metric"`    // score, distance_from_center
+	Slope       float64 `json:"slope"`
+	Intercept   float64 `json:"intercept"`
+	RSquared    float64 `json:"r_squared"`
+	PValue      float64 `json:"p_value"`
+	Significant bool    `json:"significant"`
+	Declining   bool    `json:"declining"` // significant and in the direction of worse performance
+}
+
+type FatigueAnalysisResponse struct {
+	Rounds          int             `json:"rounds"`
+	Arrows          int             `json:"arrows"`
+	BySetNumber     []FatigueBucket `json:"by_set_number"`
+	ByArrowNumber   []FatigueBucket `json:"by_arrow_number"`
+	Trends          []FatigueTrend  `json:"trends"`
+	DeclineDetected bool            `json:"decline_detected"`
+}
+
+type ScorePrediction struct {
+	RoundTemplateID uuid.UUID `json:"round_template_id"`
+	Name            string    `json:"name"`
+	Distance        int       `json:"distance"` // in meters
+	Arrows          int       `json:"arrows"`
+	MaxScore        int       `json:"max_score"`
+	ExpectedScore   int       `json:"expected_score"`
+}
+
+type HandicapPredictionsResponse struct {
+	Handicap    int               `json:"handicap"`
+	BowClass    string            `json:"bow_class,omitempty"`
+	Predictions []ScorePrediction `json:"predictions"`
+}
+
+type TargetFaceRequest struct {
+	Name            string          `json:"name" validate:"required,notblank,max=100"`
+	Standard        string          `json:"standard" validate:"required,notblank,max=50"`             // WA, NFAA, ...
+	TotalDiameter   int             `json:"total_diameter" validate:"min=1,max=2000"`                 // in mm
+	ScoringDiameter int             `json:"scoring_diameter" validate:"min=1,ltefield=TotalDiameter"` // in mm
+	ZonesConfig     json.RawMessage `json:"zones_config" validate:"required"`                         // [{"score": 10, "radius": 40}, ...]
+	HasX            bool            `json:"has_x"`
+	Description     string          `json:"description" validate:"max=2000"`
+}
+
+type AddAthleteRequest struct {
+	AthleteUserID string `json:"athlete_user_id" validate:"required,max=255"`
+}
+
+type InviteCoachRequest struct {
+	CoachUserID string `json:"coach_user_id,omitempty" validate:"max=255"` // empty to create an invite code
+}
+
+type RedeemInviteCodeRequest struct {
+	InviteCode string `json:"invite_code" validate:"required,max=32"`
+}
+
+type CoachInvitationsResponse struct {
+	Sent     []db.CoachInvitation `json:"sent"`     // as athlete
+	Received []db.CoachInvitation `json:"received"` // as coach
+}
+
+type CreateCommentRequest struct {
+	Body string `json:"body" validate:"required,notblank,max=2000"`
+}
+
+type CreatePersonalAccessTokenRequest struct {
+	Name          string   `json:"name" validate:"required,notblank,max=100"`
+	Scopes        []string `json:"scopes,omitempty"`                                              // defaults to scores:read and scores:write
+	ExpiresInDays *int     `json:"expires_in_days,omitempty" validate:"omitempty,min=1,max=3650"` // omit for a token that does not expire
+}
+
+type PersonalAccessTokenResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// CreatedPersonalAccessTokenResponse carries the plain token, which is only
+// returned once.
+type CreatedPersonalAccessTokenResponse struct {
+	PersonalAccessTokenResponse
+	Token string `json:"token"`
+}
+
+type AuditLogFilter struct {
+	EntityType  string
+	EntityID    *uuid.UUID
+	UserID      string // archer whose data changed
+	ActorUserID string // who made the change
+	BeforeID    *int64 // page through entries older than this ID
+	Limit       int
+}
+
+// Signer roles on a score sheet.
+const (
+	SignerArcher  = "archer"
+	SignerWitness = "witness"
+	SignerJudge   = "judge"
+)
+
+var SignerRoles = []string{SignerArcher, SignerWitness, SignerJudge}
+
+type SignScoresRequest struct {
+	Role   string `json:"role" validate:"required,signer_role"`                        // archer, witness or judge
+	Reason string `json:"reason,omitempty" validate:"required_if=Role judge,max=1000"` // required for judges
+}
+
+type SetVerification struct {
+	SetID     uuid.UUID `json:"set_id"`
+	SetNumber int       `json:"set_number"`
+	ShotCount int       `json:"shot_count"`
+	Hash      string    `json:"hash"`
+}
+
+type SignatureVerification struct {
+	db.ScoreSignature
+	// Valid reports whether the signed scores are unchanged since signing.
+	Valid bool `json:"valid"`
+}
+
+// RoundVerificationResponse carries tamper-evident hashes of a round's shots.
+// Recomputing the hash from the same shots gives the same value; any change
+// to a shot gives a different one.
+type RoundVerificationResponse struct {
+	RoundID    uuid.UUID               `json:"round_id"`
+	Algorithm  string                  `json:"algorithm"`
+	Hash       string                  `json:"hash"`
+	ShotCount  int                     `json:"shot_count"`
+	Sets       []SetVerification       `json:"sets"`
+	Signatures []SignatureVerification `json:"signatures"`
+	// Verified reports whether the round is signed and no signed scores
+	// changed since.
+	Verified bool `json:"verified"`
+}
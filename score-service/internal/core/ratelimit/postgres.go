@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"archy/scores/internal/db"
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// idleBucketAge is how long a bucket goes unused before the PostgresStore
+// deletes it. It must exceed the longest budget window.
+const idleBucketAge = 24 * time.Hour
+
+// PostgresStore keeps buckets in the rate_limit_buckets table so that all
+// instances of the service share one budget per caller.
+type PostgresStore struct {
+	queries *db.Queries
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+func NewPostgresStore(queries *db.Queries) *PostgresStore {
+	return &PostgresStore{queries: queries}
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	s.sweep(ctx)
+
+	row, err := s.queries.TakeRateLimitToken(ctx, db.TakeRateLimitTokenParams{
+		BucketKey: key,
+		Burst:     float64(limit.Burst),
+		Rate:      limit.Rate,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	return result(row.Allowed, row.Tokens, limit), nil
+}
+
+// sweep deletes idle buckets at most once per sweepInterval per instance.
+func (s *PostgresStore) sweep(ctx context.Context) {
+	s.mu.Lock()
+	now := time.Now()
+	due := now.Sub(s.lastSweep) >= sweepInterval
+	if due {
+		s.lastSweep = now
+	}
+	s.mu.Unlock()
+
+	if !due {
+		return
+	}
+	if _, err := s.queries.DeleteIdleRateLimitBuckets(ctx, now.Add(-idleBucketAge)); err != nil {
+		slog.WarnContext(ctx, "Failed to delete idle rate limit buckets", "error", err)
+	}
+}
@@ -0,0 +1,128 @@
+// Package ratelimit implements token bucket rate limiting with pluggable
+// bucket storage.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit is a token bucket budget: Burst requests at once, refilled at Rate
+// requests per second.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// PerMinute allows n requests per minute with a burst of n.
+func PerMinute(n int) Limit {
+	return Limit{Rate: float64(n) / 60, Burst: n}
+}
+
+// ParseLimit parses a budget such as "60/m", "5/s" or "1000/h". The burst
+// equals the count.
+func ParseLimit(s string) (Limit, error) {
+	count, unit, ok := strings.Cut(strings.TrimSpace(s), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid rate limit %q, expected e.g. 60/m", s)
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(count))
+	if err != nil || n <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: count must be a positive integer", s)
+	}
+
+	var per time.Duration
+	switch strings.TrimSpace(unit) {
+	case "s":
+		per = time.Second
+	case "m":
+		per = time.Minute
+	case "h":
+		per = time.Hour
+	default:
+		return Limit{}, fmt.Errorf("invalid rate limit %q: unit must be s, m or h", s)
+	}
+
+	return Limit{Rate: float64(n) / per.Seconds(), Burst: n}, nil
+}
+
+// Window is the time an empty bucket takes to refill completely.
+func (l Limit) Window() time.Duration {
+	if l.Rate <= 0 {
+		return 0
+	}
+	return time.Duration(float64(l.Burst) / l.Rate * float64(time.Second))
+}
+
+// Result describes the state of a bucket after a request was counted.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is the time until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is the time until the next request would be allowed; zero
+	// when Allowed.
+	RetryAfter time.Duration
+}
+
+// Store keeps token buckets.
+type Store interface {
+	// Take removes a token from the bucket for key if one is available.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// result computes a Result from the tokens left in a bucket.
+func result(allowed bool, tokens float64, limit Limit) Result {
+	res := Result{
+		Allowed:   allowed,
+		Limit:     limit.Burst,
+		Remaining: max(0, int(math.Floor(tokens))),
+	}
+	if limit.Rate > 0 {
+		res.Reset = seconds((float64(limit.Burst) - tokens) / limit.Rate)
+		if !allowed {
+			res.RetryAfter = seconds((1 - tokens) / limit.Rate)
+		}
+	}
+	return res
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(max(0, s) * float64(time.Second))
+}
+
+// Limiter applies read, write and per-route budgets to callers.
+type Limiter struct {
+	store Store
+	read  Limit
+	write Limit
+	// routes overrides the budget of a route, keyed by "METHOD /path" with
+	// the path as registered, e.g. "POST /api/rounds/:roundId/sets".
+	routes map[string]Limit
+}
+
+func NewLimiter(store Store, read, write Limit, routes map[string]Limit) *Limiter {
+	return &Limiter{store: store, read: read, write: write, routes: routes}
+}
+
+// Allow counts a request by caller to route and reports whether it may proceed.
+// Routes with their own budget get their own bucket; all other reads and
+// writes of a caller share one bucket each.
+func (l *Limiter) Allow(ctx context.Context, caller, method, route string) (Result, error) {
+	name := method + " " + route
+	limit, ok := l.routes[name]
+	switch {
+	case ok:
+	case method == "GET" || method == "HEAD" || method == "OPTIONS":
+		name, limit = "read", l.read
+	default:
+		name, limit = "write", l.write
+	}
+
+	return l.store.Take(ctx, caller+"|"+name, limit)
+}
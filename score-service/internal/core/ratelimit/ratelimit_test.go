@@ -0,0 +1,121 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Limit
+		wantErr bool
+	}{
+		{"60/m", Limit{Rate: 1, Burst: 60}, false},
+		{"5/s", Limit{Rate: 5, Burst: 5}, false},
+		{"3600/h", Limit{Rate: 1, Burst: 3600}, false},
+		{" 10 / m ", Limit{Rate: 10.0 / 60, Burst: 10}, false},
+		{"60", Limit{}, true},
+		{"0/m", Limit{}, true},
+		{"ten/m", Limit{}, true},
+		{"60/d", Limit{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseLimit(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("ParseLimit(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("ParseLimit(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func newTestStore() (*MemoryStore, *time.Time) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	return store, &now
+}
+
+func TestMemoryStore_TakeAndRefill(t *testing.T) {
+	store, now := newTestStore()
+	limit := Limit{Rate: 1, Burst: 3}
+	ctx := context.Background()
+
+	for i := 2; i >= 0; i-- {
+		res, _ := store.Take(ctx, "k", limit)
+		if !res.Allowed || res.Remaining != i {
+			t.Fatalf("expected allowed with %d remaining, got %+v", i, res)
+		}
+	}
+
+	res, _ := store.Take(ctx, "k", limit)
+	if res.Allowed {
+		t.Fatal("expected the empty bucket to reject")
+	}
+	if res.RetryAfter != time.Second || res.Reset != 3*time.Second {
+		t.Errorf("expected retry after 1s and reset in 3s, got %+v", res)
+	}
+
+	*now = now.Add(1500 * time.Millisecond)
+	res, _ = store.Take(ctx, "k", limit)
+	if !res.Allowed || res.Remaining != 0 {
+		t.Fatalf("expected a refilled token, got %+v", res)
+	}
+
+	// Other keys have their own bucket.
+	if res, _ := store.Take(ctx, "other", limit); !res.Allowed || res.Remaining != 2 {
+		t.Fatalf("expected a fresh bucket, got %+v", res)
+	}
+}
+
+func TestMemoryStore_SweepsFullBuckets(t *testing.T) {
+	store, now := newTestStore()
+	limit := Limit{Rate: 1, Burst: 3}
+	ctx := context.Background()
+
+	store.Take(ctx, "idle", limit)
+	*now = now.Add(2 * sweepInterval)
+	store.Take(ctx, "active", limit)
+
+	if _, ok := store.buckets["idle"]; ok {
+		t.Error("expected the refilled bucket to be dropped")
+	}
+	if _, ok := store.buckets["active"]; !ok {
+		t.Error("expected the active bucket to be kept")
+	}
+}
+
+func TestLimiter_Budgets(t *testing.T) {
+	store, _ := newTestStore()
+	limiter := NewLimiter(store, Limit{Rate: 1, Burst: 3}, Limit{Rate: 1, Burst: 1},
+		map[string]Limit{"POST /batch": {Rate: 1, Burst: 2}})
+	ctx := context.Background()
+
+	allow := func(caller, method, route string) Result {
+		res, err := limiter.Allow(ctx, caller, method, route)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	if res := allow("a", "POST", "/rounds"); !res.Allowed || res.Limit != 1 {
+		t.Fatalf("expected the write budget, got %+v", res)
+	}
+	if res := allow("a", "PUT", "/rounds/:id"); res.Allowed {
+		t.Fatal("expected writes to share one budget")
+	}
+	if res := allow("a", "GET", "/rounds"); !res.Allowed || res.Limit != 3 {
+		t.Fatalf("expected reads to have their own budget, got %+v", res)
+	}
+	if res := allow("a", "POST", "/batch"); !res.Allowed || res.Limit != 2 {
+		t.Fatalf("expected the route budget, got %+v", res)
+	}
+	if res := allow("b", "POST", "/rounds"); !res.Allowed {
+		t.Fatal("expected callers to have their own budget")
+	}
+}
@@ -0,0 +1,18 @@
+// Package requestid carries the ID of the HTTP request being served through
+// the context, so that work done on its behalf can be correlated.
+package requestid
+
+import "context"
+
+type contextKey struct{}
+
+// WithID returns a copy of ctx carrying the request ID.
+func WithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID stored in ctx, or "" if there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
@@ -0,0 +1,228 @@
+package services
+
+import (
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/tracing"
+	"archy/scores/internal/db"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AccessService decides whether the caller may read or change another
+// archer's data. Archers own their rounds, coaches can read their athletes'
+// rounds and admins and judges can read everything.
+type AccessService struct {
+	queries *db.Queries
+}
+
+func NewAccessService(queries *db.Queries) *AccessService {
+	return &AccessService{queries: queries}
+}
+
+// loadLocalRoles merges roles granted in the user_roles table into p, once
+// per request.
+func (s *AccessService) loadLocalRoles(ctx context.Context, p *auth.Principal) error {
+	if p.LocalRolesLoaded {
+		return nil
+	}
+	roles, err := s.queries.ListUserRoles(ctx, p.UserID)
+	if err != nil {
+		return err
+	}
+	p.AddRoles(roles...)
+	p.LocalRolesLoaded = true
+	return nil
+}
+
+// HasScope reports whether p holds scope from its token or its local roles.
+func (s *AccessService) HasScope(ctx context.Context, p *auth.Principal, scope string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "AccessService.HasScope")
+	defer span.End()
+
+	if p.HasScope(scope) {
+		return true, nil
+	}
+	if err := s.loadLocalRoles(ctx, p); err != nil {
+		return false, err
+	}
+	return p.HasScope(scope), nil
+}
+
+// HasRole reports whether p holds role from its token or its local roles.
+func (s *AccessService) HasRole(ctx context.Context, p *auth.Principal, role string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "AccessService.HasRole")
+	defer span.End()
+
+	if p.HasRole(role) {
+		return true, nil
+	}
+	if err := s.loadLocalRoles(ctx, p); err != nil {
+		return false, err
+	}
+	return p.HasRole(role), nil
+}
+
+// IsJudge reports whether userID is the caller in ctx and holds the judge role.
+func (s *AccessService) IsJudge(ctx context.Context, userID string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "AccessService.IsJudge")
+	defer span.End()
+
+	p, ok := auth.PrincipalFromContext(ctx)
+	if !ok || p.UserID != userID {
+		return false, nil
+	}
+	return s.HasRole(ctx, p, auth.RoleJudge)
+}
+
+// IsOfficial reports whether userID is the caller in ctx and is an admin or a
+// judge, who may read every archer's data.
+func (s *AccessService) IsOfficial(ctx context.Context, userID string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "AccessService.IsOfficial")
+	defer span.End()
+
+	p, ok := auth.PrincipalFromContext(ctx)
+	if !ok || p.UserID != userID {
+		return false, nil
+	}
+	for _, role := range []string{auth.RoleAdmin, auth.RoleJudge} {
+		has, err := s.HasRole(ctx, p, role)
+		if err != nil || has {
+			return has, err
+		}
+	}
+	return false, nil
+}
+
+// CanRead returns ErrNotFound unless userID may read data owned by ownerID.
+func (s *AccessService) CanRead(ctx context.Context, userID, ownerID string) error {
+	ctx, span := tracing.Start(ctx, "AccessService.CanRead")
+	defer span.End()
+
+	if userID == ownerID {
+		return nil
+	}
+
+	official, err := s.IsOfficial(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if official {
+		return nil
+	}
+
+	coach, err := s.queries.IsCoachOf(ctx, db.IsCoachOfParams{
+		CoachUserID:   userID,
+		AthleteUserID: ownerID,
+	})
+	if err != nil {
+		return err
+	}
+	if !coach {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// CanWrite returns nil only for the owner. Others who can read the data get
+// ErrForbidden, everyone else ErrNotFound.
+func (s *AccessService) CanWrite(ctx context.Context, userID, ownerID string) error {
+	ctx, span := tracing.Start(ctx, "AccessService.CanWrite")
+	defer span.End()
+
+	if userID == ownerID {
+		return nil
+	}
+	if err := s.CanRead(ctx, userID, ownerID); err != nil {
+		return err
+	}
+	return ErrForbidden
+}
+
+// setOwner returns the owner of the round a set belongs to.
+func (s *AccessService) setOwner(ctx context.Context, roundID, setID uuid.UUID) (string, error) {
+	owner, err := s.queries.GetSetOwner(ctx, db.GetSetOwnerParams{
+		ID:            setID,
+		ParentRoundID: pgtype.UUID{Bytes: roundID, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	return owner, err
+}
+
+// CanReadSet checks that the set belongs to the round and the user may read it.
+func (s *AccessService) CanReadSet(ctx context.Context, userID string, roundID, setID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "AccessService.CanReadSet")
+	defer span.End()
+
+	owner, err := s.setOwner(ctx, roundID, setID)
+	if err != nil {
+		return err
+	}
+	return s.CanRead(ctx, userID, owner)
+}
+
+// CanWriteSet checks that the set belongs to the round and the user owns it.
+func (s *AccessService) CanWriteSet(ctx context.Context, userID string, roundID, setID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "AccessService.CanWriteSet")
+	defer span.End()
+
+	owner, err := s.setOwner(ctx, roundID, setID)
+	if err != nil {
+		return err
+	}
+	return s.CanWrite(ctx, userID, owner)
+}
+
+func (s *AccessService) roundOwner(ctx context.Context, roundID uuid.UUID) (string, error) {
+	round, err := s.queries.GetQualificationRound(ctx, roundID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	return round.ExternalUserID, err
+}
+
+// CanReadRound checks that the user may read the round.
+func (s *AccessService) CanReadRound(ctx context.Context, userID string, roundID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "AccessService.CanReadRound")
+	defer span.End()
+
+	owner, err := s.roundOwner(ctx, roundID)
+	if err != nil {
+		return err
+	}
+	return s.CanRead(ctx, userID, owner)
+}
+
+// CanWriteRound checks that the user owns the round.
+func (s *AccessService) CanWriteRound(ctx context.Context, userID string, roundID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "AccessService.CanWriteRound")
+	defer span.End()
+
+	owner, err := s.roundOwner(ctx, roundID)
+	if err != nil {
+		return err
+	}
+	return s.CanWrite(ctx, userID, owner)
+}
+
+// CanReadShot checks that the shot belongs to the set and round and that the
+// user may read it.
+func (s *AccessService) CanReadShot(ctx context.Context, userID string, roundID, setID, shotID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "AccessService.CanReadShot")
+	defer span.End()
+
+	if err := s.CanReadSet(ctx, userID, roundID, setID); err != nil {
+		return err
+	}
+
+	shot, err := s.queries.GetShot(ctx, shotID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && shot.SetID != setID) {
+		return ErrNotFound
+	}
+	return err
+}
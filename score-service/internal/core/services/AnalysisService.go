@@ -0,0 +1,195 @@
+package services
+
+import (
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/stats"
+	"archy/scores/internal/core/tracing"
+	"archy/scores/internal/db"
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// fatigueSignificanceLevel is the p-value below which a trend is reported as significant.
+const fatigueSignificanceLevel = 0.05
+
+const (
+	dimensionSetNumber   = "set_number"
+	dimensionArrowNumber = "arrow_number"
+	metricScore          = "score"
+	metricDistance       = "distance_from_center"
+)
+
+type AnalysisService struct {
+	queries *db.Queries
+}
+
+func NewAnalysisService(queries *db.Queries) *AnalysisService {
+	return &AnalysisService{queries: queries}
+}
+
+type shotPoint struct {
+	setNumber   float64
+	arrowNumber float64
+	score       float64
+	x, y        float64
+	distance    float64
+}
+
+// GetFatigueAnalysis aggregates every shot of the user's rounds by end number and
+// by arrow order within an end, and regresses score and distance from center
+// against both to detect declining performance in later ends or arrows.
+func (s *AnalysisService) GetFatigueAnalysis(
+	ctx context.Context,
+	externalUserID string,
+	filter models.FatigueAnalysisFilter,
+) (*models.FatigueAnalysisResponse, error) {
+	ctx, span := tracing.Start(ctx, "AnalysisService.GetFatigueAnalysis")
+	defer span.End()
+
+	params := db.GetShotPositionsForUserParams{ExternalUserID: externalUserID}
+	if filter.RoundType != nil {
+		params.RoundType = pgtype.Text{String: *filter.RoundType, Valid: true}
+	}
+	if filter.Distance != nil {
+		params.Distance = pgtype.Int4{Int32: int32(*filter.Distance), Valid: true}
+	}
+
+	rows, err := s.queries.GetShotPositionsForUser(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds := make(map[uuid.UUID]struct{})
+	points := make([]shotPoint, 0, len(rows))
+	for _, row := range rows {
+		rounds[row.RoundID] = struct{}{}
+		points = append(points, shotPoint{
+			setNumber:   float64(row.SetNumber),
+			arrowNumber: float64(row.ArrowNumber),
+			score:       float64(row.Score),
+			x:           numericToFloat(row.X),
+			y:           numericToFloat(row.Y),
+			distance:    numericToFloat(row.DistanceFromCenter),
+		})
+	}
+
+	res := &models.FatigueAnalysisResponse{
+		Rounds:        len(rounds),
+		Arrows:        len(points),
+		BySetNumber:   bucketize(points, func(p shotPoint) float64 { return p.setNumber }),
+		ByArrowNumber: bucketize(points, func(p shotPoint) float64 { return p.arrowNumber }),
+		Trends:        []models.FatigueTrend{},
+	}
+
+	dimensions := []struct {
+		name string
+		x    func(shotPoint) float64
+	}{
+		{dimensionSetNumber, func(p shotPoint) float64 { return p.setNumber }},
+		{dimensionArrowNumber, func(p shotPoint) float64 { return p.arrowNumber }},
+	}
+	metrics := []struct {
+		name string
+		y    func(shotPoint) float64
+		// worse reports whether a slope of this sign means worse shooting.
+		worse func(slope float64) bool
+	}{
+		{metricScore, func(p shotPoint) float64 { return p.score }, func(slope float64) bool { return slope < 0 }},
+		{metricDistance, func(p shotPoint) float64 { return p.distance }, func(slope float64) bool { return slope > 0 }},
+	}
+
+	for _, d := range dimensions {
+		for _, m := range metrics {
+			xs := make([]float64, len(points))
+			ys := make([]float64, len(points))
+			for i, p := range points {
+				xs[i] = d.x(p)
+				ys[i] = m.y(p)
+			}
+
+			reg, err := stats.LinearRegression(xs, ys)
+			if errors.Is(err, stats.ErrNotEnoughPoints) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			significant := reg.PValue < fatigueSignificanceLevel
+			trend := models.FatigueTrend{
+				Dimension:   d.name,
+				Metric:      m.name,
+				Slope:       reg.Slope,
+				Intercept:   reg.Intercept,
+				RSquared:    reg.RSquared,
+				PValue:      reg.PValue,
+				Significant: significant,
+				Declining:   significant && m.worse(reg.Slope),
+			}
+			res.Trends = append(res.Trends, trend)
+			if trend.Declining {
+				res.DeclineDetected = true
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// bucketize groups points by the given position and computes per-bucket averages
+// and the grouping diameter (2 * sqrt(var_x + var_y)), matching calculate_set_grouping.
+func bucketize(points []shotPoint, position func(shotPoint) float64) []models.FatigueBucket {
+	groups := make(map[int][]shotPoint)
+	for _, p := range points {
+		pos := int(position(p))
+		groups[pos] = append(groups[pos], p)
+	}
+
+	buckets := make([]models.FatigueBucket, 0, len(groups))
+	for pos, group := range groups {
+		n := float64(len(group))
+		var sumScore, sumDistance, sumX, sumY float64
+		for _, p := range group {
+			sumScore += p.score
+			sumDistance += p.distance
+			sumX += p.x
+			sumY += p.y
+		}
+
+		bucket := models.FatigueBucket{
+			Position:        pos,
+			Arrows:          len(group),
+			AverageScore:    sumScore / n,
+			AverageDistance: sumDistance / n,
+		}
+
+		if len(group) >= 2 {
+			meanX, meanY := sumX/n, sumY/n
+			var ssX, ssY float64
+			for _, p := range group {
+				ssX += (p.x - meanX) * (p.x - meanX)
+				ssY += (p.y - meanY) * (p.y - meanY)
+			}
+			diameter := 2 * math.Sqrt((ssX+ssY)/(n-1))
+			bucket.GroupingDiameter = &diameter
+		}
+
+		buckets = append(buckets, bucket)
+	}
+
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].Position < buckets[j].Position })
+	return buckets
+}
+
+func numericToFloat(n pgtype.Numeric) float64 {
+	f, err := n.Float64Value()
+	if err != nil || !f.Valid {
+		return 0
+	}
+	return f.Float64
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/requestid"
+	"archy/scores/internal/core/tracing"
+	"archy/scores/internal/db"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Audited actions.
+const (
+	AuditCreate = "create"
+	AuditUpdate = "update"
+	AuditDelete = "delete"
+)
+
+// Audited entity types.
+const (
+	AuditEntityRound      = "round"
+	AuditEntitySet        = "set"
+	AuditEntityShot       = "shot"
+	AuditEntityTargetFace = "target_face"
+)
+
+const (
+	defaultAuditLogLimit = 50
+	maxAuditLogLimit     = 200
+)
+
+// AuditService records changes to scoring data in the append-only audit_log
+// table, so disputed scores can be traced to who changed what and when.
+type AuditService struct {
+	queries *db.Queries
+	access  *AccessService
+}
+
+func NewAuditService(queries *db.Queries, access *AccessService) *AuditService {
+	return &AuditService{queries: queries, access: access}
+}
+
+// Record logs a change to an entity made by the caller in ctx. before and
+// after are the entity's state around the change; nil for creates and
+// deletes respectively. ownerUserID is the archer whose data changed, empty
+// for shared data such as target faces.
+// q must be bound to the transaction that makes the change, so the change is
+// rolled back when it cannot be recorded.
+func (s *AuditService) Record(
+	ctx context.Context,
+	q *db.Queries,
+	action string,
+	entityType string,
+	entityID uuid.UUID,
+	ownerUserID string,
+	before, after any,
+) error {
+	return s.RecordWithReason(ctx, q, "", action, entityType, entityID, ownerUserID, before, after)
+}
+
+// RecordWithReason is Record for changes that must be justified, such as a
+// judge correcting a signed end.
+func (s *AuditService) RecordWithReason(
+	ctx context.Context,
+	q *db.Queries,
+	reason string,
+	action string,
+	entityType string,
+	entityID uuid.UUID,
+	ownerUserID string,
+	before, after any,
+) error {
+	ctx, span := tracing.Start(ctx, "AuditService.RecordWithReason")
+	defer span.End()
+
+	e := newAuditEntry(ctx, reason, ownerUserID)
+	params := db.CreateAuditLogEntryParams{
+		ActorUserID: e.actor,
+		TokenType:   e.tokenType,
+		TokenID:     e.tokenID,
+		RequestID:   e.requestID,
+		Action:      action,
+		EntityType:  entityType,
+		EntityID:    entityID,
+		OwnerUserID: e.owner,
+		Reason:      e.reason,
+	}
+
+	var err error
+	if params.BeforeData, err = auditData(before); err != nil {
+		return err
+	}
+	if params.AfterData, err = auditData(after); err != nil {
+		return err
+	}
+	if err := q.CreateAuditLogEntry(ctx, params); err != nil {
+		return fmt.Errorf("record %s of %s %s: %w", action, entityType, entityID, err)
+	}
+	return nil
+}
+
+// RecordCreates logs entities of one type created together, such as the
+// arrows of a batch, in one statement. ids and after are parallel.
+func (s *AuditService) RecordCreates(
+	ctx context.Context,
+	q *db.Queries,
+	reason string,
+	entityType string,
+	ownerUserID string,
+	ids []uuid.UUID,
+	after []any,
+) error {
+	ctx, span := tracing.Start(ctx, "AuditService.RecordCreates")
+	defer span.End()
+
+	e := newAuditEntry(ctx, reason, ownerUserID)
+	params := db.CreateAuditLogEntriesParams{
+		ActorUserID: e.actor,
+		TokenType:   e.tokenType,
+		TokenID:     e.tokenID,
+		RequestID:   e.requestID,
+		EntityType:  entityType,
+		EntityIds:   ids,
+		OwnerUserID: e.owner,
+		AfterData:   make([][]byte, len(after)),
+		Reason:      e.reason,
+	}
+	for i, v := range after {
+		data, err := auditData(v)
+		if err != nil {
+			return err
+		}
+		params.AfterData[i] = data
+	}
+	if err := q.CreateAuditLogEntries(ctx, params); err != nil {
+		return fmt.Errorf("record creates of %d %s: %w", len(ids), entityType, err)
+	}
+	return nil
+}
+
+// auditEntry holds what every entry records about the request.
+type auditEntry struct {
+	actor, tokenType                  string
+	tokenID, requestID, owner, reason pgtype.Text
+}
+
+func newAuditEntry(ctx context.Context, reason, ownerUserID string) auditEntry {
+	e := auditEntry{
+		actor:     "system",
+		tokenType: "system",
+		owner:     pgtype.Text{String: ownerUserID, Valid: ownerUserID != ""},
+		reason:    pgtype.Text{String: reason, Valid: reason != ""},
+	}
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		e.actor = p.UserID
+		e.tokenType = p.TokenType
+		e.tokenID = pgtype.Text{String: p.TokenID, Valid: p.TokenID != ""}
+	}
+	if id := requestid.FromContext(ctx); id != "" {
+		e.requestID = pgtype.Text{String: id, Valid: true}
+	}
+	return e
+}
+
+func auditData(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+// ListEntries returns audit log entries, newest first. Admins see every
+// entry; other users see changes to their own data and, for coaches, to
+// their athletes' data. Filtering by user requires read access to that
+// user's data.
+func (s *AuditService) ListEntries(
+	ctx context.Context,
+	externalUserID string,
+	filter models.AuditLogFilter,
+) ([]db.AuditLog, error) {
+	ctx, span := tracing.Start(ctx, "AuditService.ListEntries")
+	defer span.End()
+
+	params := db.ListAuditLogParams{RowLimit: defaultAuditLogLimit}
+	if filter.Limit > 0 {
+		params.RowLimit = int32(min(filter.Limit, maxAuditLogLimit))
+	}
+	if filter.EntityType != "" {
+		params.EntityType = pgtype.Text{String: filter.EntityType, Valid: true}
+	}
+	if filter.EntityID != nil {
+		params.EntityID = pgtype.UUID{Bytes: *filter.EntityID, Valid: true}
+	}
+	if filter.ActorUserID != "" {
+		params.ActorUserID = pgtype.Text{String: filter.ActorUserID, Valid: true}
+	}
+	if filter.BeforeID != nil {
+		params.BeforeID = pgtype.Int8{Int64: *filter.BeforeID, Valid: true}
+	}
+
+	if filter.UserID != "" {
+		if err := s.access.CanRead(ctx, externalUserID, filter.UserID); err != nil {
+			return nil, err
+		}
+		params.OwnerUserIds = []string{filter.UserID}
+	} else {
+		owners, err := s.readableOwners(ctx, externalUserID)
+		if err != nil {
+			return nil, err
+		}
+		params.OwnerUserIds = owners
+	}
+
+	return s.queries.ListAuditLog(ctx, params)
+}
+
+// readableOwners lists the users whose audit entries the caller may see, or
+// nil for all of them.
+func (s *AuditService) readableOwners(ctx context.Context, externalUserID string) ([]string, error) {
+	if p, ok := auth.PrincipalFromContext(ctx); ok {
+		admin, err := s.access.HasRole(ctx, p, auth.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if admin {
+			return nil, nil
+		}
+	}
+
+	athletes, err := s.queries.ListAthletesForCoach(ctx, externalUserID)
+	if err != nil {
+		return nil, err
+	}
+	owners := []string{externalUserID}
+	for _, a := range athletes {
+		owners = append(owners, a.AthleteUserID)
+	}
+	return owners, nil
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"archy/scores/internal/core/tracing"
+	"archy/scores/internal/db"
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ClassificationService struct {
+	queries *db.Queries
+}
+
+func NewClassificationService(queries *db.Queries) *ClassificationService {
+	return &ClassificationService{queries: queries}
+}
+
+// EvaluateRound stores the highest classification whose threshold the round's
+// score meets. Only complete rounds shot to a round template's distance,
+// arrow count and target face, with a known gender, can earn a
+// classification; for anything else, or when no threshold is met, it
+// returns nil.
+func (s *ClassificationService) EvaluateRound(
+	ctx context.Context,
+	round *db.QualificationRound,
+) (*db.ArcherClassification, error) {
+	ctx, span := tracing.Start(ctx, "ClassificationService.EvaluateRound")
+	defer span.End()
+
+	if !round.RoundTemplateID.Valid || !round.Gender.Valid || !round.Handicap.Valid {
+		return nil, nil
+	}
+
+	template, err := s.queries.GetRoundTemplate(ctx, round.RoundTemplateID.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if template.Distance != round.Distance || template.TargetFaceID != round.TargetFaceID ||
+		template.TotalSets*template.ShotsPerSet != round.TotalSets*round.ShotsPerSet {
+		return nil, nil
+	}
+
+	rule, err := s.queries.GetBestClassificationRule(ctx, db.GetBestClassificationRuleParams{
+		RoundTemplateID: template.ID,
+		AgeCategory:     round.AgeCategory,
+		Gender:          round.Gender.String,
+		BowClass:        round.BowClass,
+		Score:           round.TotalScore,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.queries.UpsertArcherClassification(ctx, db.UpsertArcherClassificationParams{
+		ExternalUserID: round.ExternalUserID,
+		RoundID:        round.ID,
+		RuleID:         rule.ID,
+		Classification: rule.Classification,
+		Rank:           rule.Rank,
+		BowClass:       round.BowClass,
+		AgeCategory:    round.AgeCategory,
+		Score:          round.TotalScore,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *ClassificationService) GetArcherClassifications(
+	ctx context.Context,
+	externalUserID string,
+) ([]db.ArcherClassification, error) {
+	ctx, span := tracing.Start(ctx, "ClassificationService.GetArcherClassifications")
+	defer span.End()
+
+	return s.queries.GetArcherClassifications(ctx, externalUserID)
+}
+
+func (s *ClassificationService) ListRules(
+	ctx context.Context,
+	roundTemplateID pgtype.UUID,
+	bowClass pgtype.Text,
+) ([]db.ClassificationRule, error) {
+	ctx, span := tracing.Start(ctx, "ClassificationService.ListRules")
+	defer span.End()
+
+	return s.queries.ListClassificationRules(ctx, db.ListClassificationRulesParams{
+		RoundTemplateID: roundTemplateID,
+		BowClass:        bowClass,
+	})
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/tracing"
+	"archy/scores/internal/db"
+	"context"
+	"crypto/rand"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrSelfInvitation is returned when an athlete invites themselves as coach.
+var ErrSelfInvitation = apperr.InvalidField("coach_user_id", "you cannot invite yourself as coach")
+
+type CoachService struct {
+	queries *db.Queries
+}
+
+func NewCoachService(queries *db.Queries) *CoachService {
+	return &CoachService{queries: queries}
+}
+
+func (s *CoachService) ListAthletes(ctx context.Context, coachUserID string) ([]db.CoachAthlete, error) {
+	ctx, span := tracing.Start(ctx, "CoachService.ListAthletes")
+	defer span.End()
+
+	return s.queries.ListAthletesForCoach(ctx, coachUserID)
+}
+
+// AddAthlete lets the coach read the athlete's rounds. Adding an existing
+// athlete is a no-op.
+func (s *CoachService) AddAthlete(ctx context.Context, coachUserID, athleteUserID string) (*db.CoachAthlete, error) {
+	ctx, span := tracing.Start(ctx, "CoachService.AddAthlete")
+	defer span.End()
+
+	res, err := s.queries.AddCoachAthlete(ctx, db.AddCoachAthleteParams{
+		CoachUserID:   coachUserID,
+		AthleteUserID: athleteUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (s *CoachService) RemoveAthlete(ctx context.Context, coachUserID, athleteUserID string) error {
+	ctx, span := tracing.Start(ctx, "CoachService.RemoveAthlete")
+	defer span.End()
+
+	rows, err := s.queries.RemoveCoachAthlete(ctx, db.RemoveCoachAthleteParams{
+		CoachUserID:   coachUserID,
+		AthleteUserID: athleteUserID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// InviteCoach creates an invitation from the athlete. Without a coach user ID
+// the invitation carries an invite code that any coach can redeem.
+func (s *CoachService) InviteCoach(ctx context.Context, athleteUserID, coachUserID string) (*db.CoachInvitation, error) {
+	ctx, span := tracing.Start(ctx, "CoachService.InviteCoach")
+	defer span.End()
+
+	if coachUserID == athleteUserID {
+		return nil, ErrSelfInvitation
+	}
+
+	params := db.CreateCoachInvitationParams{AthleteUserID: athleteUserID}
+	if coachUserID != "" {
+		params.CoachUserID = pgtype.Text{String: coachUserID, Valid: true}
+	} else {
+		params.InviteCode = pgtype.Text{String: rand.Text(), Valid: true}
+	}
+
+	inv, err := s.queries.CreateCoachInvitation(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &inv, nil
+}
+
+// ListInvitations returns the invitations the user sent as an athlete and
+// received as a coach.
+func (s *CoachService) ListInvitations(ctx context.Context, userID string) (*models.CoachInvitationsResponse, error) {
+	ctx, span := tracing.Start(ctx, "CoachService.ListInvitations")
+	defer span.End()
+
+	sent, err := s.queries.ListCoachInvitationsForAthlete(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	received, err := s.queries.ListCoachInvitationsForCoach(ctx, pgtype.Text{String: userID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	// Invite codes are only shown to the athlete who created them.
+	for i := range received {
+		received[i].InviteCode = pgtype.Text{}
+	}
+
+	return &models.CoachInvitationsResponse{Sent: sent, Received: received}, nil
+}
+
+// AcceptInvitation accepts a pending invitation addressed to the coach and
+// gives them read access to the athlete's rounds.
+func (s *CoachService) AcceptInvitation(ctx context.Context, coachUserID string, invitationID uuid.UUID) (*db.CoachInvitation, error) {
+	ctx, span := tracing.Start(ctx, "CoachService.AcceptInvitation")
+	defer span.End()
+
+	return s.accept(ctx, db.AcceptCoachInvitationParams{
+		CoachUserID: pgtype.Text{String: coachUserID, Valid: true},
+		ID:          pgtype.UUID{Bytes: invitationID, Valid: true},
+	})
+}
+
+// RedeemInviteCode accepts the invitation with the given code on behalf of the coach.
+func (s *CoachService) RedeemInviteCode(ctx context.Context, coachUserID, code string) (*db.CoachInvitation, error) {
+	ctx, span := tracing.Start(ctx, "CoachService.RedeemInviteCode")
+	defer span.End()
+
+	return s.accept(ctx, db.AcceptCoachInvitationParams{
+		CoachUserID: pgtype.Text{String: coachUserID, Valid: true},
+		InviteCode:  pgtype.Text{String: code, Valid: true},
+	})
+}
+
+func (s *CoachService) accept(ctx context.Context, params db.AcceptCoachInvitationParams) (*db.CoachInvitation, error) {
+	row, err := s.queries.AcceptCoachInvitation(ctx, params)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	inv := db.CoachInvitation(row)
+	return &inv, nil
+}
+
+// DeclineInvitation declines a pending invitation addressed to the coach.
+func (s *CoachService) DeclineInvitation(ctx context.Context, coachUserID string, invitationID uuid.UUID) (*db.CoachInvitation, error) {
+	ctx, span := tracing.Start(ctx, "CoachService.DeclineInvitation")
+	defer span.End()
+
+	inv, err := s.queries.DeclineCoachInvitation(ctx, db.DeclineCoachInvitationParams{
+		ID:          invitationID,
+		CoachUserID: pgtype.Text{String: coachUserID, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &inv, nil
+}
+
+// RevokeInvitation withdraws an invitation the athlete sent. If it was already
+// accepted, the coach loses access.
+func (s *CoachService) RevokeInvitation(ctx context.Context, athleteUserID string, invitationID uuid.UUID) (*db.CoachInvitation, error) {
+	ctx, span := tracing.Start(ctx, "CoachService.RevokeInvitation")
+	defer span.End()
+
+	row, err := s.queries.RevokeCoachInvitation(ctx, db.RevokeCoachInvitationParams{
+		ID:            invitationID,
+		AthleteUserID: athleteUserID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	inv := db.CoachInvitation(row)
+	return &inv, nil
+}
+
+func (s *CoachService) ListCoaches(ctx context.Context, athleteUserID string) ([]db.CoachAthlete, error) {
+	ctx, span := tracing.Start(ctx, "CoachService.ListCoaches")
+	defer span.End()
+
+	return s.queries.ListCoachesForAthlete(ctx, athleteUserID)
+}
+
+// RevokeCoach removes a coach's access to the athlete's rounds.
+func (s *CoachService) RevokeCoach(ctx context.Context, athleteUserID, coachUserID string) error {
+	ctx, span := tracing.Start(ctx, "CoachService.RevokeCoach")
+	defer span.End()
+
+	rows, err := s.queries.RevokeCoach(ctx, db.RevokeCoachParams{
+		AthleteUserID: athleteUserID,
+		CoachUserID:   coachUserID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package services
+
+import (
+	"archy/scores/internal/core/tracing"
+	"archy/scores/internal/db"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// CommentService manages comments on rounds, sets and shots. Anyone who can
+// read a round, i.e. the archer and their coaches, can comment on it.
+type CommentService struct {
+	queries *db.Queries
+	access  *AccessService
+}
+
+func NewCommentService(queries *db.Queries, access *AccessService) *CommentService {
+	return &CommentService{queries: queries, access: access}
+}
+
+func (s *CommentService) CreateRoundComment(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+	body string,
+) (*db.Comment, error) {
+	ctx, span := tracing.Start(ctx, "CommentService.CreateRoundComment")
+	defer span.End()
+
+	if err := s.access.CanReadRound(ctx, externalUserID, roundID); err != nil {
+		return nil, err
+	}
+	return s.create(ctx, db.CreateCommentParams{
+		AuthorUserID: externalUserID,
+		RoundID:      roundID,
+		Body:         body,
+	})
+}
+
+func (s *CommentService) CreateSetComment(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+	setID uuid.UUID,
+	body string,
+) (*db.Comment, error) {
+	ctx, span := tracing.Start(ctx, "CommentService.CreateSetComment")
+	defer span.End()
+
+	if err := s.access.CanReadSet(ctx, externalUserID, roundID, setID); err != nil {
+		return nil, err
+	}
+	return s.create(ctx, db.CreateCommentParams{
+		AuthorUserID: externalUserID,
+		RoundID:      roundID,
+		SetID:        pgtype.UUID{Bytes: setID, Valid: true},
+		Body:         body,
+	})
+}
+
+func (s *CommentService) CreateShotComment(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+	setID uuid.UUID,
+	shotID uuid.UUID,
+	body string,
+) (*db.Comment, error) {
+	ctx, span := tracing.Start(ctx, "CommentService.CreateShotComment")
+	defer span.End()
+
+	if err := s.access.CanReadShot(ctx, externalUserID, roundID, setID, shotID); err != nil {
+		return nil, err
+	}
+	return s.create(ctx, db.CreateCommentParams{
+		AuthorUserID: externalUserID,
+		RoundID:      roundID,
+		SetID:        pgtype.UUID{Bytes: setID, Valid: true},
+		ShotID:       pgtype.UUID{Bytes: shotID, Valid: true},
+		Body:         body,
+	})
+}
+
+func (s *CommentService) create(ctx context.Context, params db.CreateCommentParams) (*db.Comment, error) {
+	comment, err := s.queries.CreateComment(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
+func (s *CommentService) ListRoundComments(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+) ([]db.Comment, error) {
+	ctx, span := tracing.Start(ctx, "CommentService.ListRoundComments")
+	defer span.End()
+
+	if err := s.access.CanReadRound(ctx, externalUserID, roundID); err != nil {
+		return nil, err
+	}
+	return s.queries.ListCommentsForRound(ctx, roundID)
+}
+
+func (s *CommentService) ListSetComments(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+	setID uuid.UUID,
+) ([]db.Comment, error) {
+	ctx, span := tracing.Start(ctx, "CommentService.ListSetComments")
+	defer span.End()
+
+	if err := s.access.CanReadSet(ctx, externalUserID, roundID, setID); err != nil {
+		return nil, err
+	}
+	return s.queries.ListCommentsForSet(ctx, pgtype.UUID{Bytes: setID, Valid: true})
+}
+
+func (s *CommentService) ListShotComments(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+	setID uuid.UUID,
+	shotID uuid.UUID,
+) ([]db.Comment, error) {
+	ctx, span := tracing.Start(ctx, "CommentService.ListShotComments")
+	defer span.End()
+
+	if err := s.access.CanReadShot(ctx, externalUserID, roundID, setID, shotID); err != nil {
+		return nil, err
+	}
+	return s.queries.ListCommentsForShot(ctx, pgtype.UUID{Bytes: shotID, Valid: true})
+}
+
+// DeleteComment removes a comment written by the user.
+func (s *CommentService) DeleteComment(ctx context.Context, externalUserID string, commentID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "CommentService.DeleteComment")
+	defer span.End()
+
+	rows, err := s.queries.DeleteComment(ctx, db.DeleteCommentParams{
+		ID:           commentID,
+		AuthorUserID: externalUserID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"archy/scores/internal/core/handicap"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/tracing"
+	"archy/scores/internal/db"
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// rollingHandicapRounds is the number of most recent rated rounds averaged into
+// the rolling handicap.
+const rollingHandicapRounds = 3
+
+type HandicapService struct {
+	queries *db.Queries
+}
+
+func NewHandicapService(queries *db.Queries) *HandicapService {
+	return &HandicapService{queries: queries}
+}
+
+// RateRound computes the handicap achieved in a round. Rounds in which not every
+// planned arrow was shot cannot be rated and return an invalid (NULL) handicap.
+func (s *HandicapService) RateRound(ctx context.Context, round *db.QualificationRound) (pgtype.Int4, error) {
+	ctx, span := tracing.Start(ctx, "HandicapService.RateRound")
+	defer span.End()
+
+	arrows := int(round.ShotsCount)
+	if arrows == 0 || arrows < int(round.TotalSets*round.ShotsPerSet) {
+		return pgtype.Int4{}, nil
+	}
+
+	face, err := s.getFace(ctx, round.TargetFaceID)
+	if err != nil {
+		return pgtype.Int4{}, err
+	}
+
+	h, err := handicap.FromScore(face, float64(round.Distance), arrows, int(round.TotalScore))
+	if err != nil {
+		return pgtype.Int4{}, err
+	}
+
+	return pgtype.Int4{Int32: int32(h), Valid: true}, nil
+}
+
+// UpdateRollingHandicap recomputes the archer's rolling handicap for a bow class
+// from their most recent rated rounds.
+func (s *HandicapService) UpdateRollingHandicap(
+	ctx context.Context,
+	externalUserID string,
+	bowClass string,
+) (*db.ArcherHandicap, error) {
+	ctx, span := tracing.Start(ctx, "HandicapService.UpdateRollingHandicap")
+	defer span.End()
+
+	recent, err := s.queries.GetRecentRoundHandicaps(ctx, db.GetRecentRoundHandicapsParams{
+		ExternalUserID: externalUserID,
+		BowClass:       bowClass,
+		Limit:          rollingHandicapRounds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(recent) == 0 {
+		return nil, nil
+	}
+
+	handicaps := make([]int, len(recent))
+	for i, h := range recent {
+		handicaps[i] = int(h)
+	}
+
+	res, err := s.queries.UpsertArcherHandicap(ctx, db.UpsertArcherHandicapParams{
+		ExternalUserID: externalUserID,
+		BowClass:       bowClass,
+		Handicap:       int32(handicap.Rolling(handicaps)),
+		RoundsCount:    int32(len(recent)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (s *HandicapService) GetArcherHandicaps(ctx context.Context, externalUserID string) ([]db.ArcherHandicap, error) {
+	ctx, span := tracing.Start(ctx, "HandicapService.GetArcherHandicaps")
+	defer span.End()
+
+	return s.queries.GetArcherHandicaps(ctx, externalUserID)
+}
+
+func (s *HandicapService) GetArcherHandicap(
+	ctx context.Context,
+	externalUserID string,
+	bowClass string,
+) (*db.ArcherHandicap, error) {
+	ctx, span := tracing.Start(ctx, "HandicapService.GetArcherHandicap")
+	defer span.End()
+
+	res, err := s.queries.GetArcherHandicap(ctx, db.GetArcherHandicapParams{
+		ExternalUserID: externalUserID,
+		BowClass:       bowClass,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (s *HandicapService) ListRoundTemplates(ctx context.Context) ([]db.RoundTemplate, error) {
+	ctx, span := tracing.Start(ctx, "HandicapService.ListRoundTemplates")
+	defer span.End()
+
+	return s.queries.ListRoundTemplates(ctx)
+}
+
+// PredictScores returns the expected score for every round template at the given handicap.
+func (s *HandicapService) PredictScores(ctx context.Context, h int) ([]models.ScorePrediction, error) {
+	ctx, span := tracing.Start(ctx, "HandicapService.PredictScores")
+	defer span.End()
+
+	templates, err := s.queries.ListRoundTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	faces := make(map[uuid.UUID]handicap.Face)
+	predictions := make([]models.ScorePrediction, 0, len(templates))
+	for _, t := range templates {
+		face, ok := faces[t.TargetFaceID]
+		if !ok {
+			face, err = s.getFace(ctx, t.TargetFaceID)
+			if err != nil {
+				return nil, err
+			}
+			faces[t.TargetFaceID] = face
+		}
+
+		arrows := int(t.TotalSets * t.ShotsPerSet)
+		predictions = append(predictions, models.ScorePrediction{
+			RoundTemplateID: t.ID,
+			Name:            t.Name,
+			Distance:        int(t.Distance),
+			Arrows:          arrows,
+			MaxScore:        arrows * face.MaxScore(),
+			ExpectedScore:   handicap.PredictScore(face, float64(t.Distance), arrows, h),
+		})
+	}
+
+	return predictions, nil
+}
+
+func (s *HandicapService) getFace(ctx context.Context, targetFaceID uuid.UUID) (handicap.Face, error) {
+	tf, err := s.queries.GetTargetFaceIncludingDeleted(ctx, targetFaceID)
+	if err != nil {
+		return nil, err
+	}
+	return handicap.ParseFace(tf.ZonesConfig)
+}
@@ -2,22 +2,41 @@ package services
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
-	"github.com/labstack/echo/v4"
 
+	"archy/scores/internal/core/metrics"
 	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/tracing"
 	"archy/scores/internal/db"
 )
 
 type QualificationRoundService struct {
-	queries *db.Queries
+	queries         *db.Queries
+	access          *AccessService
+	audit           *AuditService
+	handicaps       *HandicapService
+	classifications *ClassificationService
 }
 
-func NewQualificationRoundService(queries *db.Queries) *QualificationRoundService {
-	return &QualificationRoundService{queries: queries}
+func NewQualificationRoundService(
+	queries *db.Queries,
+	access *AccessService,
+	audit *AuditService,
+	handicaps *HandicapService,
+	classifications *ClassificationService,
+) *QualificationRoundService {
+	return &QualificationRoundService{
+		queries:         queries,
+		access:          access,
+		audit:           audit,
+		handicaps:       handicaps,
+		classifications: classifications,
+	}
 }
 
 func (s *QualificationRoundService) CreateQualificationRound(
@@ -25,6 +44,9 @@ func (s *QualificationRoundService) CreateQualificationRound(
 	externalUserID string,
 	req models.CreateQualificationRoundRequest,
 ) (*db.QualificationRound, error) {
+	ctx, span := tracing.Start(ctx, "QualificationRoundService.CreateQualificationRound")
+	defer span.End()
+
 	params := db.CreateQualificationRoundParams{
 		ExternalUserID: externalUserID,
 		RoundType:      req.RoundType,
@@ -34,6 +56,21 @@ func (s *QualificationRoundService) CreateQualificationRound(
 		ShotsPerSet:    int32(req.ShotsPerSet),
 		TargetFaceID:   req.TargetFaceID,
 		Notes:          pgtype.Text{String: req.Notes, Valid: true},
+		BowClass:       req.BowClass,
+		AgeCategory:    req.AgeCategory,
+	}
+
+	if params.BowClass == "" {
+		params.BowClass = models.BowClassRecurve
+	}
+	if params.AgeCategory == "" {
+		params.AgeCategory = models.AgeCategoryAdult
+	}
+	if req.Gender != "" {
+		params.Gender = pgtype.Text{String: req.Gender, Valid: true}
+	}
+	if req.RoundTemplateID != nil {
+		params.RoundTemplateID = pgtype.UUID{Bytes: *req.RoundTemplateID, Valid: true}
 	}
 
 	if req.StartTime != nil {
@@ -47,25 +84,51 @@ func (s *QualificationRoundService) CreateQualificationRound(
 			Valid: true,
 		}
 	}
-	res, err := s.queries.CreateQualificationRound(ctx, params)
+	var res db.QualificationRound
+	err := s.queries.InTx(ctx, func(q *db.Queries) error {
+		var err error
+		if res, err = q.CreateQualificationRound(ctx, params); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, q, AuditCreate, AuditEntityRound, res.ID, externalUserID, nil, res)
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	return &res, err
+	metrics.RoundsCreated.Inc()
+	return &res, nil
 }
 
+// GetQualificationRound returns a round the user owns or coaches.
 func (s *QualificationRoundService) GetQualificationRound(
 	ctx context.Context,
 	externalUserID string,
 	roundID uuid.UUID,
 ) (*db.QualificationRound, error) {
-	round, err := s.queries.GetQualificationRound(ctx, roundID)
+	ctx, span := tracing.Start(ctx, "QualificationRoundService.GetQualificationRound")
+	defer span.End()
+
+	round, err := s.getRound(ctx, roundID)
 	if err != nil {
 		return nil, err
 	}
 
-	if round.ExternalUserID != externalUserID {
-		return nil, echo.NewHTTPError(404, "Round not found")
+	if err := s.access.CanRead(ctx, externalUserID, round.ExternalUserID); err != nil {
+		return nil, err
 	}
 
+	return round, nil
+}
+
+func (s *QualificationRoundService) getRound(ctx context.Context, roundID uuid.UUID) (*db.QualificationRound, error) {
+	round, err := s.queries.GetQualificationRound(ctx, roundID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
 	return &round, nil
 }
 
@@ -73,6 +136,9 @@ func (s *QualificationRoundService) GetQualificationRoundsForUser(
 	ctx context.Context,
 	externalUserID string,
 ) ([]db.QualificationRound, error) {
+	ctx, span := tracing.Start(ctx, "QualificationRoundService.GetQualificationRoundsForUser")
+	defer span.End()
+
 	rounds, err := s.queries.GetQualificationRoundsForUser(ctx, externalUserID)
 	if err != nil {
 		return nil, err
@@ -84,3 +150,72 @@ func (s *QualificationRoundService) GetQualificationRoundsForUser(
 
 	return rounds, nil
 }
+
+// GetAthleteRounds lists the rounds of an athlete the user coaches.
+func (s *QualificationRoundService) GetAthleteRounds(
+	ctx context.Context,
+	externalUserID string,
+	athleteUserID string,
+) ([]db.QualificationRound, error) {
+	ctx, span := tracing.Start(ctx, "QualificationRoundService.GetAthleteRounds")
+	defer span.End()
+
+	if err := s.access.CanRead(ctx, externalUserID, athleteUserID); err != nil {
+		return nil, err
+	}
+	return s.GetQualificationRoundsForUser(ctx, athleteUserID)
+}
+
+// CompleteQualificationRound marks the round as finished. When every planned
+// arrow was shot the round is rated with a handicap, the archer's rolling
+// handicap for the round's bow class is refreshed and the score is evaluated
+// against the classification rules of the round template.
+func (s *QualificationRoundService) CompleteQualificationRound(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+) (*db.QualificationRound, error) {
+	ctx, span := tracing.Start(ctx, "QualificationRoundService.CompleteQualificationRound")
+	defer span.End()
+
+	round, err := s.getRound(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.access.CanWrite(ctx, externalUserID, round.ExternalUserID); err != nil {
+		return nil, err
+	}
+
+	h, err := s.handicaps.RateRound(ctx, round)
+	if err != nil {
+		return nil, err
+	}
+
+	var completed db.QualificationRound
+	err = s.queries.InTx(ctx, func(q *db.Queries) error {
+		var err error
+		completed, err = q.CompleteQualificationRound(ctx, db.CompleteQualificationRoundParams{
+			ID:       round.ID,
+			Handicap: h,
+		})
+		if err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, q, AuditUpdate, AuditEntityRound, round.ID, round.ExternalUserID, round, completed)
+	})
+	if err != nil {
+		return nil, err
+	}
+	metrics.RoundsCompleted.Inc()
+
+	if h.Valid {
+		if _, err := s.handicaps.UpdateRollingHandicap(ctx, externalUserID, completed.BowClass); err != nil {
+			return nil, err
+		}
+		if _, err := s.classifications.EvaluateRound(ctx, &completed); err != nil {
+			return nil, err
+		}
+	}
+
+	return &completed, nil
+}
@@ -1,52 +1,104 @@
 package services
 
 import (
+	"archy/scores/internal/core/metrics"
 	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/tracing"
 	"archy/scores/internal/db"
 	"context"
+	"errors"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type SetService struct {
-	queries *db.Queries
+	queries    *db.Queries
+	access     *AccessService
+	signatures *SignatureService
+	audit      *AuditService
 }
 
-func NewSetService(queries *db.Queries) *SetService {
-	return &SetService{queries: queries}
+func NewSetService(
+	queries *db.Queries,
+	access *AccessService,
+	signatures *SignatureService,
+	audit *AuditService,
+) *SetService {
+	return &SetService{queries: queries, access: access, signatures: signatures, audit: audit}
 }
 
 func (s *SetService) CreateSet(
 	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
 	req models.CreateSetRequest,
 ) (*db.Set, error) {
-	params := db.CreateSetParams{
-		SetNumber:     int32(req.SetNumber),
-		MaxShots:      int32(*req.MaxShots),
-		ParentRoundID: pgtype.UUID{Bytes: req.ParentRoundId},
-	}
+	ctx, span := tracing.Start(ctx, "SetService.CreateSet")
+	defer span.End()
+
+	var set db.Set
+	err := s.queries.InTx(ctx, func(q *db.Queries) error {
+		owner, err := s.signatures.CanEditScores(ctx, q, externalUserID, roundID, nil, req.Reason)
+		if err != nil {
+			return err
+		}
+
+		params := db.CreateSetParams{
+			SetNumber:     int32(req.SetNumber),
+			ParentRoundID: pgtype.UUID{Bytes: roundID, Valid: true},
+		}
+		if req.MaxShots != nil {
+			params.MaxShots = int32(*req.MaxShots)
+		} else {
+			// An end holds as many arrows as the round shoots per end.
+			round, err := q.GetQualificationRound(ctx, roundID)
+			if err != nil {
+				return err
+			}
+			params.MaxShots = round.ShotsPerSet
+		}
 
-	set, err := s.queries.CreateSet(
-		ctx,
-		params,
-	)
+		if set, err = q.CreateSet(ctx, params); err != nil {
+			return err
+		}
+		return s.audit.RecordWithReason(ctx, q, req.Reason, AuditCreate, AuditEntitySet, set.ID, owner, nil, set)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	metrics.SetsRecorded.Inc()
 	return &set, nil
 }
 
-func (s *SetService) GetSet(ctx context.Context, id uuid.UUID) (*db.Set, error) {
+func (s *SetService) GetSet(ctx context.Context, externalUserID string, roundID, id uuid.UUID) (*db.Set, error) {
+	ctx, span := tracing.Start(ctx, "SetService.GetSet")
+	defer span.End()
+
+	if err := s.access.CanReadSet(ctx, externalUserID, roundID, id); err != nil {
+		return nil, err
+	}
+
 	set, err := s.queries.GetSet(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &set, nil
 }
 
-func (s *SetService) GetSetShots(ctx context.Context, id uuid.UUID) ([]db.Shot, error) {
+func (s *SetService) GetSetShots(ctx context.Context, externalUserID string, roundID, id uuid.UUID) ([]db.Shot, error) {
+	ctx, span := tracing.Start(ctx, "SetService.GetSetShots")
+	defer span.End()
+
+	if err := s.access.CanReadSet(ctx, externalUserID, roundID, id); err != nil {
+		return nil, err
+	}
+
 	shots, err := s.queries.GetShotsBySet(ctx, id)
 	if err != nil {
 		return nil, err
@@ -54,8 +106,19 @@ func (s *SetService) GetSetShots(ctx context.Context, id uuid.UUID) ([]db.Shot,
 	return shots, nil
 }
 
-func (s *SetService) GetSetsForQualificationRound(ctx context.Context, roundId pgtype.UUID) ([]db.Set, error) {
-	sets, err := s.queries.GetSetsForQualificationRound(ctx, roundId)
+func (s *SetService) GetSetsForQualificationRound(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+) ([]db.Set, error) {
+	ctx, span := tracing.Start(ctx, "SetService.GetSetsForQualificationRound")
+	defer span.End()
+
+	if err := s.access.CanReadRound(ctx, externalUserID, roundID); err != nil {
+		return nil, err
+	}
+
+	sets, err := s.queries.GetSetsForQualificationRound(ctx, pgtype.UUID{Bytes: roundID, Valid: true})
 
 	if err != nil {
 		return nil, err
@@ -1,37 +1,50 @@
 package services
 
 import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/metrics"
 	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/tracing"
 	"archy/scores/internal/db"
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type ShotService struct {
-	queries *db.Queries
+	queries    *db.Queries
+	access     *AccessService
+	signatures *SignatureService
+	audit      *AuditService
 }
 
-func NewShotService(queries *db.Queries) *ShotService {
-	return &ShotService{queries: queries}
+func NewShotService(
+	queries *db.Queries,
+	access *AccessService,
+	signatures *SignatureService,
+	audit *AuditService,
+) *ShotService {
+	return &ShotService{queries: queries, access: access, signatures: signatures, audit: audit}
 }
 
 func (s *ShotService) CreateShot(
 	ctx context.Context,
+	externalUserID string,
+	roundId uuid.UUID,
 	setId uuid.UUID,
 	shot models.CreateShotRequest,
 ) (*db.Shot, error) {
-	var x pgtype.Numeric
-	var y pgtype.Numeric
+	ctx, span := tracing.Start(ctx, "ShotService.CreateShot")
+	defer span.End()
 
-	errX := x.Scan(shot.X)
-	if errX != nil {
-		return nil, errX
-	}
-	errY := y.Scan(shot.Y)
-	if errY != nil {
-		return nil, errY
+	x, y, err := coordinates(shot, "")
+	if err != nil {
+		return nil, err
 	}
 
 	var text pgtype.Text
@@ -46,14 +59,87 @@ func (s *ShotService) CreateShot(
 		Notes: text,
 		SetID: setId,
 	}
-	sh, err := s.queries.CreateShot(ctx, params)
+	var sh db.Shot
+	err = s.queries.InTx(ctx, func(q *db.Queries) error {
+		owner, err := s.signatures.CanEditScores(ctx, q, externalUserID, roundId, &setId, shot.Reason)
+		if err != nil {
+			return err
+		}
+		if sh, err = q.CreateShot(ctx, params); err != nil {
+			return err
+		}
+		return s.audit.RecordWithReason(ctx, q, shot.Reason, AuditCreate, AuditEntityShot, sh.ID, owner, nil, sh)
+	})
 	if err != nil {
 		return nil, err
 	}
+
+	metrics.ShotsRecorded.Inc()
 	return &sh, nil
 }
 
-func (s *ShotService) GetShotsBySet(ctx context.Context, setId uuid.UUID) ([]db.Shot, error) {
+// CreateShotsBatch scores and inserts several shots of a set in one statement.
+func (s *ShotService) CreateShotsBatch(
+	ctx context.Context,
+	externalUserID string,
+	roundId uuid.UUID,
+	setId uuid.UUID,
+	req models.CreateShotsBatchRequest,
+) ([]db.Shot, error) {
+	ctx, span := tracing.Start(ctx, "ShotService.CreateShotsBatch")
+	defer span.End()
+
+	params := db.BatchCreateShotsParams{
+		SetID: setId,
+		Xs:    make([]pgtype.Numeric, len(req.Shots)),
+		Ys:    make([]pgtype.Numeric, len(req.Shots)),
+		Notes: make([]string, len(req.Shots)),
+	}
+
+	for i, shot := range req.Shots {
+		var err error
+		if params.Xs[i], params.Ys[i], err = coordinates(shot, fmt.Sprintf("shots[%d].", i)); err != nil {
+			return nil, err
+		}
+		params.Notes[i] = shot.Notes
+	}
+
+	var shots []db.Shot
+	err := s.queries.InTx(ctx, func(q *db.Queries) error {
+		owner, err := s.signatures.CanEditScores(ctx, q, externalUserID, roundId, &setId, req.Reason)
+		if err != nil {
+			return err
+		}
+		if shots, err = q.BatchCreateShots(ctx, params); err != nil {
+			return err
+		}
+		ids, after := make([]uuid.UUID, len(shots)), make([]any, len(shots))
+		for i, sh := range shots {
+			ids[i], after[i] = sh.ID, sh
+		}
+		return s.audit.RecordCreates(ctx, q, req.Reason, AuditEntityShot, owner, ids, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.ShotsRecorded.Add(float64(len(shots)))
+	return shots, nil
+}
+
+func (s *ShotService) GetShotsBySet(
+	ctx context.Context,
+	externalUserID string,
+	roundId uuid.UUID,
+	setId uuid.UUID,
+) ([]db.Shot, error) {
+	ctx, span := tracing.Start(ctx, "ShotService.GetShotsBySet")
+	defer span.End()
+
+	if err := s.access.CanReadSet(ctx, externalUserID, roundId, setId); err != nil {
+		return nil, err
+	}
+
 	sh, err := s.queries.GetShotsBySet(ctx, setId)
 	if err != nil {
 		return nil, err
@@ -61,10 +147,151 @@ func (s *ShotService) GetShotsBySet(ctx context.Context, setId uuid.UUID) ([]db.
 	return sh, nil
 }
 
-func (s *ShotService) GetShot(ctx context.Context, shotId uuid.UUID) (*db.Shot, error) {
+func (s *ShotService) GetShot(
+	ctx context.Context,
+	externalUserID string,
+	roundId uuid.UUID,
+	setId uuid.UUID,
+	shotId uuid.UUID,
+) (*db.Shot, error) {
+	ctx, span := tracing.Start(ctx, "ShotService.GetShot")
+	defer span.End()
+
+	if err := s.access.CanReadSet(ctx, externalUserID, roundId, setId); err != nil {
+		return nil, err
+	}
+
 	sh, err := s.queries.GetShot(ctx, shotId)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && sh.SetID != setId) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sh, nil
+}
+
+// UpdateShot moves a shot or changes its notes. The shot is rescored against
+// the round's target face.
+func (s *ShotService) UpdateShot(
+	ctx context.Context,
+	externalUserID string,
+	roundId uuid.UUID,
+	setId uuid.UUID,
+	shotId uuid.UUID,
+	req models.UpdateShotRequest,
+) (*db.Shot, error) {
+	ctx, span := tracing.Start(ctx, "ShotService.UpdateShot")
+	defer span.End()
+
+	var sh db.Shot
+	err := s.queries.InTx(ctx, func(q *db.Queries) error {
+		owner, err := s.signatures.CanEditScores(ctx, q, externalUserID, roundId, &setId, req.Reason)
+		if err != nil {
+			return err
+		}
+
+		before, err := q.GetShot(ctx, shotId)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && before.SetID != setId) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		params := db.UpdateShotParams{
+			ID:    shotId,
+			SetID: setId,
+			X:     before.X,
+			Y:     before.Y,
+			Notes: before.Notes,
+		}
+		if req.X != nil {
+			if params.X, err = floatToNumeric(*req.X); err != nil {
+				return err
+			}
+		}
+		if req.Y != nil {
+			if params.Y, err = floatToNumeric(*req.Y); err != nil {
+				return err
+			}
+		}
+		if req.Notes != nil {
+			params.Notes = pgtype.Text{String: *req.Notes, Valid: *req.Notes != ""}
+		}
+
+		if sh, err = q.UpdateShot(ctx, params); err != nil {
+			return err
+		}
+		return s.audit.RecordWithReason(ctx, q, req.Reason, AuditUpdate, AuditEntityShot, sh.ID, owner, before, sh)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &sh, nil
 }
+
+// DeleteShot soft-deletes a shot.
+func (s *ShotService) DeleteShot(
+	ctx context.Context,
+	externalUserID string,
+	roundId uuid.UUID,
+	setId uuid.UUID,
+	shotId uuid.UUID,
+	reason string,
+) error {
+	ctx, span := tracing.Start(ctx, "ShotService.DeleteShot")
+	defer span.End()
+
+	return s.queries.InTx(ctx, func(q *db.Queries) error {
+		owner, err := s.signatures.CanEditScores(ctx, q, externalUserID, roundId, &setId, reason)
+		if err != nil {
+			return err
+		}
+
+		before, err := q.GetShot(ctx, shotId)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && before.SetID != setId) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		rows, err := q.DeleteShot(ctx, db.DeleteShotParams{ID: shotId, SetID: setId})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return ErrNotFound
+		}
+		return s.audit.RecordWithReason(ctx, q, reason, AuditDelete, AuditEntityShot, shotId, owner, before, nil)
+	})
+}
+
+// coordinates converts the position of a new shot, reporting a missing
+// coordinate as invalid input for callers that skip request validation. The
+// prefix locates the shot in the request, as in validation errors.
+func coordinates(shot models.CreateShotRequest, prefix string) (x, y pgtype.Numeric, err error) {
+	if shot.X == nil {
+		return x, y, apperr.InvalidField(prefix+"x", "is required")
+	}
+	if shot.Y == nil {
+		return x, y, apperr.InvalidField(prefix+"y", "is required")
+	}
+	if x, err = floatToNumeric(*shot.X); err != nil {
+		return x, y, err
+	}
+	y, err = floatToNumeric(*shot.Y)
+	return x, y, err
+}
+
+// floatToNumeric converts a coordinate to NUMERIC; pgtype.Numeric only scans
+// from its text representation.
+func floatToNumeric(f float64) (pgtype.Numeric, error) {
+	var n pgtype.Numeric
+	err := n.Scan(strconv.FormatFloat(f, 'f', -1, 64))
+	return n, err
+}
@@ -0,0 +1,35 @@
+package services
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"reflect"
+	"testing"
+)
+
+func TestCoordinates(t *testing.T) {
+	one := 1.5
+	tests := []struct {
+		name string
+		shot models.CreateShotRequest
+		want []apperr.FieldError // nil when valid
+	}{
+		{"both", models.CreateShotRequest{X: &one, Y: &one}, nil},
+		{"no x", models.CreateShotRequest{Y: &one}, []apperr.FieldError{{Field: "shots[2].x", Message: "is required"}}},
+		{"no y", models.CreateShotRequest{X: &one}, []apperr.FieldError{{Field: "shots[2].y", Message: "is required"}}},
+	}
+
+	for _, tt := range tests {
+		x, y, err := coordinates(tt.shot, "shots[2].")
+		if tt.want == nil {
+			if err != nil || numericText(x) != "1.50" || numericText(y) != "1.50" {
+				t.Errorf("%s: coordinates = %v, %v, %v", tt.name, x, y, err)
+			}
+			continue
+		}
+		e, ok := apperr.As(err)
+		if !ok || e.Kind != apperr.KindValidation || !reflect.DeepEqual(e.Fields, tt.want) {
+			t.Errorf("%s: coordinates error = %v, want %+v", tt.name, err, tt.want)
+		}
+	}
+}
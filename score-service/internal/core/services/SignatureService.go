@@ -0,0 +1,319 @@
+package services
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/metrics"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/tracing"
+	"archy/scores/internal/db"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// scoresHashAlgorithm names the hash returned by VerifyRound. The "v1" in the
+// hashed preamble versions the serialisation of shots.
+const scoresHashAlgorithm = "sha256"
+
+// SignatureService handles sign-off of score sheets. Once an archer, witness
+// or judge has signed an end, or the whole round, its scores can only be
+// changed by a judge giving a reason.
+type SignatureService struct {
+	queries *db.Queries
+	access  *AccessService
+}
+
+func NewSignatureService(queries *db.Queries, access *AccessService) *SignatureService {
+	return &SignatureService{queries: queries, access: access}
+}
+
+// Sign records the caller's signature on a round, or on one end when setID is
+// not nil. Archers sign their own rounds, witnesses sign the rounds of archers
+// whose data they may read and judges sign any round with a reason, e.g. to
+// override a missing signature.
+func (s *SignatureService) Sign(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+	setID *uuid.UUID,
+	req models.SignScoresRequest,
+) (*db.ScoreSignature, error) {
+	ctx, span := tracing.Start(ctx, "SignatureService.Sign")
+	defer span.End()
+
+	owner, err := s.access.roundOwner(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	if setID != nil {
+		if _, err := s.access.setOwner(ctx, roundID, *setID); err != nil {
+			return nil, err
+		}
+	}
+
+	switch req.Role {
+	case models.SignerArcher:
+		if err := s.access.CanWrite(ctx, externalUserID, owner); err != nil {
+			return nil, err
+		}
+	case models.SignerWitness:
+		if externalUserID == owner {
+			return nil, ErrForbidden
+		}
+		// A witness signature locks the scores, so only someone who may
+		// already read them, such as the archer's coach or an official,
+		// can give one.
+		if err := s.access.CanRead(ctx, externalUserID, owner); err != nil {
+			return nil, err
+		}
+	case models.SignerJudge:
+		judge, err := s.access.IsJudge(ctx, externalUserID)
+		if err != nil {
+			return nil, err
+		}
+		if !judge {
+			return nil, ErrForbidden
+		}
+		if strings.TrimSpace(req.Reason) == "" {
+			return nil, ErrReasonRequired
+		}
+	default:
+		return nil, apperr.InvalidField("role", fmt.Sprintf("unknown signer role %q", req.Role))
+	}
+
+	// The round stays locked until the signature is stored, so that the
+	// scores cannot change after they were hashed.
+	var signature db.ScoreSignature
+	err = s.queries.InTx(ctx, func(q *db.Queries) error {
+		if err := q.LockRoundScores(ctx, roundID); err != nil {
+			return err
+		}
+		verification, err := s.verify(ctx, q, roundID)
+		if err != nil {
+			return err
+		}
+		params := db.CreateScoreSignatureParams{
+			RoundID:      roundID,
+			SignerUserID: externalUserID,
+			SignerRole:   req.Role,
+			Reason:       pgtype.Text{String: req.Reason, Valid: req.Reason != ""},
+			ScoresHash:   verification.Hash,
+		}
+		if setID != nil {
+			params.SetID = pgtype.UUID{Bytes: *setID, Valid: true}
+			params.ScoresHash = setHash(verification, *setID)
+		}
+		signature, err = q.CreateScoreSignature(ctx, params)
+		return err
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return nil, ErrAlreadySigned
+	}
+	if err != nil {
+		return nil, err
+	}
+	metrics.ScoresSigned.WithLabelValues(req.Role).Inc()
+	return &signature, nil
+}
+
+// ListSignatures returns the signatures on a round the user may read.
+func (s *SignatureService) ListSignatures(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+) ([]db.ScoreSignature, error) {
+	ctx, span := tracing.Start(ctx, "SignatureService.ListSignatures")
+	defer span.End()
+
+	if err := s.access.CanReadRound(ctx, externalUserID, roundID); err != nil {
+		return nil, err
+	}
+	return s.queries.ListScoreSignatures(ctx, roundID)
+}
+
+// VerifyRound hashes the round's shots and checks every signature against
+// the scores it signed.
+func (s *SignatureService) VerifyRound(
+	ctx context.Context,
+	externalUserID string,
+	roundID uuid.UUID,
+) (*models.RoundVerificationResponse, error) {
+	ctx, span := tracing.Start(ctx, "SignatureService.VerifyRound")
+	defer span.End()
+
+	if err := s.access.CanReadRound(ctx, externalUserID, roundID); err != nil {
+		return nil, err
+	}
+
+	verification, err := s.verify(ctx, s.queries, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	signatures, err := s.queries.ListScoreSignatures(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	verification.Verified = len(signatures) > 0
+	for _, sig := range signatures {
+		current := verification.Hash
+		if sig.SetID.Valid {
+			current = setHash(verification, sig.SetID.Bytes)
+		}
+		valid := sig.ScoresHash == current
+		verification.Signatures = append(verification.Signatures, models.SignatureVerification{
+			ScoreSignature: sig,
+			Valid:          valid,
+		})
+		verification.Verified = verification.Verified && valid
+	}
+
+	return verification, nil
+}
+
+// verify hashes the shots of a round, per end and for the whole round.
+func (s *SignatureService) verify(
+	ctx context.Context,
+	q *db.Queries,
+	roundID uuid.UUID,
+) (*models.RoundVerificationResponse, error) {
+	shots, err := q.ListRoundShotsForVerification(ctx, pgtype.UUID{Bytes: roundID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &models.RoundVerificationResponse{
+		RoundID:    roundID,
+		Algorithm:  scoresHashAlgorithm,
+		ShotCount:  len(shots),
+		Sets:       []models.SetVerification{},
+		Signatures: []models.SignatureVerification{},
+	}
+
+	round := sha256.New()
+	fmt.Fprintf(round, "archy-round-v1\n%s\n", roundID)
+
+	var set hash.Hash
+	finishSet := func() {
+		if set != nil {
+			res.Sets[len(res.Sets)-1].Hash = hex.EncodeToString(set.Sum(nil))
+		}
+	}
+	for _, shot := range shots {
+		if len(res.Sets) == 0 || res.Sets[len(res.Sets)-1].SetID != shot.SetID {
+			finishSet()
+			set = sha256.New()
+			fmt.Fprintf(set, "archy-set-v1\n%s\n%s\n", roundID, shot.SetID)
+			res.Sets = append(res.Sets, models.SetVerification{SetID: shot.SetID, SetNumber: int(shot.SetNumber)})
+		}
+
+		line := shotLine(shot)
+		round.Write(line)
+		set.Write(line)
+		res.Sets[len(res.Sets)-1].ShotCount++
+	}
+	finishSet()
+
+	res.Hash = hex.EncodeToString(round.Sum(nil))
+	return res, nil
+}
+
+// setHash returns the hash of an end, or of an empty end when it has no
+// shots.
+func setHash(verification *models.RoundVerificationResponse, setID uuid.UUID) string {
+	for _, set := range verification.Sets {
+		if set.SetID == setID {
+			return set.Hash
+		}
+	}
+	empty := sha256.Sum256(fmt.Appendf(nil, "archy-set-v1\n%s\n%s\n", verification.RoundID, setID))
+	return hex.EncodeToString(empty[:])
+}
+
+// shotLine serialises a shot for hashing. Coordinates are stored with two
+// decimals and are formatted the same way regardless of their scale.
+func shotLine(shot db.ListRoundShotsForVerificationRow) []byte {
+	return fmt.Appendf(nil, "%d|%s|%s|%s|%s|%d|%t|%t\n",
+		shot.SetNumber, shot.SetID, shot.ID,
+		numericText(shot.X), numericText(shot.Y),
+		shot.Score, shot.IsX, shot.IsMiss)
+}
+
+func numericText(n pgtype.Numeric) string {
+	f, err := n.Float64Value()
+	if err != nil || !f.Valid {
+		return ""
+	}
+	return fmt.Sprintf("%.2f", f.Float64)
+}
+
+// CanEditScores checks that the user may change the scores of a round, or of
+// one end when setID is not nil, and returns the round's owner. Owners may
+// change unsigned scores. Judges may change any scores but must give a
+// reason when the scores are signed or not their own.
+//
+// The round is locked against signatures until the transaction of q ends, so
+// the change must be made with q for the check to hold.
+func (s *SignatureService) CanEditScores(
+	ctx context.Context,
+	q *db.Queries,
+	externalUserID string,
+	roundID uuid.UUID,
+	setID *uuid.UUID,
+	reason string,
+) (string, error) {
+	ctx, span := tracing.Start(ctx, "SignatureService.CanEditScores")
+	defer span.End()
+
+	var owner string
+	var err error
+	if setID != nil {
+		owner, err = s.access.setOwner(ctx, roundID, *setID)
+	} else {
+		owner, err = s.access.roundOwner(ctx, roundID)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := q.LockRoundScores(ctx, roundID); err != nil {
+		return "", err
+	}
+	params := db.IsSetSignedParams{RoundID: roundID}
+	if setID != nil {
+		params.SetID = pgtype.UUID{Bytes: *setID, Valid: true}
+	}
+	signed, err := q.IsSetSigned(ctx, params)
+	if err != nil {
+		return "", err
+	}
+
+	writeErr := s.access.CanWrite(ctx, externalUserID, owner)
+	if writeErr == nil && !signed {
+		return owner, nil
+	}
+
+	judge, err := s.access.IsJudge(ctx, externalUserID)
+	if err != nil {
+		return "", err
+	}
+	if !judge {
+		if writeErr != nil {
+			return "", writeErr
+		}
+		return "", ErrLocked
+	}
+	if strings.TrimSpace(reason) == "" {
+		return "", ErrReasonRequired
+	}
+	return owner, nil
+}
@@ -0,0 +1,274 @@
+package services
+
+import (
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/database/dbtest"
+	"archy/scores/internal/db"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestMain(m *testing.M) { os.Exit(dbtest.Main(m)) }
+
+func TestShotLine(t *testing.T) {
+	setID, shotID := uuid.New(), uuid.New()
+	var x, y pgtype.Numeric
+	if err := x.Scan("0.5"); err != nil {
+		t.Fatal(err)
+	}
+	if err := y.Scan("-3.00"); err != nil {
+		t.Fatal(err)
+	}
+
+	got := string(shotLine(db.ListRoundShotsForVerificationRow{
+		SetID: setID, SetNumber: 2, ID: shotID, X: x, Y: y, Score: 10, IsX: true,
+	}))
+	want := fmt.Sprintf("2|%s|%s|0.50|-3.00|10|true|false\n", setID, shotID)
+	if got != want {
+		t.Errorf("shotLine = %q, want %q", got, want)
+	}
+}
+
+func TestSetHash(t *testing.T) {
+	roundID, shot, empty := uuid.New(), uuid.New(), uuid.New()
+	verification := &models.RoundVerificationResponse{
+		RoundID: roundID,
+		Sets:    []models.SetVerification{{SetID: shot, Hash: "hash of the end"}},
+	}
+
+	if got := setHash(verification, shot); got != "hash of the end" {
+		t.Errorf("setHash of an end with shots = %q", got)
+	}
+	sum := sha256.Sum256(fmt.Appendf(nil, "archy-set-v1\n%s\n%s\n", roundID, empty))
+	if got := setHash(verification, empty); got != hex.EncodeToString(sum[:]) {
+		t.Errorf("setHash of an empty end = %q, want the hash of its preamble", got)
+	}
+	if setHash(verification, empty) == setHash(verification, uuid.New()) {
+		t.Error("empty ends should hash differently")
+	}
+}
+
+// signatureFixture holds a round by archer, whose coach is coach, with three
+// arrows in its first end and none in its second.
+type signatureFixture struct {
+	t     *testing.T
+	q     *db.Queries
+	s     *SignatureService
+	round db.QualificationRound
+	ends  [2]uuid.UUID
+}
+
+func newSignatureFixture(t *testing.T) *signatureFixture {
+	t.Helper()
+	t.Parallel()
+	q := db.New(dbtest.New(t))
+	ctx := context.Background()
+
+	faces, err := q.ListTargetFaces(ctx)
+	if err != nil || len(faces) == 0 {
+		t.Fatalf("ListTargetFaces = %d faces, %v", len(faces), err)
+	}
+	round, err := q.CreateQualificationRound(ctx, db.CreateQualificationRoundParams{
+		ExternalUserID: "archer", RoundType: "training", Name: "Club round", Distance: 70,
+		TotalSets: 2, ShotsPerSet: 3, TargetFaceID: faces[0].ID, BowClass: "recurve", AgeCategory: "adult",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &signatureFixture{t: t, q: q, s: NewSignatureService(q, NewAccessService(q)), round: round}
+	for i := range f.ends {
+		set, err := q.CreateSet(ctx, db.CreateSetParams{
+			SetNumber: int32(i + 1), MaxShots: 3, ParentRoundID: pgtype.UUID{Bytes: round.ID, Valid: true},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.ends[i] = set.ID
+	}
+	f.shoot(f.ends[0], 0, 50, 100)
+	if _, err := q.AddCoachAthlete(ctx, db.AddCoachAthleteParams{CoachUserID: "coach", AthleteUserID: "archer"}); err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// shoot adds arrows at the given heights on the vertical axis to an end.
+func (f *signatureFixture) shoot(setID uuid.UUID, ys ...float64) {
+	f.t.Helper()
+	params := db.BatchCreateShotsParams{SetID: setID, Notes: make([]string, len(ys))}
+	for _, y := range ys {
+		x, err := floatToNumeric(0)
+		if err != nil {
+			f.t.Fatal(err)
+		}
+		n, err := floatToNumeric(y)
+		if err != nil {
+			f.t.Fatal(err)
+		}
+		params.Xs, params.Ys = append(params.Xs, x), append(params.Ys, n)
+	}
+	if _, err := f.q.BatchCreateShots(context.Background(), params); err != nil {
+		f.t.Fatal(err)
+	}
+}
+
+// as returns a context signed in as user with the given roles.
+func as(user string, roles ...string) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{
+		UserID: user, TokenType: auth.TokenTypeJWT, Roles: roles, LocalRolesLoaded: true,
+	})
+}
+
+func TestSignatureService_Sign(t *testing.T) {
+	f := newSignatureFixture(t)
+	end := &f.ends[0]
+
+	tests := []struct {
+		name  string
+		user  string
+		roles []string
+		setID *uuid.UUID
+		req   models.SignScoresRequest
+		want  error // nil when allowed
+	}{
+		{"stranger as witness", "stranger", nil, end, models.SignScoresRequest{Role: models.SignerWitness}, ErrNotFound},
+		{"archer as own witness", "archer", nil, end, models.SignScoresRequest{Role: models.SignerWitness}, ErrForbidden},
+		{"stranger as archer", "stranger", nil, end, models.SignScoresRequest{Role: models.SignerArcher}, ErrNotFound},
+		{"coach as archer", "coach", nil, end, models.SignScoresRequest{Role: models.SignerArcher}, ErrForbidden},
+		{"archer as judge", "archer", nil, end, models.SignScoresRequest{Role: models.SignerJudge, Reason: "mine"}, ErrForbidden},
+		{"judge without a reason", "judge", []string{auth.RoleJudge}, end, models.SignScoresRequest{Role: models.SignerJudge}, ErrReasonRequired},
+		{"archer", "archer", nil, end, models.SignScoresRequest{Role: models.SignerArcher}, nil},
+		{"archer again", "archer", nil, end, models.SignScoresRequest{Role: models.SignerArcher}, ErrAlreadySigned},
+		{"coach as witness", "coach", nil, end, models.SignScoresRequest{Role: models.SignerWitness}, nil},
+		{"official as witness", "admin", []string{auth.RoleAdmin}, nil, models.SignScoresRequest{Role: models.SignerWitness}, nil},
+		{"judge", "judge", []string{auth.RoleJudge}, nil, models.SignScoresRequest{Role: models.SignerJudge, Reason: "checked"}, nil},
+	}
+	for _, tt := range tests {
+		_, err := f.s.Sign(as(tt.user, tt.roles...), tt.user, f.round.ID, tt.setID, tt.req)
+		if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+			t.Errorf("%s: Sign = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+
+	if _, err := f.s.Sign(as("stranger"), "stranger", uuid.New(), nil, models.SignScoresRequest{Role: models.SignerWitness}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Sign of an unknown round = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestSignatureService_SignedHashesVerify(t *testing.T) {
+	f := newSignatureFixture(t)
+	ctx := as("archer")
+
+	end, err := f.s.Sign(ctx, "archer", f.round.ID, &f.ends[0], models.SignScoresRequest{Role: models.SignerArcher})
+	if err != nil {
+		t.Fatal(err)
+	}
+	empty, err := f.s.Sign(ctx, "archer", f.round.ID, &f.ends[1], models.SignScoresRequest{Role: models.SignerArcher})
+	if err != nil {
+		t.Fatal(err)
+	}
+	round, err := f.s.Sign(ctx, "archer", f.round.ID, nil, models.SignScoresRequest{Role: models.SignerArcher})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := f.s.VerifyRound(ctx, "archer", f.round.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Verified || res.ShotCount != 3 || len(res.Sets) != 1 || res.Sets[0].ShotCount != 3 {
+		t.Fatalf("VerifyRound = %+v, want 3 verified shots in one end", res)
+	}
+	if end.ScoresHash != res.Sets[0].Hash || round.ScoresHash != res.Hash || empty.ScoresHash != setHash(res, f.ends[1]) {
+		t.Errorf("signatures do not match the hashes of the scores they signed")
+	}
+
+	// Hashing is stable, and changing a shot invalidates the signatures on it.
+	again, err := f.s.verify(context.Background(), f.q, f.round.ID)
+	if err != nil || again.Hash != res.Hash {
+		t.Fatalf("verify = %v, %v, want the same hash", again, err)
+	}
+	f.shoot(f.ends[1], 200)
+	res, err = f.s.VerifyRound(ctx, "archer", f.round.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := map[uuid.UUID]bool{}
+	for _, sig := range res.Signatures {
+		valid[sig.ID] = sig.Valid
+	}
+	if res.Verified || !valid[end.ID] || valid[empty.ID] || valid[round.ID] {
+		t.Errorf("expected only the untouched end to verify, got %+v", res.Signatures)
+	}
+}
+
+func TestSignatureService_CanEditScores(t *testing.T) {
+	f := newSignatureFixture(t)
+	signed, unsigned := &f.ends[0], &f.ends[1]
+	if _, err := f.s.Sign(as("archer"), "archer", f.round.ID, signed, models.SignScoresRequest{Role: models.SignerArcher}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		user   string
+		roles  []string
+		setID  *uuid.UUID
+		reason string
+		want   error // nil when allowed
+	}{
+		{"owner, unsigned end", "archer", nil, unsigned, "", nil},
+		{"owner, signed end", "archer", nil, signed, "", ErrLocked},
+		{"owner, round with a signed end", "archer", nil, nil, "", nil},
+		{"coach", "coach", nil, unsigned, "", ErrForbidden},
+		{"stranger", "stranger", nil, unsigned, "", ErrNotFound},
+		{"judge without a reason", "judge", []string{auth.RoleJudge}, signed, "", ErrReasonRequired},
+		{"judge, unsigned end of another archer", "judge", []string{auth.RoleJudge}, unsigned, " ", ErrReasonRequired},
+		{"judge", "judge", []string{auth.RoleJudge}, signed, "line call", nil},
+	}
+	for _, tt := range tests {
+		owner, err := f.s.CanEditScores(as(tt.user, tt.roles...), f.q, tt.user, f.round.ID, tt.setID, tt.reason)
+		if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+			t.Errorf("%s: CanEditScores = %v, want %v", tt.name, err, tt.want)
+		}
+		if err == nil && owner != "archer" {
+			t.Errorf("%s: owner = %q, want archer", tt.name, owner)
+		}
+	}
+
+	// Signing waits for an edit that passed the check to finish.
+	err := f.q.InTx(context.Background(), func(q *db.Queries) error {
+		if _, err := f.s.CanEditScores(as("archer"), q, "archer", f.round.ID, unsigned, ""); err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(as("coach"), 200*time.Millisecond)
+		defer cancel()
+		_, err := f.s.Sign(ctx, "coach", f.round.ID, unsigned, models.SignScoresRequest{Role: models.SignerWitness})
+		if !pgconn.Timeout(err) {
+			t.Errorf("Sign during an edit = %v, want it to wait for the edit", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A signature on the round locks every end.
+	if _, err := f.s.Sign(as("archer"), "archer", f.round.ID, nil, models.SignScoresRequest{Role: models.SignerArcher}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.s.CanEditScores(as("archer"), f.q, "archer", f.round.ID, unsigned, ""); !errors.Is(err, ErrLocked) {
+		t.Errorf("CanEditScores after signing the round = %v, want %v", err, ErrLocked)
+	}
+}
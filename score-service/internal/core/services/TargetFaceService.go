@@ -0,0 +1,191 @@
+package services
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/handicap"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/tracing"
+	"archy/scores/internal/db"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TargetFaceService struct {
+	queries *db.Queries
+	audit   *AuditService
+}
+
+func NewTargetFaceService(queries *db.Queries, audit *AuditService) *TargetFaceService {
+	return &TargetFaceService{queries: queries, audit: audit}
+}
+
+func (s *TargetFaceService) ListTargetFaces(ctx context.Context) ([]db.TargetFace, error) {
+	ctx, span := tracing.Start(ctx, "TargetFaceService.ListTargetFaces")
+	defer span.End()
+
+	return s.queries.ListTargetFaces(ctx)
+}
+
+func (s *TargetFaceService) GetTargetFace(ctx context.Context, id uuid.UUID) (*db.TargetFace, error) {
+	ctx, span := tracing.Start(ctx, "TargetFaceService.GetTargetFace")
+	defer span.End()
+
+	tf, err := s.queries.GetTargetFace(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tf, nil
+}
+
+// CreateTargetFace stores a new face. The maximum arrow score is derived from
+// the zones, which must parse as a handicap.Face.
+func (s *TargetFaceService) CreateTargetFace(ctx context.Context, req models.TargetFaceRequest) (*db.TargetFace, error) {
+	ctx, span := tracing.Start(ctx, "TargetFaceService.CreateTargetFace")
+	defer span.End()
+
+	face, err := parseZones(req.ZonesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var tf db.TargetFace
+	err = s.queries.InTx(ctx, func(q *db.Queries) error {
+		var err error
+		tf, err = q.CreateTargetFace(ctx, db.CreateTargetFaceParams{
+			Name:            req.Name,
+			Standard:        req.Standard,
+			TotalDiameter:   int32(req.TotalDiameter),
+			ScoringDiameter: int32(req.ScoringDiameter),
+			ZonesConfig:     req.ZonesConfig,
+			MaxScore:        int32(face.MaxScore()),
+			HasX:            req.HasX,
+			Description:     pgtype.Text{String: req.Description, Valid: req.Description != ""},
+		})
+		if err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, q, AuditCreate, AuditEntityTargetFace, tf.ID, "", nil, tf)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &tf, nil
+}
+
+// UpdateTargetFace replaces a face. The zones of a face that rounds were shot
+// on cannot change, since their arrows would score differently from then on;
+// its name and description can.
+func (s *TargetFaceService) UpdateTargetFace(
+	ctx context.Context,
+	id uuid.UUID,
+	req models.TargetFaceRequest,
+) (*db.TargetFace, error) {
+	ctx, span := tracing.Start(ctx, "TargetFaceService.UpdateTargetFace")
+	defer span.End()
+
+	face, err := parseZones(req.ZonesConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := s.GetTargetFace(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !sameZones(before.ZonesConfig, req.ZonesConfig) {
+		if err := s.checkUnused(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	var tf db.TargetFace
+	err = s.queries.InTx(ctx, func(q *db.Queries) error {
+		var err error
+		tf, err = q.UpdateTargetFace(ctx, db.UpdateTargetFaceParams{
+			ID:              id,
+			Name:            req.Name,
+			Standard:        req.Standard,
+			TotalDiameter:   int32(req.TotalDiameter),
+			ScoringDiameter: int32(req.ScoringDiameter),
+			ZonesConfig:     req.ZonesConfig,
+			MaxScore:        int32(face.MaxScore()),
+			HasX:            req.HasX,
+			Description:     pgtype.Text{String: req.Description, Valid: req.Description != ""},
+		})
+		if err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, q, AuditUpdate, AuditEntityTargetFace, tf.ID, "", before, tf)
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &tf, nil
+}
+
+// DeleteTargetFace soft-deletes a face that no round was shot on.
+func (s *TargetFaceService) DeleteTargetFace(ctx context.Context, id uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "TargetFaceService.DeleteTargetFace")
+	defer span.End()
+
+	before, err := s.GetTargetFace(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.checkUnused(ctx, id); err != nil {
+		return err
+	}
+
+	return s.queries.InTx(ctx, func(q *db.Queries) error {
+		rows, err := q.DeleteTargetFace(ctx, id)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return ErrNotFound
+		}
+		return s.audit.Record(ctx, q, AuditDelete, AuditEntityTargetFace, id, "", before, nil)
+	})
+}
+
+func (s *TargetFaceService) checkUnused(ctx context.Context, id uuid.UUID) error {
+	inUse, err := s.queries.IsTargetFaceInUse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		return ErrTargetFaceInUse
+	}
+	return nil
+}
+
+// sameZones reports whether two zone configurations are the same JSON value,
+// ignoring formatting and key order.
+func sameZones(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// parseZones parses the zones of a face, reporting bad zones as invalid input.
+func parseZones(zones []byte) (handicap.Face, error) {
+	face, err := handicap.ParseFace(zones)
+	if err != nil {
+		return nil, apperr.InvalidField("zones_config", err.Error())
+	}
+	return face, nil
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/auth"
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/tracing"
+	"archy/scores/internal/db"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// PersonalTokenPrefix starts every personal access token, so the
+// authentication middleware can tell them apart from JWTs.
+const PersonalTokenPrefix = "archy_pat_"
+
+// personalTokenDisplayLength is how much of the token is stored in clear to
+// let users recognise it.
+const personalTokenDisplayLength = len(PersonalTokenPrefix) + 6
+
+// PersonalTokenScopes can be granted to a personal access token.
+var PersonalTokenScopes = []string{
+	auth.ScopeScoresRead,
+	auth.ScopeScoresWrite,
+	auth.ScopeTargetFacesWrite,
+	auth.ScopeCoachesWrite,
+}
+
+var (
+	// ErrInvalidPersonalToken is returned for unknown, revoked or expired tokens.
+	ErrInvalidPersonalToken = apperr.Unauthorized("invalid personal access token")
+	// ErrInvalidScope is returned when a token is requested with a scope that
+	// does not exist or that the user does not hold.
+	ErrInvalidScope = apperr.InvalidField("scopes", "invalid scope")
+	// ErrTokenFromToken is returned when a personal access token is used to
+	// create another one.
+	ErrTokenFromToken = apperr.Forbidden("personal access tokens can only be created when signed in")
+)
+
+// TokenService issues and verifies personal access tokens. Only a SHA-256
+// hash of each token is stored.
+type TokenService struct {
+	queries *db.Queries
+	access  *AccessService
+}
+
+func NewTokenService(queries *db.Queries, access *AccessService) *TokenService {
+	return &TokenService{queries: queries, access: access}
+}
+
+// CreateToken issues a token for the signed-in user. Tokens cannot be used to
+// create further tokens, and cannot hold scopes the user does not have.
+func (s *TokenService) CreateToken(
+	ctx context.Context,
+	externalUserID string,
+	req models.CreatePersonalAccessTokenRequest,
+) (*models.CreatedPersonalAccessTokenResponse, error) {
+	ctx, span := tracing.Start(ctx, "TokenService.CreateToken")
+	defer span.End()
+
+	p, ok := auth.PrincipalFromContext(ctx)
+	if !ok || p.UserID != externalUserID || p.IsPersonalToken() {
+		return nil, ErrTokenFromToken
+	}
+
+	scopes := req.Scopes
+	if len(scopes) == 0 {
+		scopes = auth.UserScopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(PersonalTokenScopes, scope) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidScope, scope)
+		}
+		held, err := s.access.HasScope(ctx, p, scope)
+		if err != nil {
+			return nil, err
+		}
+		if !held {
+			return nil, fmt.Errorf("%w: %q is not granted to you", ErrInvalidScope, scope)
+		}
+	}
+
+	token := PersonalTokenPrefix + strings.ToLower(rand.Text())
+	hash := sha256.Sum256([]byte(token))
+
+	params := db.CreatePersonalAccessTokenParams{
+		ExternalUserID: externalUserID,
+		Name:           req.Name,
+		TokenPrefix:    token[:personalTokenDisplayLength],
+		TokenHash:      hash[:],
+		Scopes:         slices.Compact(slices.Sorted(slices.Values(scopes))),
+	}
+	if req.ExpiresInDays != nil {
+		params.ExpiresAt = pgtype.Timestamptz{
+			Time:  time.Now().AddDate(0, 0, *req.ExpiresInDays),
+			Valid: true,
+		}
+	}
+
+	pat, err := s.queries.CreatePersonalAccessToken(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CreatedPersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: personalTokenResponse(pat),
+		Token:                       token,
+	}, nil
+}
+
+func (s *TokenService) ListTokens(ctx context.Context, externalUserID string) ([]models.PersonalAccessTokenResponse, error) {
+	ctx, span := tracing.Start(ctx, "TokenService.ListTokens")
+	defer span.End()
+
+	tokens, err := s.queries.ListPersonalAccessTokens(ctx, externalUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.PersonalAccessTokenResponse, len(tokens))
+	for i, t := range tokens {
+		res[i] = personalTokenResponse(t)
+	}
+	return res, nil
+}
+
+// RevokeToken revokes one of the user's tokens. It takes effect on the next request.
+func (s *TokenService) RevokeToken(ctx context.Context, externalUserID string, tokenID uuid.UUID) error {
+	ctx, span := tracing.Start(ctx, "TokenService.RevokeToken")
+	defer span.End()
+
+	rows, err := s.queries.RevokePersonalAccessToken(ctx, db.RevokePersonalAccessTokenParams{
+		ID:             tokenID,
+		ExternalUserID: externalUserID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Authenticate resolves a personal access token to the principal it was
+// issued to.
+func (s *TokenService) Authenticate(ctx context.Context, token string) (*auth.Principal, error) {
+	ctx, span := tracing.Start(ctx, "TokenService.Authenticate")
+	defer span.End()
+
+	if !strings.HasPrefix(token, PersonalTokenPrefix) {
+		return nil, ErrInvalidPersonalToken
+	}
+
+	hash := sha256.Sum256([]byte(token))
+	pat, err := s.queries.GetActivePersonalAccessToken(ctx, hash[:])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrInvalidPersonalToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.queries.TouchPersonalAccessToken(ctx, pat.ID); err != nil {
+		return nil, err
+	}
+
+	return &auth.Principal{
+		UserID:    pat.ExternalUserID,
+		TokenType: auth.TokenTypePersonal,
+		TokenID:   pat.ID.String(),
+		Scopes:    pat.Scopes,
+		// Roles are left to AccessService to load from user_roles: a scope
+		// implied by a role is only honoured while the user still holds it.
+	}, nil
+}
+
+func personalTokenResponse(t db.PersonalAccessToken) models.PersonalAccessTokenResponse {
+	res := models.PersonalAccessTokenResponse{
+		ID:          t.ID,
+		Name:        t.Name,
+		TokenPrefix: t.TokenPrefix,
+		Scopes:      t.Scopes,
+		CreatedAt:   t.CreatedAt,
+	}
+	if t.ExpiresAt.Valid {
+		res.ExpiresAt = &t.ExpiresAt.Time
+	}
+	if t.LastUsedAt.Valid {
+		res.LastUsedAt = &t.LastUsedAt.Time
+	}
+	return res
+}
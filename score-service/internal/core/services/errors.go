@@ -0,0 +1,22 @@
+package services
+
+import "archy/scores/internal/core/apperr"
+
+var (
+	// ErrNotFound is returned when a resource does not exist or the caller
+	// may not know that it does.
+	ErrNotFound = apperr.NotFound("not found")
+	// ErrForbidden is returned when the caller can see a resource but not change it.
+	ErrForbidden = apperr.Forbidden("forbidden")
+	// ErrLocked is returned when a change touches signed scores and the
+	// caller is not a judge.
+	ErrLocked = apperr.Conflict("scores are signed and can only be changed by a judge")
+	// ErrAlreadySigned is returned when a signer signs the same scores twice.
+	ErrAlreadySigned = apperr.Conflict("scores are already signed in this role")
+	// ErrTargetFaceInUse is returned when a change to a target face would
+	// rescore the rounds shot on it.
+	ErrTargetFaceInUse = apperr.Conflict("target face is used by rounds; add a new face instead")
+	// ErrReasonRequired is returned when a judge changes or signs scores
+	// without giving a reason.
+	ErrReasonRequired = apperr.InvalidField("reason", "a reason is required")
+)
@@ -0,0 +1,169 @@
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrNotEnoughPoints is returned when a regression has fewer than three
+// observations or no variance in x, so a slope cannot be tested.
+var ErrNotEnoughPoints = errors.New("not enough points for regression")
+
+// Regression is the result of an ordinary least squares fit y = Intercept + Slope*x.
+type Regression struct {
+	N         int
+	Slope     float64
+	Intercept float64
+	RSquared  float64
+	StdErr    float64 // standard error of the slope
+	TValue    float64
+	PValue    float64 // two-sided p-value for H0: slope == 0
+}
+
+// LinearRegression fits a simple linear regression of ys on xs and tests the
+// slope against zero with a Student t-test on n-2 degrees of freedom.
+func LinearRegression(xs, ys []float64) (Regression, error) {
+	n := len(xs)
+	if n != len(ys) {
+		return Regression{}, errors.New("xs and ys must have the same length")
+	}
+	if n < 3 {
+		return Regression{}, ErrNotEnoughPoints
+	}
+
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= float64(n)
+	meanY /= float64(n)
+
+	var sxx, sxy, syy float64
+	for i := range xs {
+		dx := xs[i] - meanX
+		dy := ys[i] - meanY
+		sxx += dx * dx
+		sxy += dx * dy
+		syy += dy * dy
+	}
+	if sxx == 0 {
+		return Regression{}, ErrNotEnoughPoints
+	}
+
+	slope := sxy / sxx
+	res := Regression{
+		N:         n,
+		Slope:     slope,
+		Intercept: meanY - slope*meanX,
+	}
+
+	sse := syy - slope*sxy
+	if sse < 0 {
+		sse = 0
+	}
+	if syy > 0 {
+		res.RSquared = 1 - sse/syy
+	}
+
+	df := float64(n - 2)
+	res.StdErr = math.Sqrt(sse / df / sxx)
+	switch {
+	case res.StdErr > 0:
+		res.TValue = slope / res.StdErr
+		res.PValue = StudentTTwoSided(res.TValue, df)
+	case slope != 0:
+		// Perfect fit: the slope is exact, so it is as significant as it gets.
+		res.TValue = math.Inf(int(math.Copysign(1, slope)))
+		res.PValue = 0
+	default:
+		res.PValue = 1
+	}
+
+	return res, nil
+}
+
+// StudentTTwoSided returns P(|T| >= |t|) for a Student t distribution with
+// df degrees of freedom.
+func StudentTTwoSided(t, df float64) float64 {
+	if math.IsInf(t, 0) {
+		return 0
+	}
+	return regularizedIncompleteBeta(df/(df+t*t), df/2, 0.5)
+}
+
+// regularizedIncompleteBeta computes I_x(a, b) using the continued fraction
+// expansion from Numerical Recipes (betai/betacf).
+func regularizedIncompleteBeta(x, a, b float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	lga, _ := math.Lgamma(a)
+	lgb, _ := math.Lgamma(b)
+	lgab, _ := math.Lgamma(a + b)
+	front := math.Exp(lgab - lga - lgb + a*math.Log(x) + b*math.Log(1-x))
+
+	if x < (a+1)/(a+b+2) {
+		return front * betaContinuedFraction(x, a, b) / a
+	}
+	return 1 - front*betaContinuedFraction(1-x, b, a)/b
+}
+
+func betaContinuedFraction(x, a, b float64) float64 {
+	const (
+		maxIterations = 200
+		epsilon       = 3e-14
+		tiny          = 1e-300
+	)
+
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+	c := 1.0
+	d := 1 - qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIterations; m++ {
+		fm := float64(m)
+		m2 := 2 * fm
+
+		aa := fm * (b - fm) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		aa = -(a + fm) * (qab + fm) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+
+		if math.Abs(del-1) < epsilon {
+			break
+		}
+	}
+
+	return h
+}
@@ -0,0 +1,65 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestLinearRegression_ExactLine(t *testing.T) {
+	xs := []float64{1, 2, 3, 4, 5}
+	ys := []float64{10, 8, 6, 4, 2}
+
+	r, err := LinearRegression(xs, ys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Slope != -2 || r.Intercept != 12 {
+		t.Errorf("expected y = 12 - 2x, got y = %v + %vx", r.Intercept, r.Slope)
+	}
+	if r.RSquared != 1 || r.PValue != 0 {
+		t.Errorf("expected perfect fit, got r2=%v p=%v", r.RSquared, r.PValue)
+	}
+}
+
+func TestLinearRegression_NoisyDecline(t *testing.T) {
+	xs := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	ys := []float64{9.8, 9.9, 9.4, 9.5, 9.1, 9.3, 8.8, 8.9, 8.5, 8.6}
+
+	r, err := LinearRegression(xs, ys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Slope >= 0 {
+		t.Errorf("expected negative slope, got %v", r.Slope)
+	}
+	if r.PValue >= 0.001 {
+		t.Errorf("expected a significant decline, got p=%v", r.PValue)
+	}
+}
+
+func TestLinearRegression_NotEnoughPoints(t *testing.T) {
+	if _, err := LinearRegression([]float64{1, 2}, []float64{1, 2}); !errors.Is(err, ErrNotEnoughPoints) {
+		t.Errorf("expected ErrNotEnoughPoints, got %v", err)
+	}
+	if _, err := LinearRegression([]float64{3, 3, 3}, []float64{1, 2, 3}); !errors.Is(err, ErrNotEnoughPoints) {
+		t.Errorf("expected ErrNotEnoughPoints for constant x, got %v", err)
+	}
+}
+
+func TestStudentTTwoSided(t *testing.T) {
+	// Reference values from standard t tables.
+	cases := []struct {
+		t, df, want float64
+	}{
+		{0, 10, 1},
+		{2.228, 10, 0.05},
+		{2.086, 20, 0.05},
+		{1.96, 1e6, 0.05},
+	}
+	for _, c := range cases {
+		if got := StudentTTwoSided(c.t, c.df); math.Abs(got-c.want) > 1e-3 {
+			t.Errorf("StudentTTwoSided(%v, %v) = %v, want %v", c.t, c.df, got, c.want)
+		}
+	}
+}
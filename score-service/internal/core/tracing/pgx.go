@@ -0,0 +1,79 @@
+package tracing
+
+import (
+	"archy/scores/internal/core/metrics"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// QueryTracer is a pgx tracer that wraps every query in a span named after
+// its sqlc query and times it in metrics.DBQueryDuration. Set it as the
+// Tracer of the pool's connection config.
+type QueryTracer struct{}
+
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{}
+}
+
+type queryKey struct{}
+
+type queryStart struct {
+	name    string
+	started time.Time
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	name := QueryName(data.SQL)
+	ctx, _ = otel.Tracer(InstrumentationName).Start(ctx, "db "+name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.operation.name", name),
+			attribute.String("db.query.text", data.SQL),
+		),
+	)
+	return context.WithValue(ctx, queryKey{}, queryStart{name: name, started: time.Now()})
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	// pgx.ErrNoRows is an answer, not a failure.
+	failed := data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows)
+
+	if q, ok := ctx.Value(queryKey{}).(queryStart); ok {
+		result := "success"
+		if failed {
+			result = "error"
+		}
+		metrics.DBQueryDuration.WithLabelValues(q.name, result).Observe(time.Since(q.started).Seconds())
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if failed {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+	span.SetAttributes(attribute.Int64("db.response.rows", data.CommandTag.RowsAffected()))
+	span.End()
+}
+
+// QueryName returns the sqlc name of a query, read from its leading
+// "-- name: GetShot :one" comment, or "query" for SQL not generated by sqlc.
+func QueryName(sql string) string {
+	const prefix = "-- name: "
+	if !strings.HasPrefix(sql, prefix) {
+		return "query"
+	}
+	name, _, _ := strings.Cut(sql[len(prefix):], " ")
+	if name == "" {
+		return "query"
+	}
+	return name
+}
@@ -0,0 +1,17 @@
+package tracing
+
+import "testing"
+
+func TestQueryName(t *testing.T) {
+	tests := map[string]string{
+		"-- name: GetShot :one\nSELECT id FROM shots WHERE id = $1":          "GetShot",
+		"-- name: DeleteShot :execrows\nUPDATE shots SET deleted_at = NOW()": "DeleteShot",
+		"SELECT 1":  "query",
+		"-- name: ": "query",
+	}
+	for sql, want := range tests {
+		if got := QueryName(sql); got != want {
+			t.Errorf("QueryName(%q) = %q, want %q", sql, got, want)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Package tracing creates OpenTelemetry spans for the service and database
+// layers. Spans go to the global tracer provider, which is a no-op until the
+// server installs an exporting one, so tracing costs next to nothing when it
+// is disabled.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName identifies the score-service's own spans.
+const InstrumentationName = "archy/scores"
+
+// Start starts a span named after the service method, e.g.
+// "ShotService.CreateShot", as a child of the span in ctx.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(InstrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
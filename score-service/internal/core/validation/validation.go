@@ -0,0 +1,148 @@
+// Package validation checks request models against their validate struct
+// tags and reports every violation as a field error, named as in the JSON
+// body. Besides the validator's built-in rules it knows the enums in models
+// (round_type, bow_class, age_category, gender, signer_role) and notblank,
+// which rejects strings of only whitespace.
+package validation
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var validate = newValidator()
+
+// enums maps the custom enum tags to their allowed values.
+var enums = map[string][]string{
+	"round_type":   models.RoundTypes,
+	"bow_class":    models.BowClasses,
+	"age_category": models.AgeCategories,
+	"gender":       models.Genders,
+	"signer_role":  models.SignerRoles,
+}
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(jsonName)
+
+	for tag, values := range enums {
+		v.RegisterValidation(tag, func(fl validator.FieldLevel) bool {
+			return slices.Contains(values, fl.Field().String())
+		})
+	}
+	v.RegisterValidation("notblank", func(fl validator.FieldLevel) bool {
+		return strings.TrimSpace(fl.Field().String()) != ""
+	})
+	return v
+}
+
+// jsonName names a field as it appears in the JSON body.
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return snakeCase(f.Name)
+	}
+	return name
+}
+
+// Struct validates a request model. Violations are returned as an
+// apperr validation error with one FieldError per invalid field.
+func Struct(v any) error {
+	err := validate.Struct(v)
+	if err == nil {
+		return nil
+	}
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return err
+	}
+
+	fields := make([]apperr.FieldError, len(verrs))
+	for i, fe := range verrs {
+		fields[i] = apperr.FieldError{Field: fieldPath(fe), Message: message(fe)}
+	}
+
+	msg := fields[0].Field + " " + fields[0].Message
+	if len(fields) > 1 {
+		msg = fmt.Sprintf("%s (and %d more)", msg, len(fields)-1)
+	}
+	return apperr.Validation(msg, fields...)
+}
+
+// fieldPath drops the struct name from the error's namespace, leaving e.g.
+// shots[2].x.
+func fieldPath(fe validator.FieldError) string {
+	_, path, _ := strings.Cut(fe.Namespace(), ".")
+	return path
+}
+
+func message(fe validator.FieldError) string {
+	kind := fe.Kind()
+	if kind == reflect.Pointer {
+		kind = fe.Type().Elem().Kind()
+	}
+	param := fe.Param()
+
+	if values, ok := enums[fe.Tag()]; ok {
+		return "must be one of " + strings.Join(values, ", ")
+	}
+
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "notblank":
+		return "must not be blank"
+	case "required_if":
+		field, value, _ := strings.Cut(param, " ")
+		return fmt.Sprintf("is required when %s is %s", snakeCase(field), value)
+	case "required_without_all":
+		names := strings.Fields(param)
+		for i, name := range names {
+			names[i] = snakeCase(name)
+		}
+		return fmt.Sprintf("is required unless %s is given", strings.Join(names, " or "))
+	case "ltefield":
+		return "must not exceed " + snakeCase(param)
+	case "min", "max":
+		bound := "at least"
+		if fe.Tag() == "max" {
+			bound = "at most"
+		}
+		switch kind {
+		case reflect.String:
+			return fmt.Sprintf("must be %s %s characters", bound, param)
+		case reflect.Slice, reflect.Map, reflect.Array:
+			return fmt.Sprintf("must have %s %s items", bound, param)
+		}
+		return fmt.Sprintf("must be %s %s", bound, param)
+	}
+	return "is invalid"
+}
+
+// snakeCase turns a Go field name into its JSON name, e.g. TotalDiameter
+// into total_diameter.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
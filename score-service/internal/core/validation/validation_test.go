@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"archy/scores/internal/core/apperr"
+	"archy/scores/internal/core/models"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func validRound() models.CreateQualificationRoundRequest {
+	return models.CreateQualificationRoundRequest{
+		RoundType:    models.RoundTypeQualification,
+		Name:         "WA 720",
+		Distance:     70,
+		TotalSets:    12,
+		ShotsPerSet:  6,
+		TargetFaceID: uuid.New(),
+	}
+}
+
+func TestStruct_Valid(t *testing.T) {
+	requests := []any{
+		validRound(),
+		models.CreateSetRequest{SetNumber: 1},
+		models.CreateSetRequest{SetNumber: 1, MaxShots: ptr(3)},
+		models.CreateShotRequest{X: ptr(-12.5), Y: ptr(700.0)},
+		models.CreateShotRequest{X: ptr(0.0), Y: ptr(0.0)},
+		models.CreateShotsBatchRequest{Shots: []models.CreateShotRequest{{X: ptr(1.0), Y: ptr(1.0)}}},
+		models.UpdateShotRequest{Notes: ptr("")},
+		models.TargetFaceRequest{Name: "WA 40cm", Standard: "WA", TotalDiameter: 400, ScoringDiameter: 400,
+			ZonesConfig: json.RawMessage(`[]`)},
+		models.AddAthleteRequest{AthleteUserID: "user-2"},
+		models.InviteCoachRequest{},
+		models.RedeemInviteCodeRequest{InviteCode: "ABCD1234"},
+		models.CreateCommentRequest{Body: "Nice group"},
+		models.CreatePersonalAccessTokenRequest{Name: "laptop", ExpiresInDays: ptr(30)},
+		models.SignScoresRequest{Role: models.SignerArcher},
+		models.SignScoresRequest{Role: models.SignerJudge, Reason: "miscounted end 3"},
+	}
+
+	for _, req := range requests {
+		if err := Struct(req); err != nil {
+			t.Errorf("%T should be valid, got %v", req, err)
+		}
+	}
+}
+
+func TestStruct_FieldErrors(t *testing.T) {
+	round := validRound()
+	round.RoundType = "tournament"
+	round.Name = "   "
+	round.Distance = 0
+	round.ShotsPerSet = 13
+	round.TargetFaceID = uuid.Nil
+	round.BowClass = "crossbow"
+
+	tests := []struct {
+		name string
+		req  any
+		want []apperr.FieldError
+	}{
+		{"round", round, []apperr.FieldError{
+			{Field: "round_type", Message: "must be one of training, qualification, practice, warmup"},
+			{Field: "name", Message: "must not be blank"},
+			{Field: "distance", Message: "must be at least 1"},
+			{Field: "shots_per_set", Message: "must be at most 12"},
+			{Field: "target_face_id", Message: "is required"},
+			{Field: "bow_class", Message: "must be one of recurve, compound, barebow, longbow"},
+		}},
+		{"set", models.CreateSetRequest{MaxShots: ptr(0)}, []apperr.FieldError{
+			{Field: "set_number", Message: "must be at least 1"},
+			{Field: "max_shots", Message: "must be at least 1"},
+		}},
+		{"shot without coordinates", models.CreateShotRequest{Y: ptr(0.0)},
+			[]apperr.FieldError{{Field: "x", Message: "is required"}}},
+		{"batch", models.CreateShotsBatchRequest{Shots: []models.CreateShotRequest{{X: ptr(0.0), Y: ptr(0.0)}, {X: ptr(701.0), Y: ptr(0.0)}, {X: ptr(0.0)}}},
+			[]apperr.FieldError{
+				{Field: "shots[1].x", Message: "must be at most 700"},
+				{Field: "shots[2].y", Message: "is required"},
+			}},
+		{"empty batch", models.CreateShotsBatchRequest{Shots: []models.CreateShotRequest{}},
+			[]apperr.FieldError{{Field: "shots", Message: "must have at least 1 items"}}},
+		{"nothing to update", models.UpdateShotRequest{},
+			[]apperr.FieldError{{Field: "x", Message: "is required unless y or notes is given"}}},
+		{"target face", models.TargetFaceRequest{Name: "big", Standard: "WA", TotalDiameter: 400, ScoringDiameter: 600},
+			[]apperr.FieldError{
+				{Field: "scoring_diameter", Message: "must not exceed total_diameter"},
+				{Field: "zones_config", Message: "is required"},
+			}},
+		{"token", models.CreatePersonalAccessTokenRequest{ExpiresInDays: ptr(0)}, []apperr.FieldError{
+			{Field: "name", Message: "is required"},
+			{Field: "expires_in_days", Message: "must be at least 1"},
+		}},
+		{"judge without reason", models.SignScoresRequest{Role: models.SignerJudge},
+			[]apperr.FieldError{{Field: "reason", Message: "is required when role is judge"}}},
+		{"unknown role", models.SignScoresRequest{Role: "captain"},
+			[]apperr.FieldError{{Field: "role", Message: "must be one of archer, witness, judge"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Struct(tt.req)
+			e, ok := apperr.As(err)
+			if !ok || e.Kind != apperr.KindValidation {
+				t.Fatalf("expected a validation error, got %v", err)
+			}
+			if !reflect.DeepEqual(e.Fields, tt.want) {
+				t.Errorf("unexpected field errors:\n got %+v\nwant %+v", e.Fields, tt.want)
+			}
+		})
+	}
+}
+
+func TestStruct_Message(t *testing.T) {
+	err := Struct(models.CreateSetRequest{})
+	if err == nil || err.Error() != "set_number must be at least 1" {
+		t.Errorf("unexpected message: %v", err)
+	}
+
+	err = Struct(models.CreateCommentRequest{Body: string(make([]rune, 2001))})
+	if err == nil || err.Error() != "body must be at most 2000 characters" {
+		t.Errorf("unexpected message: %v", err)
+	}
+
+	err = Struct(models.CreatePersonalAccessTokenRequest{ExpiresInDays: ptr(3651)})
+	if err == nil || err.Error() != "name is required (and 1 more)" {
+		t.Errorf("unexpected message: %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+// Package dbtest gives tests a disposable Postgres database with the embedded
+// migrations applied.
+//
+// The server is the one at TEST_DATABASE_URL when it is set; its user must be
+// allowed to create databases. Otherwise an embedded Postgres is downloaded
+// on first use, cached in ~/.embedded-postgres-go and started on a free port
+// for the test binary. The migrations are applied once to a template
+// database and every test gets its own copy of it, so tests may run in
+// parallel and leave whatever they like behind.
+//
+// Tests that need a database are skipped with -short, and when the embedded
+// server cannot start, for example without network access on the first run
+// or as root, which initdb refuses. A server named by TEST_DATABASE_URL that
+// cannot be used fails the tests instead, as does a server that runs but
+// cannot be migrated.
+//
+// A package using New stops the embedded server with Main:
+//
+//	func TestMain(m *testing.M) { os.Exit(dbtest.Main(m)) }
+package dbtest
+
+import (
+	"archy/scores/internal/database/migrations"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// EnvURL names the variable holding the URL of a server to use instead of
+// the embedded one.
+const EnvURL = "TEST_DATABASE_URL"
+
+type server struct {
+	url      string // the server's maintenance database
+	template string // the migrated database copied for each test
+	required bool   // the server was configured or has started, so failing to use it is an error
+	err      error
+	stop     func() error
+}
+
+var (
+	once      sync.Once
+	srv       server
+	databases atomic.Int64
+)
+
+// New returns a pool connected to a new migrated database that is dropped
+// when the test ends.
+func New(t testing.TB) *pgxpool.Pool {
+	t.Helper()
+
+	if testing.Short() {
+		t.Skip("dbtest: skipping database test in short mode")
+	}
+	once.Do(start)
+	if srv.err != nil {
+		if srv.required {
+			t.Fatalf("dbtest: %v", srv.err)
+		}
+		t.Skipf("dbtest: no Postgres to test against, set %s to use a running server: %v", EnvURL, srv.err)
+	}
+
+	ctx := context.Background()
+	name := fmt.Sprintf("%s_%d", srv.template, databases.Add(1))
+	if err := exec(ctx, srv.url, fmt.Sprintf("CREATE DATABASE %s TEMPLATE %s", name, srv.template)); err != nil {
+		t.Fatalf("dbtest: failed to create database: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := exec(ctx, srv.url, fmt.Sprintf("DROP DATABASE IF EXISTS %s WITH (FORCE)", name)); err != nil {
+			t.Errorf("dbtest: failed to drop database %s: %v", name, err)
+		}
+	})
+
+	pool, err := pgxpool.New(ctx, withDatabase(srv.url, name))
+	if err != nil {
+		t.Fatalf("dbtest: failed to connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+	return pool
+}
+
+// Main runs the tests and then stops the embedded server and drops the
+// template database, returning the exit code for os.Exit.
+func Main(m *testing.M) int {
+	code := m.Run()
+	if srv.stop != nil {
+		if err := srv.stop(); err != nil {
+			fmt.Fprintf(os.Stderr, "dbtest: %v\n", err)
+			if code == 0 {
+				code = 1
+			}
+		}
+	}
+	return code
+}
+
+func start() {
+	if u := os.Getenv(EnvURL); u != "" {
+		srv.url = u
+	} else if srv.err = startEmbedded(); srv.err != nil {
+		return
+	}
+	// Only a missing server skips the tests; a broken migration must not.
+	srv.required = true
+
+	// Test binaries of several packages run at once, so the template is
+	// named after the process.
+	ctx := context.Background()
+	srv.template = fmt.Sprintf("archy_test_%d", os.Getpid())
+	if srv.err = exec(ctx, srv.url, "CREATE DATABASE "+srv.template); srv.err != nil {
+		srv.err = fmt.Errorf("failed to create the template database: %w", srv.err)
+		return
+	}
+	dropTemplate := func() error {
+		return exec(ctx, srv.url, fmt.Sprintf("DROP DATABASE IF EXISTS %s WITH (FORCE)", srv.template))
+	}
+	stop := srv.stop
+	srv.stop = func() error {
+		err := dropTemplate()
+		if stop != nil {
+			if stopErr := stop(); err == nil {
+				err = stopErr
+			}
+		}
+		return err
+	}
+
+	if _, err := migrations.Up(withDatabase(srv.url, srv.template)); err != nil {
+		srv.err = fmt.Errorf("failed to migrate the template database: %w", err)
+	}
+}
+
+func startEmbedded() error {
+	port, err := freePort()
+	if err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp("", "archy-dbtest-")
+	if err != nil {
+		return err
+	}
+
+	config := embeddedpostgres.DefaultConfig().
+		Version(embeddedpostgres.V15).
+		Port(port).
+		RuntimePath(dir).
+		StartTimeout(time.Minute).
+		Logger(io.Discard)
+	pg := embeddedpostgres.NewDatabase(config)
+	if err := pg.Start(); err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+
+	srv.url = config.GetConnectionURL() + "?sslmode=disable"
+	srv.stop = func() error {
+		defer os.RemoveAll(dir)
+		return pg.Stop()
+	}
+	return nil
+}
+
+func freePort() (uint32, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return uint32(l.Addr().(*net.TCPAddr).Port), nil
+}
+
+// exec runs a statement that cannot run in a transaction, such as CREATE
+// DATABASE, on its own connection.
+func exec(ctx context.Context, databaseURL, sql string) error {
+	conn, err := pgx.Connect(ctx, databaseURL)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+	_, err = conn.Exec(ctx, sql)
+	return err
+}
+
+func withDatabase(databaseURL, name string) string {
+	u, err := url.Parse(databaseURL)
+	if err != nil {
+		// pgx reports the malformed URL when connecting.
+		return databaseURL
+	}
+	u.Path = "/" + name
+	return u.String()
+}
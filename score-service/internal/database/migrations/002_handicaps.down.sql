@@ -0,0 +1,14 @@
+-- =============================================
+-- Archery Tracker - Drop round templates and handicaps
+-- =============================================
+
+DROP TABLE IF EXISTS archer_handicaps;
+
+DROP INDEX IF EXISTS idx_qualification_rounds_user_bow_class;
+ALTER TABLE qualification_rounds
+    DROP COLUMN IF EXISTS handicap,
+    DROP COLUMN IF EXISTS round_template_id,
+    DROP COLUMN IF EXISTS bow_class;
+
+DROP TRIGGER IF EXISTS update_round_templates_updated_at ON round_templates;
+DROP TABLE IF EXISTS round_templates;
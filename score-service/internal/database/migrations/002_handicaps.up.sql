@@ -0,0 +1,70 @@
+-- =============================================
+-- Archery Tracker - Round templates and handicaps
+-- Version: 1.1
+-- Description: Standard round definitions, bow classes and
+--              Archery GB-style handicaps per archer
+-- =============================================
+
+-- =============================================
+-- ROUND TEMPLATES
+-- =============================================
+-- Standard single-distance rounds used to compare and predict scores
+CREATE TABLE round_templates (
+                                 id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                 name VARCHAR(100) NOT NULL UNIQUE,
+                                 distance INTEGER NOT NULL,                -- in meters
+                                 total_sets INTEGER NOT NULL,
+                                 shots_per_set INTEGER NOT NULL,
+                                 target_face_id UUID NOT NULL REFERENCES target_faces(id),
+                                 description TEXT,
+                                 created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                                 updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                                 deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_round_templates_deleted ON round_templates(deleted_at) WHERE deleted_at IS NULL;
+COMMENT ON TABLE round_templates IS 'Standard round definitions (WA 70m, WA 30m, etc.)';
+
+CREATE TRIGGER update_round_templates_updated_at
+    BEFORE UPDATE ON round_templates
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- QUALIFICATION ROUNDS: bow class and handicap
+-- =============================================
+ALTER TABLE qualification_rounds
+    ADD COLUMN bow_class VARCHAR(50) NOT NULL DEFAULT 'recurve',  -- 'recurve', 'compound', 'barebow', 'longbow'
+    ADD COLUMN round_template_id UUID REFERENCES round_templates(id),
+    ADD COLUMN handicap INTEGER;                                   -- set when a complete round is finished
+
+CREATE INDEX idx_qualification_rounds_user_bow_class ON qualification_rounds(external_user_id, bow_class);
+COMMENT ON COLUMN qualification_rounds.handicap IS 'Archery GB-style handicap (0-150) achieved in this round';
+
+-- =============================================
+-- ARCHER HANDICAPS
+-- =============================================
+-- Rolling handicap per archer and bow class
+CREATE TABLE archer_handicaps (
+                                  external_user_id VARCHAR(255) NOT NULL,
+                                  bow_class VARCHAR(50) NOT NULL,
+                                  handicap INTEGER NOT NULL,
+                                  rounds_count INTEGER NOT NULL,    -- number of rounds the handicap is based on
+                                  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                                  PRIMARY KEY (external_user_id, bow_class)
+);
+
+COMMENT ON TABLE archer_handicaps IS 'Rolling handicap per archer and bow class (average of the most recent rated rounds)';
+
+-- =============================================
+-- DEFAULT DATA
+-- =============================================
+INSERT INTO round_templates (name, distance, total_sets, shots_per_set, target_face_id, description)
+SELECT t.name, t.distance, t.total_sets, t.shots_per_set, tf.id, t.description
+FROM (VALUES
+          ('WA 70m', 70, 12, 6, 'WA 122cm 10-zone', 'World Archery 72 arrows at 70m (recurve ranking round)'),
+          ('WA 60m', 60, 12, 6, 'WA 122cm 10-zone', 'World Archery 72 arrows at 60m'),
+          ('WA 50m', 50, 12, 6, 'WA 122cm 10-zone', 'World Archery 72 arrows at 50m'),
+          ('WA 30m', 30, 6, 6, 'WA 80cm 10-zone', 'World Archery 36 arrows at 30m (1440 round short distance)'),
+          ('NFAA 300', 18, 12, 5, '3-Spot Vertical', 'NFAA 60 arrows at 20 yards')
+     ) AS t(name, distance, total_sets, shots_per_set, face_name, description)
+         JOIN target_faces tf ON tf.name = t.face_name;
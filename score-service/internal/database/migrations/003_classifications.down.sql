@@ -0,0 +1,12 @@
+-- =============================================
+-- Archery Tracker - Drop classifications
+-- =============================================
+
+DROP TABLE IF EXISTS archer_classifications;
+
+DROP TRIGGER IF EXISTS update_classification_rules_updated_at ON classification_rules;
+DROP TABLE IF EXISTS classification_rules;
+
+ALTER TABLE qualification_rounds
+    DROP COLUMN IF EXISTS gender,
+    DROP COLUMN IF EXISTS age_category;
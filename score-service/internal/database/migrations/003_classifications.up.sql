@@ -0,0 +1,87 @@
+-- =============================================
+-- Archery Tracker - Classifications
+-- Version: 1.2
+-- Description: Classification thresholds per round template and
+--              classifications earned by archers
+-- =============================================
+
+-- =============================================
+-- QUALIFICATION ROUNDS: archer category
+-- =============================================
+ALTER TABLE qualification_rounds
+    ADD COLUMN age_category VARCHAR(20) NOT NULL DEFAULT 'adult',  -- 'adult', '50+', 'u21', 'u18', 'u16', 'u15', 'u14', 'u12'
+    ADD COLUMN gender VARCHAR(20);                                 -- 'male', 'female'; rounds without gender are not classified
+
+-- =============================================
+-- CLASSIFICATION RULES
+-- =============================================
+-- Minimum score for a classification on a round template
+CREATE TABLE classification_rules (
+                                      id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                      round_template_id UUID NOT NULL REFERENCES round_templates(id) ON DELETE CASCADE,
+                                      age_category VARCHAR(20) NOT NULL,
+                                      gender VARCHAR(20) NOT NULL,
+                                      bow_class VARCHAR(50) NOT NULL,
+                                      classification VARCHAR(100) NOT NULL,   -- 'Bowman 1st Class', 'Master Bowman', ...
+                                      rank INTEGER NOT NULL,                  -- 1 = highest classification
+                                      min_score INTEGER NOT NULL,
+                                      created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                                      updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                                      CONSTRAINT uq_classification_rules UNIQUE (round_template_id, age_category, gender, bow_class, classification)
+);
+
+CREATE INDEX idx_classification_rules_lookup ON classification_rules(round_template_id, age_category, gender, bow_class);
+COMMENT ON TABLE classification_rules IS 'Score thresholds for classifications per round template, age category, gender and bow class';
+
+CREATE TRIGGER update_classification_rules_updated_at
+    BEFORE UPDATE ON classification_rules
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- =============================================
+-- ARCHER CLASSIFICATIONS
+-- =============================================
+-- Highest classification earned in a completed round
+CREATE TABLE archer_classifications (
+                                        id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                        external_user_id VARCHAR(255) NOT NULL,
+                                        round_id UUID NOT NULL UNIQUE REFERENCES qualification_rounds(id) ON DELETE CASCADE,
+                                        rule_id UUID NOT NULL REFERENCES classification_rules(id),
+                                        classification VARCHAR(100) NOT NULL,
+                                        rank INTEGER NOT NULL,
+                                        bow_class VARCHAR(50) NOT NULL,
+                                        age_category VARCHAR(20) NOT NULL,
+                                        score INTEGER NOT NULL,
+                                        achieved_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_archer_classifications_user ON archer_classifications(external_user_id, bow_class);
+COMMENT ON TABLE archer_classifications IS 'Classifications earned by archers, one per completed round';
+
+-- =============================================
+-- DEFAULT DATA
+-- =============================================
+-- Adult recurve thresholds for WA 70m, derived from the Archery GB handicap
+-- table (men: handicap 15 to 71 in steps of 7, women: 22 to 78).
+INSERT INTO classification_rules (round_template_id, age_category, gender, bow_class, classification, rank, min_score)
+SELECT rt.id, 'adult', c.gender, 'recurve', c.classification, c.rank, c.min_score
+FROM (VALUES
+          ('male', 'Elite Master Bowman', 1, 662),
+          ('male', 'Grand Master Bowman', 2, 635),
+          ('male', 'Master Bowman', 3, 602),
+          ('male', 'Bowman 1st Class', 4, 559),
+          ('male', 'Bowman 2nd Class', 5, 504),
+          ('male', 'Bowman 3rd Class', 6, 436),
+          ('male', 'Archer 1st Class', 7, 355),
+          ('male', 'Archer 2nd Class', 8, 271),
+          ('male', 'Archer 3rd Class', 9, 194),
+          ('female', 'Elite Master Bowman', 1, 635),
+          ('female', 'Grand Master Bowman', 2, 602),
+          ('female', 'Master Bowman', 3, 559),
+          ('female', 'Bowman 1st Class', 4, 504),
+          ('female', 'Bowman 2nd Class', 5, 436),
+          ('female', 'Bowman 3rd Class', 6, 355),
+          ('female', 'Archer 1st Class', 7, 271),
+          ('female', 'Archer 2nd Class', 8, 194),
+          ('female', 'Archer 3rd Class', 9, 132)
+     ) AS c(gender, classification, rank, min_score)
+         JOIN round_templates rt ON rt.name = 'WA 70m';
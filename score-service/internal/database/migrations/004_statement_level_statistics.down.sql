@@ -0,0 +1,73 @@
+-- =============================================
+-- Archery Tracker - Restore per-row statistics trigger
+-- =============================================
+
+DROP TRIGGER IF EXISTS update_stats_after_shots_insert ON shots;
+DROP TRIGGER IF EXISTS update_stats_after_shots_update ON shots;
+DROP TRIGGER IF EXISTS update_stats_after_shots_delete ON shots;
+DROP FUNCTION IF EXISTS update_statistics_after_shots_change();
+DROP FUNCTION IF EXISTS refresh_set_statistics(UUID[]);
+
+CREATE OR REPLACE FUNCTION update_set_statistics()
+RETURNS TRIGGER AS $$
+BEGIN
+    -- Update statistics for the affected set
+UPDATE sets s
+SET
+    shots_count = ss.shots_count,
+    total_score = ss.total_score,
+    average_score = ss.average_score,
+    ten_count = ss.ten_count,
+    x_count = ss.x_count,
+    miss_count = ss.miss_count,
+    grouping_diameter = g.grouping_diameter,
+    grouping_center_x = g.grouping_center_x,
+    grouping_center_y = g.grouping_center_y,
+    updated_at = NOW()
+    FROM (
+        SELECT
+            set_id,
+            COUNT(*) as shots_count,
+            SUM(score) as total_score,
+            AVG(score) as average_score,
+            COUNT(CASE WHEN is_ten THEN 1 END) as ten_count,
+            COUNT(CASE WHEN is_x THEN 1 END) as x_count,
+            COUNT(CASE WHEN is_miss THEN 1 END) as miss_count
+        FROM shots
+        WHERE set_id = COALESCE(NEW.set_id, OLD.set_id)
+          AND deleted_at IS NULL
+        GROUP BY set_id
+    ) ss
+    LEFT JOIN LATERAL calculate_set_grouping(COALESCE(NEW.set_id, OLD.set_id)) g ON true
+WHERE s.id = COALESCE(NEW.set_id, OLD.set_id);
+
+-- Update parent round statistics
+UPDATE qualification_rounds qr
+SET
+    total_score = rs.total_score,
+    average_score = rs.average_score,
+    completed_sets = rs.completed_sets,
+    updated_at = NOW()
+    FROM (
+        SELECT
+            parent_round_id,
+            SUM(total_score) as total_score,
+            AVG(average_score) as average_score,
+            COUNT(*) as completed_sets
+        FROM sets
+        WHERE parent_round_id = (
+            SELECT parent_round_id
+            FROM sets
+            WHERE id = COALESCE(NEW.set_id, OLD.set_id)
+        ) AND deleted_at IS NULL
+        GROUP BY parent_round_id
+    ) rs
+WHERE qr.id = rs.parent_round_id;
+
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER update_stats_after_shot_change
+    AFTER INSERT OR UPDATE OR DELETE ON shots
+    FOR EACH ROW EXECUTE FUNCTION update_set_statistics();
@@ -0,0 +1,125 @@
+-- =============================================
+-- Archery Tracker - Statement-level statistics
+-- Version: 1.3
+-- Description: Replace the per-row shot statistics trigger with
+--              statement-level triggers over transition tables, so a
+--              batch insert refreshes each affected set and round once
+-- =============================================
+
+DROP TRIGGER IF EXISTS update_stats_after_shot_change ON shots;
+DROP FUNCTION IF EXISTS update_set_statistics();
+
+-- =============================================
+-- FUNCTIONS
+-- =============================================
+
+-- Recalculate statistics for the given sets and their parent rounds.
+-- Sets without remaining shots are reset to zero.
+CREATE OR REPLACE FUNCTION refresh_set_statistics(p_set_ids UUID[])
+RETURNS VOID AS $$
+BEGIN
+    IF p_set_ids IS NULL OR cardinality(p_set_ids) = 0 THEN
+        RETURN;
+    END IF;
+
+UPDATE sets s
+SET
+    shots_count = ss.shots_count,
+    total_score = ss.total_score,
+    average_score = ss.average_score,
+    ten_count = ss.ten_count,
+    x_count = ss.x_count,
+    miss_count = ss.miss_count,
+    grouping_diameter = ss.grouping_diameter,
+    grouping_center_x = ss.grouping_center_x,
+    grouping_center_y = ss.grouping_center_y
+    FROM (
+        SELECT
+            st.id as set_id,
+            COUNT(sh.id) as shots_count,
+            COALESCE(SUM(sh.score), 0) as total_score,
+            COALESCE(AVG(sh.score), 0) as average_score,
+            COUNT(sh.id) FILTER (WHERE sh.is_ten) as ten_count,
+            COUNT(sh.id) FILTER (WHERE sh.is_x) as x_count,
+            COUNT(sh.id) FILTER (WHERE sh.is_miss) as miss_count,
+            CASE
+                WHEN COUNT(sh.id) >= 2 THEN SQRT(POWER(STDDEV_SAMP(sh.x), 2) + POWER(STDDEV_SAMP(sh.y), 2)) * 2
+                ELSE NULL
+            END as grouping_diameter,
+            AVG(sh.x) as grouping_center_x,
+            AVG(sh.y) as grouping_center_y
+        FROM sets st
+        LEFT JOIN shots sh ON sh.set_id = st.id AND sh.deleted_at IS NULL
+        WHERE st.id = ANY(p_set_ids)
+        GROUP BY st.id
+    ) ss
+WHERE s.id = ss.set_id;
+
+-- Update parent round statistics once per affected round
+UPDATE qualification_rounds qr
+SET
+    total_score = rs.total_score,
+    average_score = rs.average_score,
+    completed_sets = rs.completed_sets
+    FROM (
+        SELECT
+            parent_round_id,
+            SUM(total_score) as total_score,
+            AVG(average_score) as average_score,
+            COUNT(*) as completed_sets
+        FROM sets
+        WHERE parent_round_id IN (
+            SELECT DISTINCT parent_round_id
+            FROM sets
+            WHERE id = ANY(p_set_ids) AND parent_round_id IS NOT NULL
+        ) AND deleted_at IS NULL
+        GROUP BY parent_round_id
+    ) rs
+WHERE qr.id = rs.parent_round_id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Statement-level trigger: collect the sets touched by the statement from
+-- the transition tables and refresh each of them once.
+CREATE OR REPLACE FUNCTION update_statistics_after_shots_change()
+RETURNS TRIGGER AS $$
+DECLARE
+v_set_ids UUID[];
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+SELECT array_agg(DISTINCT set_id) INTO v_set_ids FROM new_shots;
+ELSIF TG_OP = 'UPDATE' THEN
+SELECT array_agg(DISTINCT set_id) INTO v_set_ids
+FROM (
+         SELECT set_id FROM old_shots
+         UNION
+         SELECT set_id FROM new_shots
+     ) changed;
+ELSE
+SELECT array_agg(DISTINCT set_id) INTO v_set_ids FROM old_shots;
+END IF;
+
+    PERFORM refresh_set_statistics(v_set_ids);
+
+RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- =============================================
+-- TRIGGERS
+-- =============================================
+-- Transition tables require one trigger per event
+CREATE TRIGGER update_stats_after_shots_insert
+    AFTER INSERT ON shots
+    REFERENCING NEW TABLE AS new_shots
+    FOR EACH STATEMENT EXECUTE FUNCTION update_statistics_after_shots_change();
+
+CREATE TRIGGER update_stats_after_shots_update
+    AFTER UPDATE ON shots
+    REFERENCING OLD TABLE AS old_shots NEW TABLE AS new_shots
+    FOR EACH STATEMENT EXECUTE FUNCTION update_statistics_after_shots_change();
+
+CREATE TRIGGER update_stats_after_shots_delete
+    AFTER DELETE ON shots
+    REFERENCING OLD TABLE AS old_shots
+    FOR EACH STATEMENT EXECUTE FUNCTION update_statistics_after_shots_change();
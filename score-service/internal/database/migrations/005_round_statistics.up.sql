@@ -0,0 +1,93 @@
+-- =============================================
+-- Archery Tracker - Round statistics semantics
+-- Version: 1.4
+-- Description: Round statistics are per-arrow totals instead of
+--              averages of set averages. completed_sets only counts
+--              full ends. Existing data is recalculated with
+--              `archy-migrate backfill`.
+-- =============================================
+
+ALTER TABLE qualification_rounds
+    ADD COLUMN shots_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN ten_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN x_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN miss_count INTEGER NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN qualification_rounds.average_score IS 'Average score per arrow over all shots of the round';
+COMMENT ON COLUMN qualification_rounds.completed_sets IS 'Number of ends with shots_count = max_shots';
+
+-- Recalculate statistics for the given sets and their parent rounds.
+-- Round totals are summed from the per-set shot counts, which are computed
+-- from shots just above, so the round average is a true per-arrow average
+-- while the cost stays proportional to the number of sets in the round.
+CREATE OR REPLACE FUNCTION refresh_set_statistics(p_set_ids UUID[])
+RETURNS VOID AS $$
+BEGIN
+    IF p_set_ids IS NULL OR cardinality(p_set_ids) = 0 THEN
+        RETURN;
+    END IF;
+
+UPDATE sets s
+SET
+    shots_count = ss.shots_count,
+    total_score = ss.total_score,
+    average_score = ss.average_score,
+    ten_count = ss.ten_count,
+    x_count = ss.x_count,
+    miss_count = ss.miss_count,
+    grouping_diameter = ss.grouping_diameter,
+    grouping_center_x = ss.grouping_center_x,
+    grouping_center_y = ss.grouping_center_y
+    FROM (
+        SELECT
+            st.id as set_id,
+            COUNT(sh.id) as shots_count,
+            COALESCE(SUM(sh.score), 0) as total_score,
+            COALESCE(AVG(sh.score), 0) as average_score,
+            COUNT(sh.id) FILTER (WHERE sh.is_ten) as ten_count,
+            COUNT(sh.id) FILTER (WHERE sh.is_x) as x_count,
+            COUNT(sh.id) FILTER (WHERE sh.is_miss) as miss_count,
+            CASE
+                WHEN COUNT(sh.id) >= 2 THEN SQRT(POWER(STDDEV_SAMP(sh.x), 2) + POWER(STDDEV_SAMP(sh.y), 2)) * 2
+                ELSE NULL
+            END as grouping_diameter,
+            AVG(sh.x) as grouping_center_x,
+            AVG(sh.y) as grouping_center_y
+        FROM sets st
+        LEFT JOIN shots sh ON sh.set_id = st.id AND sh.deleted_at IS NULL
+        WHERE st.id = ANY(p_set_ids)
+        GROUP BY st.id
+    ) ss
+WHERE s.id = ss.set_id;
+
+-- Update parent round statistics once per affected round
+UPDATE qualification_rounds qr
+SET
+    shots_count = rs.shots_count,
+    total_score = rs.total_score,
+    average_score = CASE WHEN rs.shots_count > 0 THEN rs.total_score::DECIMAL / rs.shots_count ELSE 0 END,
+    completed_sets = rs.completed_sets,
+    ten_count = rs.ten_count,
+    x_count = rs.x_count,
+    miss_count = rs.miss_count
+    FROM (
+        SELECT
+            r.id as round_id,
+            COALESCE(SUM(st.shots_count), 0) as shots_count,
+            COALESCE(SUM(st.total_score), 0) as total_score,
+            COUNT(st.id) FILTER (WHERE st.shots_count >= st.max_shots) as completed_sets,
+            COALESCE(SUM(st.ten_count), 0) as ten_count,
+            COALESCE(SUM(st.x_count), 0) as x_count,
+            COALESCE(SUM(st.miss_count), 0) as miss_count
+        FROM qualification_rounds r
+        LEFT JOIN sets st ON st.parent_round_id = r.id AND st.deleted_at IS NULL
+        WHERE r.id IN (
+            SELECT DISTINCT parent_round_id
+            FROM sets
+            WHERE id = ANY(p_set_ids) AND parent_round_id IS NOT NULL
+        )
+        GROUP BY r.id
+    ) rs
+WHERE qr.id = rs.round_id;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,6 @@
+-- =============================================
+-- Archery Tracker - Drop authorization
+-- =============================================
+
+DROP TABLE IF EXISTS coach_athletes;
+DROP TABLE IF EXISTS user_roles;
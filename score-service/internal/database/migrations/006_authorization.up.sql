@@ -0,0 +1,33 @@
+-- =============================================
+-- Archery Tracker - Authorization
+-- Version: 1.5
+-- Description: Locally granted roles and coach-athlete relationships
+-- =============================================
+
+-- =============================================
+-- USER ROLES
+-- =============================================
+-- Roles granted in this service, in addition to those carried by the token
+CREATE TABLE user_roles (
+                            external_user_id VARCHAR(255) NOT NULL,   -- ID from auth service
+                            role VARCHAR(50) NOT NULL,                -- 'coach', 'admin'
+                            created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                            PRIMARY KEY (external_user_id, role)
+);
+
+COMMENT ON TABLE user_roles IS 'Roles granted locally, merged with roles from JWT claims';
+
+-- =============================================
+-- COACH ATHLETES
+-- =============================================
+-- A coach can read the rounds, sets and shots of their athletes
+CREATE TABLE coach_athletes (
+                                coach_user_id VARCHAR(255) NOT NULL,
+                                athlete_user_id VARCHAR(255) NOT NULL,
+                                created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                                PRIMARY KEY (coach_user_id, athlete_user_id),
+                                CONSTRAINT chk_coach_athletes_distinct CHECK (coach_user_id <> athlete_user_id)
+);
+
+CREATE INDEX idx_coach_athletes_athlete ON coach_athletes(athlete_user_id);
+COMMENT ON TABLE coach_athletes IS 'Coach to athlete relationships granting read access';
@@ -0,0 +1,9 @@
+-- =============================================
+-- Archery Tracker - Drop coach invitations and comments
+-- =============================================
+
+DROP TRIGGER IF EXISTS update_comments_updated_at ON comments;
+DROP TABLE IF EXISTS comments;
+
+DROP TRIGGER IF EXISTS update_coach_invitations_updated_at ON coach_invitations;
+DROP TABLE IF EXISTS coach_invitations;
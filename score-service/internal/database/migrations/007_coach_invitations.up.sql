@@ -0,0 +1,62 @@
+-- =============================================
+-- Archery Tracker - Coach invitations and comments
+-- Version: 1.6
+-- Description: Athletes invite coaches, accepted coaches can read and
+--              comment on the athlete's rounds, sets and shots
+-- =============================================
+
+-- =============================================
+-- COACH INVITATIONS
+-- =============================================
+-- An athlete invites a coach directly by user id, or creates an invite
+-- code that any coach can redeem
+CREATE TABLE coach_invitations (
+                                   id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                   athlete_user_id VARCHAR(255) NOT NULL,
+                                   coach_user_id VARCHAR(255),               -- NULL until an invite code is redeemed
+                                   invite_code VARCHAR(32) UNIQUE,
+                                   status VARCHAR(20) NOT NULL DEFAULT 'pending',  -- 'pending', 'accepted', 'declined', 'revoked'
+                                   expires_at TIMESTAMPTZ NOT NULL DEFAULT NOW() + INTERVAL '14 days',
+                                   responded_at TIMESTAMPTZ,
+                                   created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                                   updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                                   CONSTRAINT chk_coach_invitations_target CHECK (coach_user_id IS NOT NULL OR invite_code IS NOT NULL),
+                                   CONSTRAINT chk_coach_invitations_distinct CHECK (coach_user_id IS DISTINCT FROM athlete_user_id),
+                                   CONSTRAINT chk_coach_invitations_status CHECK (status IN ('pending', 'accepted', 'declined', 'revoked'))
+);
+
+CREATE INDEX idx_coach_invitations_athlete ON coach_invitations(athlete_user_id, created_at DESC);
+CREATE INDEX idx_coach_invitations_coach ON coach_invitations(coach_user_id, created_at DESC);
+
+CREATE TRIGGER update_coach_invitations_updated_at
+    BEFORE UPDATE ON coach_invitations
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE coach_invitations IS 'Invitations from athletes to coaches';
+
+-- =============================================
+-- COMMENTS
+-- =============================================
+-- Comments on a round, one of its sets or one of its shots
+CREATE TABLE comments (
+                          id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                          author_user_id VARCHAR(255) NOT NULL,
+                          round_id UUID NOT NULL REFERENCES qualification_rounds(id) ON DELETE CASCADE,
+                          set_id UUID REFERENCES sets(id) ON DELETE CASCADE,
+                          shot_id UUID REFERENCES shots(id) ON DELETE CASCADE,
+                          body TEXT NOT NULL,
+                          created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                          updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                          deleted_at TIMESTAMPTZ,
+                          CONSTRAINT chk_comments_shot_in_set CHECK (shot_id IS NULL OR set_id IS NOT NULL)
+);
+
+CREATE INDEX idx_comments_round ON comments(round_id, created_at) WHERE deleted_at IS NULL;
+CREATE INDEX idx_comments_set ON comments(set_id, created_at) WHERE deleted_at IS NULL;
+CREATE INDEX idx_comments_shot ON comments(shot_id, created_at) WHERE deleted_at IS NULL;
+
+CREATE TRIGGER update_comments_updated_at
+    BEFORE UPDATE ON comments
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE comments IS 'Comments by archers and their coaches on rounds, sets and shots';
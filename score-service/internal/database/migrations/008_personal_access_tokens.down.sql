@@ -0,0 +1,5 @@
+-- =============================================
+-- Archery Tracker - Drop personal access tokens
+-- =============================================
+
+DROP TABLE IF EXISTS personal_access_tokens;
@@ -0,0 +1,21 @@
+-- =============================================
+-- Archery Tracker - Personal access tokens
+-- Version: 1.7
+-- Description: Long-lived, revocable API tokens for devices and scripts
+-- =============================================
+
+CREATE TABLE personal_access_tokens (
+                                        id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                        external_user_id VARCHAR(255) NOT NULL,   -- ID from auth service
+                                        name VARCHAR(100) NOT NULL,
+                                        token_prefix VARCHAR(32) NOT NULL,        -- first characters, to recognise the token
+                                        token_hash BYTEA NOT NULL UNIQUE,         -- SHA-256 of the token
+                                        scopes TEXT[] NOT NULL DEFAULT '{}',
+                                        expires_at TIMESTAMPTZ,                   -- NULL never expires
+                                        last_used_at TIMESTAMPTZ,
+                                        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                                        revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_personal_access_tokens_user ON personal_access_tokens(external_user_id, created_at DESC);
+COMMENT ON TABLE personal_access_tokens IS 'Hashed personal access tokens; the plain token is only shown once';
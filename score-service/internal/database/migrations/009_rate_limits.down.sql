@@ -0,0 +1,5 @@
+-- =============================================
+-- Archery Tracker - Drop rate limits
+-- =============================================
+
+DROP TABLE IF EXISTS rate_limit_buckets;
@@ -0,0 +1,15 @@
+-- =============================================
+-- Archery Tracker - Rate limits
+-- Version: 1.8
+-- Description: Token buckets shared by all instances of the service
+-- =============================================
+
+-- Buckets are cheap to lose, so the table skips the WAL.
+CREATE UNLOGGED TABLE rate_limit_buckets (
+                                             bucket_key VARCHAR(512) PRIMARY KEY,   -- caller and budget
+                                             tokens DOUBLE PRECISION NOT NULL,
+                                             allowed BOOLEAN NOT NULL,             -- whether the last request got a token
+                                             updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_rate_limit_buckets_updated ON rate_limit_buckets(updated_at);
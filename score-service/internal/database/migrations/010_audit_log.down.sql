@@ -0,0 +1,6 @@
+-- =============================================
+-- Archery Tracker - Drop audit log
+-- =============================================
+
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS reject_audit_log_changes();
@@ -0,0 +1,40 @@
+-- =============================================
+-- Archery Tracker - Audit log
+-- Version: 1.9
+-- Description: Append-only record of every change to rounds, sets, shots
+--              and target faces
+-- =============================================
+
+CREATE TABLE audit_log (
+                           id BIGSERIAL PRIMARY KEY,
+                           occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                           actor_user_id VARCHAR(255) NOT NULL,      -- who made the change
+                           token_type VARCHAR(20) NOT NULL,          -- jwt or personal
+                           token_id VARCHAR(255),                    -- JWT jti or personal access token ID
+                           request_id VARCHAR(255),
+                           action VARCHAR(20) NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+                           entity_type VARCHAR(50) NOT NULL,
+                           entity_id UUID NOT NULL,
+                           owner_user_id VARCHAR(255),               -- archer whose data changed; NULL for shared data
+                           before_data JSONB,
+                           after_data JSONB
+);
+
+CREATE INDEX idx_audit_log_entity ON audit_log(entity_type, entity_id, id DESC);
+CREATE INDEX idx_audit_log_owner ON audit_log(owner_user_id, id DESC);
+CREATE INDEX idx_audit_log_actor ON audit_log(actor_user_id, id DESC);
+
+CREATE OR REPLACE FUNCTION reject_audit_log_changes()
+    RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_log_changes();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_log_changes();
@@ -0,0 +1,6 @@
+-- =============================================
+-- Archery Tracker - Drop score signatures
+-- =============================================
+
+ALTER TABLE audit_log DROP COLUMN IF EXISTS reason;
+DROP TABLE IF EXISTS score_signatures;
@@ -0,0 +1,27 @@
+-- =============================================
+-- Archery Tracker - Score signatures
+-- Version: 1.10
+-- Description: Archer, witness and judge sign-off of rounds and ends. Signed
+--              ends are locked against edits except by a judge.
+-- =============================================
+
+CREATE TABLE score_signatures (
+                                  id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                  round_id UUID NOT NULL REFERENCES qualification_rounds(id) ON DELETE CASCADE,
+                                  set_id UUID REFERENCES sets(id) ON DELETE CASCADE,  -- NULL signs the whole round
+                                  signer_user_id VARCHAR(255) NOT NULL,
+                                  signer_role VARCHAR(20) NOT NULL CHECK (signer_role IN ('archer', 'witness', 'judge')),
+                                  reason TEXT,                                        -- required for judge overrides
+                                  scores_hash VARCHAR(64) NOT NULL,                   -- verification hash of the signed scores
+                                  signed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+                                  CONSTRAINT check_judge_reason CHECK (signer_role <> 'judge' OR reason IS NOT NULL)
+);
+
+-- An archer and a witness sign a round or end once; judges may override repeatedly.
+CREATE UNIQUE INDEX idx_score_signatures_once
+    ON score_signatures(round_id, COALESCE(set_id, '00000000-0000-0000-0000-000000000000'::uuid), signer_role)
+    WHERE signer_role IN ('archer', 'witness');
+CREATE INDEX idx_score_signatures_round ON score_signatures(round_id, signed_at);
+
+-- Reasons given for judge edits of signed ends.
+ALTER TABLE audit_log ADD COLUMN reason TEXT;
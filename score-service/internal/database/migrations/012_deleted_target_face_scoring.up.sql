@@ -0,0 +1,40 @@
+-- =============================================
+-- Archery Tracker - Scoring on deleted target faces
+-- Version: 1.11
+-- Description: Shots of rounds on a deleted face keep scoring against
+--              its zones. Faces in use can no longer be deleted, but
+--              faces deleted before that are still referenced.
+-- =============================================
+
+CREATE OR REPLACE FUNCTION calculate_shot_score(
+    p_x DECIMAL,
+    p_y DECIMAL,
+    p_target_face_id UUID
+) RETURNS INTEGER AS $$
+DECLARE
+v_distance DECIMAL;
+    v_zones JSONB;
+    v_zone JSONB;
+    v_score INTEGER := 0;
+BEGIN
+    -- Calculate Euclidean distance from center (in mm)
+    v_distance := SQRT(POWER(p_x, 2) + POWER(p_y, 2));
+
+    -- Get zones configuration, whether or not the face was deleted
+SELECT zones_config INTO v_zones
+FROM target_faces
+WHERE id = p_target_face_id;
+
+-- Find the smallest zone that contains the distance
+FOR v_zone IN SELECT * FROM jsonb_array_elements(v_zones)
+              ORDER BY (value->>'radius')::DECIMAL ASC
+    LOOP
+        IF v_distance <= (v_zone->>'radius')::DECIMAL THEN
+            v_score := (v_zone->>'score')::INTEGER;
+EXIT;
+END IF;
+END LOOP;
+
+RETURN v_score;
+END;
+$$ LANGUAGE plpgsql STABLE;
@@ -0,0 +1,5 @@
+-- =============================================
+-- Archery Tracker - Drop the source of coach access
+-- =============================================
+
+ALTER TABLE coach_athletes DROP COLUMN IF EXISTS granted_by;
@@ -0,0 +1,23 @@
+-- =============================================
+-- Archery Tracker - Source of coach access
+-- Version: 1.12
+-- Description: Coach to athlete links record whether an admin or an
+--              accepted invitation granted them, so that revoking an
+--              invitation keeps access an admin granted. Existing links
+--              with an accepted invitation are taken to come from it.
+-- =============================================
+
+ALTER TABLE coach_athletes
+    ADD COLUMN granted_by VARCHAR(20) NOT NULL DEFAULT 'admin'
+        CHECK (granted_by IN ('admin', 'invitation'));
+
+UPDATE coach_athletes ca
+SET granted_by = 'invitation'
+WHERE EXISTS (
+    SELECT 1 FROM coach_invitations ci
+    WHERE ci.coach_user_id = ca.coach_user_id
+      AND ci.athlete_user_id = ca.athlete_user_id
+      AND ci.status = 'accepted'
+);
+
+COMMENT ON COLUMN coach_athletes.granted_by IS 'admin, or invitation while an accepted invitation grants the access';
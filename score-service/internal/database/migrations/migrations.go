@@ -0,0 +1,104 @@
+// Package migrations embeds the schema migrations in this directory so that
+// the server and archy-migrate apply the same files wherever they run from.
+// Migrations are numbered NNN_name.up.sql with a matching .down.sql and are
+// applied with golang-migrate, which records the version in
+// schema_migrations.
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+//go:embed *.sql
+var FS embed.FS
+
+// Migration is one numbered migration.
+type Migration struct {
+	Version uint
+	Name    string
+}
+
+// List returns the embedded migrations by version. It fails when an up
+// migration has no down migration or the other way round.
+func List() ([]Migration, error) {
+	entries, err := fs.ReadDir(FS, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	directions := make(map[Migration][]source.Direction)
+	for _, entry := range entries {
+		m, err := source.Parse(entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", entry.Name(), err)
+		}
+		key := Migration{Version: m.Version, Name: m.Identifier}
+		directions[key] = append(directions[key], m.Direction)
+	}
+
+	list := make([]Migration, 0, len(directions))
+	for m, dirs := range directions {
+		if len(dirs) != 2 {
+			return nil, fmt.Errorf("migration %03d_%s: expected an up and a down file", m.Version, m.Name)
+		}
+		list = append(list, m)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	for i := 1; i < len(list); i++ {
+		if list[i].Version == list[i-1].Version {
+			return nil, fmt.Errorf("migration %d is defined twice", list[i].Version)
+		}
+	}
+	return list, nil
+}
+
+// DriverURL turns a postgres:// database URL into the pgx5:// URL of
+// golang-migrate's pgx driver.
+func DriverURL(databaseURL string) (string, error) {
+	scheme, rest, ok := strings.Cut(databaseURL, "://")
+	if !ok || (scheme != "postgres" && scheme != "postgresql") {
+		return "", errors.New("database URL must start with postgres:// or postgresql://")
+	}
+	return "pgx5://" + rest, nil
+}
+
+// New returns a migrator for the database at databaseURL using the embedded
+// migrations. Close it when done.
+func New(databaseURL string) (*migrate.Migrate, error) {
+	driverURL, err := DriverURL(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	src, err := iofs.New(FS, ".")
+	if err != nil {
+		return nil, err
+	}
+	return migrate.NewWithSourceInstance("iofs", src, driverURL)
+}
+
+// Up applies every pending migration and returns the resulting version.
+// Instances starting together take turns: golang-migrate holds an advisory
+// lock while migrating.
+func Up(databaseURL string) (uint, error) {
+	m, err := New(databaseURL)
+	if err != nil {
+		return 0, err
+	}
+	defer m.Close()
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return 0, err
+	}
+	version, _, err := m.Version()
+	return version, err
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import "testing"
+
+func TestList(t *testing.T) {
+	list, err := List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) == 0 || list[0] != (Migration{Version: 1, Name: "initial"}) {
+		t.Fatalf("expected to start with 001_initial, got %+v", list)
+	}
+	for i, m := range list {
+		if m.Version != uint(i+1) {
+			t.Errorf("expected migration %d, got %03d_%s; versions must not skip", i+1, m.Version, m.Name)
+		}
+	}
+}
+
+func TestDriverURL(t *testing.T) {
+	for url, want := range map[string]string{
+		"postgres://archy@db:5432/archy":                  "pgx5://archy@db:5432/archy",
+		"postgresql://archy@db/archy?sslmode=disable":     "pgx5://archy@db/archy?sslmode=disable",
+		"mysql://archy@db/archy":                          "",
+		"host=db user=archy dbname=archy sslmode=disable": "",
+	} {
+		got, err := DriverURL(url)
+		if got != want || (err == nil) != (want != "") {
+			t.Errorf("DriverURL(%q) = %q, %v; want %q", url, got, err, want)
+		}
+	}
+}
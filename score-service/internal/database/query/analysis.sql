@@ -0,0 +1,20 @@
+-- name: GetShotPositionsForUser :many
+SELECT
+    qr.id AS round_id,
+    s.id AS set_id,
+    s.set_number,
+    ROW_NUMBER() OVER (PARTITION BY sh.set_id ORDER BY sh.created_at, sh.id)::INTEGER AS arrow_number,
+    sh.score,
+    sh.x,
+    sh.y,
+    sh.distance_from_center
+FROM shots sh
+         JOIN sets s ON s.id = sh.set_id
+         JOIN qualification_rounds qr ON qr.id = s.parent_round_id
+WHERE qr.external_user_id = $1
+  AND qr.deleted_at IS NULL
+  AND s.deleted_at IS NULL
+  AND sh.deleted_at IS NULL
+  AND (sqlc.narg('round_type')::VARCHAR IS NULL OR qr.round_type = sqlc.narg('round_type')::VARCHAR)
+  AND (sqlc.narg('distance')::INTEGER IS NULL OR qr.distance = sqlc.narg('distance')::INTEGER)
+ORDER BY qr.created_at, s.set_number, arrow_number;
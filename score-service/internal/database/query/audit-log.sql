@@ -0,0 +1,55 @@
+-- name: CreateAuditLogEntry :exec
+INSERT INTO audit_log (
+    actor_user_id,
+    token_type,
+    token_id,
+    request_id,
+    action,
+    entity_type,
+    entity_id,
+    owner_user_id,
+    before_data,
+    after_data,
+    reason
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: CreateAuditLogEntries :exec
+-- Records entities of one type created together by one request, such as the
+-- arrows of a batch, with after_data parallel to entity_ids.
+INSERT INTO audit_log (
+    actor_user_id,
+    token_type,
+    token_id,
+    request_id,
+    action,
+    entity_type,
+    entity_id,
+    owner_user_id,
+    after_data,
+    reason
+)
+SELECT
+    sqlc.arg(actor_user_id)::varchar,
+    sqlc.arg(token_type)::varchar,
+    sqlc.narg(token_id)::varchar,
+    sqlc.narg(request_id)::varchar,
+    'create',
+    sqlc.arg(entity_type)::varchar,
+    (sqlc.arg('entity_ids')::UUID[])[ord],
+    sqlc.narg(owner_user_id)::varchar,
+    (sqlc.arg('after_data')::JSONB[])[ord],
+    sqlc.narg(reason)::text
+FROM generate_subscripts(sqlc.arg('entity_ids')::UUID[], 1) AS ord
+ORDER BY ord;
+
+-- name: ListAuditLog :many
+-- Newest first. owner_user_ids limits the entries to the given archers' data
+-- when not NULL; before_id pages through older entries.
+SELECT * FROM audit_log
+WHERE (sqlc.narg(entity_type)::varchar IS NULL OR entity_type = sqlc.narg(entity_type))
+  AND (sqlc.narg(entity_id)::uuid IS NULL OR entity_id = sqlc.narg(entity_id))
+  AND (sqlc.narg(actor_user_id)::varchar IS NULL OR actor_user_id = sqlc.narg(actor_user_id))
+  AND (sqlc.narg(owner_user_ids)::varchar[] IS NULL OR owner_user_id = ANY(sqlc.narg(owner_user_ids)::varchar[]))
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+ORDER BY id DESC
+LIMIT sqlc.arg(row_limit);
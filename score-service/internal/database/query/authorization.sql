@@ -0,0 +1,22 @@
+-- name: ListUserRoles :many
+SELECT role FROM user_roles WHERE external_user_id = $1 ORDER BY role;
+
+-- name: IsCoachOf :one
+SELECT EXISTS (
+    SELECT 1 FROM coach_athletes
+    WHERE coach_user_id = $1 AND athlete_user_id = $2
+);
+
+-- name: ListAthletesForCoach :many
+SELECT * FROM coach_athletes WHERE coach_user_id = $1 ORDER BY created_at;
+
+-- name: AddCoachAthlete :one
+-- Grants access as an admin, which revoking an invitation leaves in place.
+INSERT INTO coach_athletes (coach_user_id, athlete_user_id, granted_by)
+VALUES ($1, $2, 'admin')
+ON CONFLICT (coach_user_id, athlete_user_id) DO UPDATE
+SET granted_by = EXCLUDED.granted_by
+RETURNING *;
+
+-- name: RemoveCoachAthlete :execrows
+DELETE FROM coach_athletes WHERE coach_user_id = $1 AND athlete_user_id = $2;
@@ -0,0 +1,39 @@
+-- name: ListClassificationRules :many
+SELECT * FROM classification_rules
+WHERE (sqlc.narg('round_template_id')::UUID IS NULL OR round_template_id = sqlc.narg('round_template_id')::UUID)
+  AND (sqlc.narg('bow_class')::VARCHAR IS NULL OR bow_class = sqlc.narg('bow_class')::VARCHAR)
+ORDER BY round_template_id, age_category, gender, bow_class, rank;
+
+-- name: GetBestClassificationRule :one
+SELECT * FROM classification_rules
+WHERE round_template_id = $1
+  AND age_category = $2
+  AND gender = $3
+  AND bow_class = $4
+  AND min_score <= sqlc.arg('score')::INTEGER
+ORDER BY rank
+LIMIT 1;
+
+-- name: UpsertArcherClassification :one
+INSERT INTO archer_classifications (
+    external_user_id,
+    round_id,
+    rule_id,
+    classification,
+    rank,
+    bow_class,
+    age_category,
+    score
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (round_id) DO UPDATE
+SET rule_id = EXCLUDED.rule_id,
+    classification = EXCLUDED.classification,
+    rank = EXCLUDED.rank,
+    score = EXCLUDED.score,
+    achieved_at = NOW()
+    RETURNING *;
+
+-- name: GetArcherClassifications :many
+SELECT * FROM archer_classifications
+WHERE external_user_id = $1
+ORDER BY achieved_at DESC;
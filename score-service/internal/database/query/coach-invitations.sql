@@ -0,0 +1,95 @@
+-- name: CreateCoachInvitation :one
+INSERT INTO coach_invitations (
+    athlete_user_id,
+    coach_user_id,
+    invite_code
+) VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetCoachInvitation :one
+SELECT * FROM coach_invitations WHERE id = $1;
+
+-- name: ListCoachInvitationsForAthlete :many
+SELECT * FROM coach_invitations WHERE athlete_user_id = $1 ORDER BY created_at DESC;
+
+-- name: ListCoachInvitationsForCoach :many
+SELECT * FROM coach_invitations WHERE coach_user_id = $1 ORDER BY created_at DESC;
+
+-- name: AcceptCoachInvitation :one
+-- Accepts a pending, unexpired invitation addressed to the coach, or redeems
+-- an invite code, and grants the coach access to the athlete in one statement.
+-- Access an admin already granted stays theirs.
+WITH accepted AS (
+    UPDATE coach_invitations
+    SET status = 'accepted',
+        coach_user_id = sqlc.arg('coach_user_id'),
+        responded_at = NOW()
+    WHERE status = 'pending'
+      AND expires_at > NOW()
+      AND athlete_user_id <> sqlc.arg('coach_user_id')
+      AND (
+          (id = sqlc.narg('id') AND coach_user_id = sqlc.arg('coach_user_id'))
+          OR (invite_code = sqlc.narg('invite_code') AND coach_user_id IS NULL)
+      )
+    RETURNING *
+), granted AS (
+    INSERT INTO coach_athletes (coach_user_id, athlete_user_id, granted_by)
+    SELECT coach_user_id, athlete_user_id, 'invitation' FROM accepted
+    ON CONFLICT (coach_user_id, athlete_user_id) DO NOTHING
+)
+SELECT * FROM accepted;
+
+-- name: DeclineCoachInvitation :one
+UPDATE coach_invitations
+SET status = 'declined',
+    responded_at = NOW()
+WHERE id = $1 AND coach_user_id = $2 AND status = 'pending'
+RETURNING *;
+
+-- name: RevokeCoachInvitation :one
+-- Revokes a pending or accepted invitation and the access it granted, unless
+-- an admin granted the access or another accepted invitation still does.
+-- The self-join exposes the status from before the update.
+WITH revoked AS (
+    UPDATE coach_invitations ci
+    SET status = 'revoked',
+        responded_at = NOW()
+    FROM coach_invitations previous
+    WHERE previous.id = ci.id
+      AND ci.id = $1
+      AND ci.athlete_user_id = $2
+      AND ci.status IN ('pending', 'accepted')
+    RETURNING ci.*, previous.status AS previous_status
+), revoked_access AS (
+    DELETE FROM coach_athletes ca
+    USING revoked r
+    WHERE r.previous_status = 'accepted'
+      AND ca.coach_user_id = r.coach_user_id
+      AND ca.athlete_user_id = r.athlete_user_id
+      AND ca.granted_by = 'invitation'
+      AND NOT EXISTS (
+        SELECT 1 FROM coach_invitations other
+        WHERE other.id <> r.id
+          AND other.coach_user_id = r.coach_user_id
+          AND other.athlete_user_id = r.athlete_user_id
+          AND other.status = 'accepted'
+    )
+)
+SELECT id, athlete_user_id, coach_user_id, invite_code, status, expires_at, responded_at, created_at, updated_at
+FROM revoked;
+
+-- name: RevokeCoach :execrows
+-- Removes a coach's access to the athlete and revokes the invitations that granted it.
+WITH revoked AS (
+    UPDATE coach_invitations ci
+    SET status = 'revoked',
+        responded_at = NOW()
+    WHERE ci.athlete_user_id = sqlc.arg('athlete_user_id')
+      AND ci.coach_user_id = sqlc.arg('coach_user_id')
+      AND ci.status = 'accepted'
+)
+DELETE FROM coach_athletes ca
+WHERE ca.athlete_user_id = sqlc.arg('athlete_user_id') AND ca.coach_user_id = sqlc.arg('coach_user_id');
+
+-- name: ListCoachesForAthlete :many
+SELECT * FROM coach_athletes WHERE athlete_user_id = $1 ORDER BY created_at;
@@ -0,0 +1,32 @@
+-- name: CreateComment :one
+INSERT INTO comments (
+    author_user_id,
+    round_id,
+    set_id,
+    shot_id,
+    body
+) VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetComment :one
+SELECT * FROM comments WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListCommentsForRound :many
+-- Comments on the round itself, not on its sets or shots.
+SELECT * FROM comments
+WHERE round_id = $1 AND set_id IS NULL AND deleted_at IS NULL
+ORDER BY created_at;
+
+-- name: ListCommentsForSet :many
+SELECT * FROM comments
+WHERE set_id = $1 AND shot_id IS NULL AND deleted_at IS NULL
+ORDER BY created_at;
+
+-- name: ListCommentsForShot :many
+SELECT * FROM comments
+WHERE shot_id = $1 AND deleted_at IS NULL
+ORDER BY created_at;
+
+-- name: DeleteComment :execrows
+UPDATE comments SET deleted_at = NOW()
+WHERE id = $1 AND author_user_id = $2 AND deleted_at IS NULL;
@@ -0,0 +1,34 @@
+-- name: ListRoundTemplates :many
+SELECT * FROM round_templates WHERE deleted_at IS NULL ORDER BY name;
+
+-- name: GetRoundTemplate :one
+SELECT * FROM round_templates WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetRecentRoundHandicaps :many
+SELECT handicap::INTEGER AS handicap
+FROM qualification_rounds
+WHERE external_user_id = $1
+  AND bow_class = $2
+  AND handicap IS NOT NULL
+  AND deleted_at IS NULL
+ORDER BY end_time DESC
+LIMIT $3;
+
+-- name: UpsertArcherHandicap :one
+INSERT INTO archer_handicaps (
+    external_user_id,
+    bow_class,
+    handicap,
+    rounds_count
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (external_user_id, bow_class) DO UPDATE
+SET handicap = EXCLUDED.handicap,
+    rounds_count = EXCLUDED.rounds_count,
+    updated_at = NOW()
+    RETURNING *;
+
+-- name: GetArcherHandicap :one
+SELECT * FROM archer_handicaps WHERE external_user_id = $1 AND bow_class = $2;
+
+-- name: GetArcherHandicaps :many
+SELECT * FROM archer_handicaps WHERE external_user_id = $1 ORDER BY bow_class;
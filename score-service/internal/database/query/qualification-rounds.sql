@@ -8,12 +8,23 @@ INSERT INTO qualification_rounds (
     shots_per_set,
     target_face_id,
     notes,
-    start_time
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+    start_time,
+    bow_class,
+    round_template_id,
+    age_category,
+    gender
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
     RETURNING *;
 
 -- name: GetQualificationRound :one
 SELECT * FROM qualification_rounds WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: GetQualificationRoundsForUser :many
-SELECT * FROM qualification_rounds WHERE external_user_id = $1 AND deleted_at IS NULL;
\ No newline at end of file
+SELECT * FROM qualification_rounds WHERE external_user_id = $1 AND deleted_at IS NULL;
+
+-- name: CompleteQualificationRound :one
+UPDATE qualification_rounds
+SET end_time = COALESCE(end_time, NOW()),
+    handicap = $2
+WHERE id = $1 AND deleted_at IS NULL
+    RETURNING *;
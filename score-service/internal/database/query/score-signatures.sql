@@ -0,0 +1,46 @@
+-- name: CreateScoreSignature :one
+INSERT INTO score_signatures (
+    round_id,
+    set_id,
+    signer_user_id,
+    signer_role,
+    reason,
+    scores_hash
+) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListScoreSignatures :many
+SELECT * FROM score_signatures
+WHERE round_id = $1
+ORDER BY signed_at, id;
+
+-- name: IsSetSigned :one
+-- An end is signed when it or its whole round carries a signature.
+SELECT EXISTS (
+    SELECT 1 FROM score_signatures
+    WHERE round_id = sqlc.arg(round_id)
+      AND (set_id IS NULL OR set_id = sqlc.narg(set_id))
+);
+
+-- name: LockRoundScores :exec
+-- Locks a round until the transaction ends, so that its scores are not signed
+-- while they change nor changed while they are signed.
+SELECT 1 FROM qualification_rounds
+WHERE id = $1
+FOR UPDATE;
+
+-- name: ListRoundShotsForVerification :many
+-- Every scored arrow of a round in a stable order, for hashing.
+SELECT
+    s.id AS set_id,
+    s.set_number,
+    sh.id,
+    sh.x,
+    sh.y,
+    sh.score,
+    sh.is_x,
+    sh.is_miss
+FROM sets s
+         JOIN shots sh ON sh.set_id = s.id AND sh.deleted_at IS NULL
+WHERE s.parent_round_id = $1 AND s.deleted_at IS NULL
+ORDER BY s.set_number, s.id, sh.created_at, sh.id;
@@ -11,3 +11,10 @@ SELECT * FROM sets WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: GetSetsForQualificationRound :many
 SELECT * FROM sets WHERE parent_round_id = $1 AND deleted_at IS NULL ORDER BY set_number;
+
+-- name: GetSetOwner :one
+-- Returns the archer who owns the round the set belongs to.
+SELECT qr.external_user_id
+FROM sets s
+         JOIN qualification_rounds qr ON qr.id = s.parent_round_id
+WHERE s.id = $1 AND s.parent_round_id = $2 AND s.deleted_at IS NULL AND qr.deleted_at IS NULL;
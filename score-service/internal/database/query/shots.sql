@@ -1,12 +1,12 @@
 -- name: CreateShot :one
+-- The score is always calculated from the coordinates and the round's target face.
 WITH target_info AS (
-    SELECT zones_config, max_score, has_x
-    FROM target_faces tf
-             JOIN qualification_rounds qr ON tf.id = qr.target_face_id
+    SELECT calculate_shot_score(sqlc.arg('x')::DECIMAL, sqlc.arg('y')::DECIMAL, qr.target_face_id) AS score
+    FROM qualification_rounds qr
              JOIN sets s ON qr.id = s.parent_round_id
-    WHERE s.id = $4 AND tf.deleted_at IS NULL AND qr.deleted_at IS NULL AND s.deleted_at IS NULL
+    WHERE s.id = sqlc.arg('set_id') AND qr.deleted_at IS NULL AND s.deleted_at IS NULL
     LIMIT 1
-    )
+)
 INSERT INTO shots (
     x,
     y,
@@ -17,18 +17,18 @@ INSERT INTO shots (
     is_miss,
     notes,
     set_id
-) VALUES (
-    $1,
-    $2,
-    $3,
-    SQRT(POWER($1, 2) + POWER($2, 2)),
-    (SELECT calculate_shot_score($1, $2, (SELECT target_face_id FROM qualification_rounds qr JOIN sets s ON qr.id = s.parent_round_id WHERE s.id = $4 LIMIT 1))) = 10,
-    (SELECT calculate_shot_score($1, $2, (SELECT target_face_id FROM qualification_rounds qr JOIN sets s ON qr.id = s.parent_round_id WHERE s.id = $4 LIMIT 1))) = 10
-    AND SQRT(POWER($1, 2) + POWER($2, 2)) < 30.5,
-    (SELECT calculate_shot_score($1, $2, (SELECT target_face_id FROM qualification_rounds qr JOIN sets s ON qr.id = s.parent_round_id WHERE s.id = $4 LIMIT 1))) = 0,
-    $4,
-    $5
 )
+SELECT
+    sqlc.arg('x')::DECIMAL,
+    sqlc.arg('y')::DECIMAL,
+    score,
+    SQRT(POWER(sqlc.arg('x')::DECIMAL, 2) + POWER(sqlc.arg('y')::DECIMAL, 2)),
+    score = 10,
+    score = 10 AND SQRT(POWER(sqlc.arg('x')::DECIMAL, 2) + POWER(sqlc.arg('y')::DECIMAL, 2)) < 30.5,
+    score = 0,
+    sqlc.narg('notes'),
+    sqlc.arg('set_id')
+FROM target_info
     RETURNING *;
 
 -- name: GetShot :one
@@ -41,6 +41,31 @@ WHERE set_id = $1 AND deleted_at IS NULL
 ORDER BY created_at;
 
 -- name: BatchCreateShots :many
+-- Scores every shot against the round's target face and inserts them in a
+-- single statement, so set and round statistics are refreshed once.
+WITH target_info AS (
+    SELECT qr.target_face_id
+    FROM qualification_rounds qr
+             JOIN sets s ON qr.id = s.parent_round_id
+    WHERE s.id = sqlc.arg('set_id') AND qr.deleted_at IS NULL AND s.deleted_at IS NULL
+    LIMIT 1
+), input AS (
+    SELECT
+        i.x,
+        i.y,
+        i.notes,
+        i.ord,
+        calculate_shot_score(i.x, i.y, target_info.target_face_id) AS score
+    FROM (
+             SELECT
+                 (sqlc.arg('xs')::DECIMAL[])[ord] AS x,
+                 (sqlc.arg('ys')::DECIMAL[])[ord] AS y,
+                 (sqlc.arg('notes')::TEXT[])[ord] AS notes,
+                 ord
+             FROM generate_subscripts(sqlc.arg('xs')::DECIMAL[], 1) AS ord
+         ) i
+             CROSS JOIN target_info
+)
 INSERT INTO shots (
     x,
     y,
@@ -49,14 +74,46 @@ INSERT INTO shots (
     is_ten,
     is_x,
     is_miss,
-    set_id
-) SELECT
-      unnest($1::DECIMAL[]),
-      unnest($2::DECIMAL[]),
-      unnest($3::INTEGER[]),
-      unnest($4::DECIMAL[]),
-      unnest($5::BOOLEAN[]),
-      unnest($6::BOOLEAN[]),
-      unnest($7::BOOLEAN[]),
-      $8
-          RETURNING *;
\ No newline at end of file
+    notes,
+    set_id,
+    created_at
+)
+SELECT
+    x,
+    y,
+    score,
+    SQRT(POWER(x, 2) + POWER(y, 2)),
+    score = 10,
+    score = 10 AND SQRT(POWER(x, 2) + POWER(y, 2)) < 30.5,
+    score = 0,
+    NULLIF(notes, ''),
+    sqlc.arg('set_id'),
+    clock_timestamp()
+FROM input
+ORDER BY ord
+    RETURNING *;
+
+-- name: UpdateShot :one
+-- Moves a shot and rescores it against the round's target face.
+UPDATE shots sh
+SET x = sqlc.arg('x')::DECIMAL,
+    y = sqlc.arg('y')::DECIMAL,
+    score = t.score,
+    distance_from_center = SQRT(POWER(sqlc.arg('x')::DECIMAL, 2) + POWER(sqlc.arg('y')::DECIMAL, 2)),
+    is_ten = t.score = 10,
+    is_x = t.score = 10 AND SQRT(POWER(sqlc.arg('x')::DECIMAL, 2) + POWER(sqlc.arg('y')::DECIMAL, 2)) < 30.5,
+    is_miss = t.score = 0,
+    notes = sqlc.narg('notes')
+FROM (
+         SELECT calculate_shot_score(sqlc.arg('x')::DECIMAL, sqlc.arg('y')::DECIMAL, qr.target_face_id) AS score
+         FROM qualification_rounds qr
+                  JOIN sets s ON qr.id = s.parent_round_id
+         WHERE s.id = sqlc.arg('set_id') AND qr.deleted_at IS NULL AND s.deleted_at IS NULL
+         LIMIT 1
+     ) t
+WHERE sh.id = sqlc.arg('id') AND sh.set_id = sqlc.arg('set_id') AND sh.deleted_at IS NULL
+    RETURNING sh.*;
+
+-- name: DeleteShot :execrows
+UPDATE shots SET deleted_at = NOW()
+WHERE id = $1 AND set_id = $2 AND deleted_at IS NULL;
@@ -0,0 +1,5 @@
+-- name: ListQualificationRoundIDs :many
+SELECT id FROM qualification_rounds ORDER BY created_at;
+
+-- name: RefreshRoundStatistics :exec
+SELECT refresh_set_statistics(ARRAY(SELECT id FROM sets WHERE parent_round_id = $1)::UUID[]);
@@ -0,0 +1,44 @@
+-- name: GetTargetFace :one
+SELECT * FROM target_faces WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetTargetFaceIncludingDeleted :one
+-- Rounds keep the face they were shot on, so scoring looks up deleted faces too.
+SELECT * FROM target_faces WHERE id = $1;
+
+-- name: IsTargetFaceInUse :one
+-- Any round counts, deleted or not, since its shots were scored on the face.
+SELECT EXISTS (
+    SELECT 1 FROM qualification_rounds WHERE target_face_id = $1
+);
+
+-- name: ListTargetFaces :many
+SELECT * FROM target_faces WHERE deleted_at IS NULL ORDER BY standard, name;
+
+-- name: CreateTargetFace :one
+INSERT INTO target_faces (
+    name,
+    standard,
+    total_diameter,
+    scoring_diameter,
+    zones_config,
+    max_score,
+    has_x,
+    description
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: UpdateTargetFace :one
+UPDATE target_faces
+SET name = $2,
+    standard = $3,
+    total_diameter = $4,
+    scoring_diameter = $5,
+    zones_config = $6,
+    max_score = $7,
+    has_x = $8,
+    description = $9
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteTargetFace :execrows
+UPDATE target_faces SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL;
@@ -0,0 +1,77 @@
+package seed
+
+import (
+	"archy/scores/internal/database/dbtest"
+	"archy/scores/internal/db"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestMain(m *testing.M) { os.Exit(dbtest.Main(m)) }
+
+// Generate stores the planned rounds through the services, so the triggers
+// keep the same statistics as for rounds scored through the API.
+func TestGenerate(t *testing.T) {
+	ctx := context.Background()
+	pool := dbtest.New(t)
+	queries := db.New(pool)
+	opts := testOptions()
+	opts.Users, opts.Rounds = 2, 3
+
+	templates, err := queries.ListRoundTemplates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	planned, err := Plan(opts, templates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := Generate(ctx, pool, opts)
+	if err != nil || n != len(planned) {
+		t.Fatalf("Generate = %d, %v, want %d rounds", n, err, len(planned))
+	}
+
+	arrows := make(map[string]int32)
+	for _, r := range planned {
+		for _, end := range r.Ends {
+			arrows[r.UserID] += int32(len(end))
+		}
+	}
+	for user, want := range arrows {
+		rounds, err := queries.GetQualificationRoundsForUser(ctx, user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rounds) != opts.Rounds {
+			t.Errorf("%s: expected %d rounds, got %d", user, opts.Rounds, len(rounds))
+		}
+		var shots int32
+		for _, round := range rounds {
+			shots += round.ShotsCount
+			if !round.EndTime.Valid {
+				t.Errorf("%s: round %s not completed", user, round.ID)
+			}
+			if round.CompletedSets != round.TotalSets {
+				t.Errorf("%s: round %s has %d of %d ends completed", user, round.ID, round.CompletedSets, round.TotalSets)
+			}
+
+			sets, err := queries.GetSetsForQualificationRound(ctx, pgtype.UUID{Bytes: round.ID, Valid: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var total int32
+			for _, set := range sets {
+				total += set.TotalScore
+			}
+			if total != round.TotalScore {
+				t.Errorf("%s: round %s totals %d, its ends %d", user, round.ID, round.TotalScore, total)
+			}
+		}
+		if shots != want {
+			t.Errorf("%s: expected %d arrows, got %d", user, want, shots)
+		}
+	}
+}
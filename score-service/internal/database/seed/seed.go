@@ -0,0 +1,298 @@
+// Package seed generates synthetic archers, rounds, sets and shots for demos,
+// load tests and analytics tests. The data is planned from a seed value
+// alone, so the same options always give the same archers, rounds and arrows;
+// only the dates move with Options.Until.
+//
+// Each archer gets a bow class, a skill and an aiming error of their own.
+// Arrows are drawn from a bivariate normal distribution around the
+// configured centre plus the archer's aiming error, with a spread that grows
+// with distance, with the archer's skill and, through fatigue, towards the
+// last end of a round. Rounds are written through the services, so scores,
+// statistics, handicaps and classifications are calculated as for rounds
+// shot in the app.
+package seed
+
+import (
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/core/services"
+	"archy/scores/internal/db"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Options describe the dataset. Distances on the face are in mm from its
+// centre, with y pointing up. The spread is given for a recurve archer of
+// average skill at 70 m and scales with distance: at 18 m it is 18/70 of it.
+type Options struct {
+	Seed        int64
+	Users       int     // number of archers
+	Rounds      int     // rounds per archer
+	CenterX     float64 // mean horizontal offset in mm
+	CenterY     float64 // mean vertical offset in mm
+	SpreadX     float64 // horizontal standard deviation in mm at 70 m
+	SpreadY     float64 // vertical standard deviation in mm at 70 m
+	Correlation float64 // correlation between x and y, in (-1, 1)
+	Fatigue     float64 // relative growth of the spread from the first to the last end
+	Days        int     // the rounds are spread over this many days before Until
+	Until       time.Time
+	UserPrefix  string // archers are named UserPrefix followed by a number
+}
+
+// DefaultOptions returns a small dataset of ten archers who shot twelve
+// rounds each over the three months up to today. Set Until for a dataset that
+// does not depend on the day it is generated.
+func DefaultOptions() Options {
+	return Options{
+		Seed:       1,
+		Users:      10,
+		Rounds:     12,
+		SpreadX:    60,
+		SpreadY:    60,
+		Fatigue:    0.15,
+		Days:       90,
+		Until:      time.Now().UTC().Truncate(24 * time.Hour),
+		UserPrefix: "seed-archer-",
+	}
+}
+
+// Validate checks that the options describe a dataset.
+func (o Options) Validate() error {
+	switch {
+	case o.Users < 1:
+		return errors.New("seed: at least one user is required")
+	case o.Rounds < 1:
+		return errors.New("seed: at least one round per user is required")
+	case o.SpreadX <= 0 || o.SpreadY <= 0:
+		return errors.New("seed: the spread must be positive")
+	case o.Correlation <= -1 || o.Correlation >= 1:
+		return errors.New("seed: the correlation must be between -1 and 1")
+	case o.Fatigue < 0:
+		return errors.New("seed: fatigue cannot be negative")
+	case o.Days < 1:
+		return errors.New("seed: the rounds need at least one day")
+	case o.UserPrefix == "":
+		return errors.New("seed: a user prefix is required")
+	}
+	return nil
+}
+
+// Round is one planned round with its arrows by end.
+type Round struct {
+	UserID      string
+	Template    db.RoundTemplate
+	RoundType   string
+	BowClass    string
+	AgeCategory string
+	Gender      string
+	StartTime   time.Time
+	Ends        [][]Shot
+}
+
+// Shot is an arrow's position on the face in mm.
+type Shot struct {
+	X, Y float64
+}
+
+// bowClass is a bow class with how often it is drawn and how much wider than
+// a recurve archer's its groups are.
+type bowClass struct {
+	name   string
+	weight int
+	spread float64
+}
+
+var bowClasses = []bowClass{
+	{models.BowClassRecurve, 5, 1},
+	{models.BowClassCompound, 3, 0.6},
+	{models.BowClassBarebow, 1, 1.4},
+	{models.BowClassLongbow, 1, 2},
+}
+
+// Arrows stay on the largest face, as the shot requests require.
+const maxOffset = 700
+
+// Plan draws the dataset for the round templates. The templates are sorted by
+// name first so that their order in the database does not change the plan.
+func Plan(opts Options, templates []db.RoundTemplate) ([]Round, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, errors.New("seed: no round templates to shoot")
+	}
+	templates = append([]db.RoundTemplate(nil), templates...)
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+
+	r := rand.New(rand.NewSource(opts.Seed))
+	rounds := make([]Round, 0, opts.Users*opts.Rounds)
+
+	for u := 1; u <= opts.Users; u++ {
+		userID := fmt.Sprintf("%s%03d", opts.UserPrefix, u)
+		class := drawBowClass(r)
+		gender := models.Genders[r.Intn(len(models.Genders))]
+		age := models.AgeCategoryAdult
+		if r.Intn(4) == 0 {
+			age = models.AgeCategories[1+r.Intn(len(models.AgeCategories)-1)]
+		}
+		// Skill is log-normal so that a few archers are much better or worse
+		// than the rest; the aiming error moves the archer's groups off the
+		// configured centre.
+		skill := class.spread * math.Exp(0.35*r.NormFloat64())
+		aimX := opts.CenterX + opts.SpreadX/3*r.NormFloat64()
+		aimY := opts.CenterY + opts.SpreadY/3*r.NormFloat64()
+
+		starts := make([]time.Time, opts.Rounds)
+		for i := range starts {
+			day := opts.Until.AddDate(0, 0, -1-r.Intn(opts.Days))
+			starts[i] = day.Add(time.Duration(9*60+r.Intn(9*60)) * time.Minute)
+		}
+		sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+		for _, start := range starts {
+			t := templates[r.Intn(len(templates))]
+			roundType := models.RoundTypeTraining
+			if r.Intn(3) == 0 {
+				roundType = models.RoundTypeQualification
+			}
+
+			scale := skill * float64(t.Distance) / 70
+			ends := make([][]Shot, t.TotalSets)
+			for e := range ends {
+				tired := 1.0
+				if t.TotalSets > 1 {
+					tired += opts.Fatigue * float64(e) / float64(t.TotalSets-1)
+				}
+				ends[e] = make([]Shot, t.ShotsPerSet)
+				for a := range ends[e] {
+					ends[e][a] = shot(r, aimX, aimY, opts.SpreadX*scale*tired, opts.SpreadY*scale*tired, opts.Correlation)
+				}
+			}
+
+			rounds = append(rounds, Round{
+				UserID:      userID,
+				Template:    t,
+				RoundType:   roundType,
+				BowClass:    class.name,
+				AgeCategory: age,
+				Gender:      gender,
+				StartTime:   start,
+				Ends:        ends,
+			})
+		}
+	}
+
+	return rounds, nil
+}
+
+func drawBowClass(r *rand.Rand) bowClass {
+	total := 0
+	for _, c := range bowClasses {
+		total += c.weight
+	}
+	n := r.Intn(total)
+	for _, c := range bowClasses {
+		if n < c.weight {
+			return c
+		}
+		n -= c.weight
+	}
+	return bowClasses[0]
+}
+
+// shot draws an arrow from the bivariate normal distribution with means cx
+// and cy, standard deviations sx and sy and correlation rho, rounded to
+// 0.1 mm and kept on the face.
+func shot(r *rand.Rand, cx, cy, sx, sy, rho float64) Shot {
+	z1, z2 := r.NormFloat64(), r.NormFloat64()
+	x := cx + sx*z1
+	y := cy + sy*(rho*z1+math.Sqrt(1-rho*rho)*z2)
+	return Shot{X: clamp(x), Y: clamp(y)}
+}
+
+func clamp(v float64) float64 {
+	v = math.Round(v*10) / 10
+	return math.Max(-maxOffset, math.Min(maxOffset, v))
+}
+
+// Insert writes the rounds, each in its own transaction, and completes them.
+// It returns how many rounds were written.
+func Insert(ctx context.Context, pool *pgxpool.Pool, rounds []Round) (int, error) {
+	for i, round := range rounds {
+		err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+			return insertRound(ctx, db.New(pool).WithTx(tx), round)
+		})
+		if err != nil {
+			return i, fmt.Errorf("seed: round %d of %s: %w", i+1, round.UserID, err)
+		}
+	}
+	return len(rounds), nil
+}
+
+func insertRound(ctx context.Context, queries *db.Queries, round Round) error {
+	access := services.NewAccessService(queries)
+	audit := services.NewAuditService(queries, access)
+	signatures := services.NewSignatureService(queries, access)
+	roundService := services.NewQualificationRoundService(queries, access, audit,
+		services.NewHandicapService(queries), services.NewClassificationService(queries))
+	setService := services.NewSetService(queries, access, signatures, audit)
+	shotService := services.NewShotService(queries, access, signatures, audit)
+
+	templateID := round.Template.ID
+	created, err := roundService.CreateQualificationRound(ctx, round.UserID, models.CreateQualificationRoundRequest{
+		RoundType:       round.RoundType,
+		Name:            round.Template.Name,
+		Distance:        int(round.Template.Distance),
+		TotalSets:       int(round.Template.TotalSets),
+		ShotsPerSet:     int(round.Template.ShotsPerSet),
+		TargetFaceID:    round.Template.TargetFaceID,
+		BowClass:        round.BowClass,
+		RoundTemplateID: &templateID,
+		AgeCategory:     round.AgeCategory,
+		Gender:          round.Gender,
+		StartTime:       &round.StartTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	for e, end := range round.Ends {
+		set, err := setService.CreateSet(ctx, round.UserID, created.ID, models.CreateSetRequest{SetNumber: e + 1})
+		if err != nil {
+			return err
+		}
+		shots := make([]models.CreateShotRequest, len(end))
+		for a, s := range end {
+			shots[a] = models.CreateShotRequest{X: &s.X, Y: &s.Y}
+		}
+		if _, err := shotService.CreateShotsBatch(ctx, round.UserID, created.ID, set.ID,
+			models.CreateShotsBatchRequest{Shots: shots}); err != nil {
+			return err
+		}
+	}
+
+	_, err = roundService.CompleteQualificationRound(ctx, round.UserID, created.ID)
+	return err
+}
+
+// Generate plans the dataset for the round templates in the database and
+// inserts it. Running it twice with the same options adds a second copy of
+// the rounds to the same archers, so seed an empty database.
+func Generate(ctx context.Context, pool *pgxpool.Pool, opts Options) (int, error) {
+	templates, err := db.New(pool).ListRoundTemplates(ctx)
+	if err != nil {
+		return 0, err
+	}
+	rounds, err := Plan(opts, templates)
+	if err != nil {
+		return 0, err
+	}
+	return Insert(ctx, pool, rounds)
+}
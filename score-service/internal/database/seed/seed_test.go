@@ -0,0 +1,155 @@
+package seed
+
+import (
+	"archy/scores/internal/core/models"
+	"archy/scores/internal/db"
+	"math"
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var templates = []db.RoundTemplate{
+	{ID: uuid.New(), Name: "WA 70m", Distance: 70, TotalSets: 12, ShotsPerSet: 6, TargetFaceID: uuid.New()},
+	{ID: uuid.New(), Name: "WA 30m", Distance: 30, TotalSets: 6, ShotsPerSet: 6, TargetFaceID: uuid.New()},
+	{ID: uuid.New(), Name: "NFAA 300", Distance: 18, TotalSets: 12, ShotsPerSet: 5, TargetFaceID: uuid.New()},
+}
+
+func testOptions() Options {
+	opts := DefaultOptions()
+	opts.Until = time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	return opts
+}
+
+func TestPlan_IsDeterministic(t *testing.T) {
+	opts := testOptions()
+	first, err := Plan(opts, templates)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The order the templates come back from the database does not matter.
+	reversed := slices.Clone(templates)
+	slices.Reverse(reversed)
+	second, err := Plan(opts, reversed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(first, second) {
+		t.Error("expected the same plan for the same seed")
+	}
+
+	opts.Seed = 2
+	other, err := Plan(opts, templates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(first, other) {
+		t.Error("expected a different plan for another seed")
+	}
+}
+
+func TestPlan_Shape(t *testing.T) {
+	opts := testOptions()
+	rounds, err := Plan(opts, templates)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rounds) != opts.Users*opts.Rounds {
+		t.Fatalf("expected %d rounds, got %d", opts.Users*opts.Rounds, len(rounds))
+	}
+
+	users := make(map[string]bool)
+	for _, round := range rounds {
+		users[round.UserID] = true
+		if len(round.Ends) != int(round.Template.TotalSets) {
+			t.Errorf("expected %d ends, got %d", round.Template.TotalSets, len(round.Ends))
+		}
+		for _, end := range round.Ends {
+			if len(end) != int(round.Template.ShotsPerSet) {
+				t.Errorf("expected %d arrows an end, got %d", round.Template.ShotsPerSet, len(end))
+			}
+			for _, s := range end {
+				if math.Abs(s.X) > maxOffset || math.Abs(s.Y) > maxOffset {
+					t.Errorf("arrow %+v is off the face", s)
+				}
+			}
+		}
+		if !models.IsValidBowClass(round.BowClass) || !slices.Contains(models.Genders, round.Gender) ||
+			!slices.Contains(models.AgeCategories, round.AgeCategory) {
+			t.Errorf("invalid archer %s/%s/%s", round.BowClass, round.Gender, round.AgeCategory)
+		}
+		if !round.StartTime.Before(opts.Until) || round.StartTime.Before(opts.Until.AddDate(0, 0, -opts.Days)) {
+			t.Errorf("start time %v is outside the %d days before %v", round.StartTime, opts.Days, opts.Until)
+		}
+	}
+	if len(users) != opts.Users {
+		t.Errorf("expected %d archers, got %d", opts.Users, len(users))
+	}
+}
+
+func TestPlan_RejectsInvalidOptions(t *testing.T) {
+	for name, change := range map[string]func(*Options){
+		"no users":    func(o *Options) { o.Users = 0 },
+		"no rounds":   func(o *Options) { o.Rounds = 0 },
+		"no spread":   func(o *Options) { o.SpreadY = 0 },
+		"correlation": func(o *Options) { o.Correlation = 1 },
+		"fatigue":     func(o *Options) { o.Fatigue = -0.1 },
+		"no days":     func(o *Options) { o.Days = 0 },
+		"no prefix":   func(o *Options) { o.UserPrefix = "" },
+	} {
+		opts := testOptions()
+		change(&opts)
+		if _, err := Plan(opts, templates); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if _, err := Plan(testOptions(), nil); err == nil {
+		t.Error("expected an error without round templates")
+	}
+}
+
+func TestShot_Distribution(t *testing.T) {
+	const n = 20000
+	r := rand.New(rand.NewSource(1))
+	cx, cy, sx, sy, rho := 15.0, -10.0, 40.0, 25.0, 0.5
+
+	var sumX, sumY float64
+	shots := make([]Shot, n)
+	for i := range shots {
+		shots[i] = shot(r, cx, cy, sx, sy, rho)
+		sumX += shots[i].X
+		sumY += shots[i].Y
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var varX, varY, cov float64
+	for _, s := range shots {
+		varX += (s.X - meanX) * (s.X - meanX)
+		varY += (s.Y - meanY) * (s.Y - meanY)
+		cov += (s.X - meanX) * (s.Y - meanY)
+	}
+	stdX, stdY := math.Sqrt(varX/n), math.Sqrt(varY/n)
+	corr := cov / math.Sqrt(varX*varY)
+
+	for _, c := range []struct {
+		name      string
+		got, want float64
+		tolerance float64
+	}{
+		{"mean x", meanX, cx, 1},
+		{"mean y", meanY, cy, 1},
+		{"std x", stdX, sx, 1},
+		{"std y", stdY, sy, 1},
+		{"correlation", corr, rho, 0.02},
+	} {
+		if math.Abs(c.got-c.want) > c.tolerance {
+			t.Errorf("%s = %.3f, want %.3f ± %v", c.name, c.got, c.want, c.tolerance)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: analysis.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getShotPositionsForUser = `-- name: GetShotPositionsForUser :many
+SELECT
+    qr.id AS round_id,
+    s.id AS set_id,
+    s.set_number,
+    ROW_NUMBER() OVER (PARTITION BY sh.set_id ORDER BY sh.created_at, sh.id)::INTEGER AS arrow_number,
+    sh.score,
+    sh.x,
+    sh.y,
+    sh.distance_from_center
+FROM shots sh
+         JOIN sets s ON s.id = sh.set_id
+         JOIN qualification_rounds qr ON qr.id = s.parent_round_id
+WHERE qr.external_user_id = $1
+  AND qr.deleted_at IS NULL
+  AND s.deleted_at IS NULL
+  AND sh.deleted_at IS NULL
+  AND ($2::VARCHAR IS NULL OR qr.round_type = $2::VARCHAR)
+  AND ($3::INTEGER IS NULL OR qr.distance = $3::INTEGER)
+ORDER BY qr.created_at, s.set_number, arrow_number
+`
+
+type GetShotPositionsForUserParams struct {
+	ExternalUserID string      `json:"external_user_id"`
+	RoundType      pgtype.Text `json:"round_type"`
+	Distance       pgtype.Int4 `json:"distance"`
+}
+
+type GetShotPositionsForUserRow struct {
+	RoundID            uuid.UUID      `json:"round_id"`
+	SetID              uuid.UUID      `json:"set_id"`
+	SetNumber          int32          `json:"set_number"`
+	ArrowNumber        int32          `json:"arrow_number"`
+	Score              int32          `json:"score"`
+	X                  pgtype.Numeric `json:"x"`
+	Y                  pgtype.Numeric `json:"y"`
+	DistanceFromCenter pgtype.Numeric `json:"distance_from_center"`
+}
+
+func (q *Queries) GetShotPositionsForUser(ctx context.Context, arg GetShotPositionsForUserParams) ([]GetShotPositionsForUserRow, error) {
+	rows, err := q.db.Query(ctx, getShotPositionsForUser, arg.ExternalUserID, arg.RoundType, arg.Distance)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetShotPositionsForUserRow{}
+	for rows.Next() {
+		var i GetShotPositionsForUserRow
+		if err := rows.Scan(
+			&i.RoundID,
+			&i.SetID,
+			&i.SetNumber,
+			&i.ArrowNumber,
+			&i.Score,
+			&i.X,
+			&i.Y,
+			&i.DistanceFromCenter,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
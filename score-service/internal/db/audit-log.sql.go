@@ -0,0 +1,181 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit-log.sql
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAuditLogEntries = `-- name: CreateAuditLogEntries :exec
+INSERT INTO audit_log (
+    actor_user_id,
+    token_type,
+    token_id,
+    request_id,
+    action,
+    entity_type,
+    entity_id,
+    owner_user_id,
+    after_data,
+    reason
+)
+SELECT
+    $1::varchar,
+    $2::varchar,
+    $3::varchar,
+    $4::varchar,
+    'create',
+    $5::varchar,
+    ($6::UUID[])[ord],
+    $7::varchar,
+    ($8::JSONB[])[ord],
+    $9::text
+FROM generate_subscripts($6::UUID[], 1) AS ord
+ORDER BY ord
+`
+
+type CreateAuditLogEntriesParams struct {
+	ActorUserID string      `json:"actor_user_id"`
+	TokenType   string      `json:"token_type"`
+	TokenID     pgtype.Text `json:"token_id"`
+	RequestID   pgtype.Text `json:"request_id"`
+	EntityType  string      `json:"entity_type"`
+	EntityIds   []uuid.UUID `json:"entity_ids"`
+	OwnerUserID pgtype.Text `json:"owner_user_id"`
+	AfterData   [][]byte    `json:"after_data"`
+	Reason      pgtype.Text `json:"reason"`
+}
+
+// Records entities of one type created together by one request, such as the
+// arrows of a batch, with after_data parallel to entity_ids.
+func (q *Queries) CreateAuditLogEntries(ctx context.Context, arg CreateAuditLogEntriesParams) error {
+	_, err := q.db.Exec(ctx, createAuditLogEntries,
+		arg.ActorUserID,
+		arg.TokenType,
+		arg.TokenID,
+		arg.RequestID,
+		arg.EntityType,
+		arg.EntityIds,
+		arg.OwnerUserID,
+		arg.AfterData,
+		arg.Reason,
+	)
+	return err
+}
+
+const createAuditLogEntry = `-- name: CreateAuditLogEntry :exec
+INSERT INTO audit_log (
+    actor_user_id,
+    token_type,
+    token_id,
+    request_id,
+    action,
+    entity_type,
+    entity_id,
+    owner_user_id,
+    before_data,
+    after_data,
+    reason
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+
+type CreateAuditLogEntryParams struct {
+	ActorUserID string          `json:"actor_user_id"`
+	TokenType   string          `json:"token_type"`
+	TokenID     pgtype.Text     `json:"token_id"`
+	RequestID   pgtype.Text     `json:"request_id"`
+	Action      string          `json:"action"`
+	EntityType  string          `json:"entity_type"`
+	EntityID    uuid.UUID       `json:"entity_id"`
+	OwnerUserID pgtype.Text     `json:"owner_user_id"`
+	BeforeData  json.RawMessage `json:"before_data"`
+	AfterData   json.RawMessage `json:"after_data"`
+	Reason      pgtype.Text     `json:"reason"`
+}
+
+func (q *Queries) CreateAuditLogEntry(ctx context.Context, arg CreateAuditLogEntryParams) error {
+	_, err := q.db.Exec(ctx, createAuditLogEntry,
+		arg.ActorUserID,
+		arg.TokenType,
+		arg.TokenID,
+		arg.RequestID,
+		arg.Action,
+		arg.EntityType,
+		arg.EntityID,
+		arg.OwnerUserID,
+		arg.BeforeData,
+		arg.AfterData,
+		arg.Reason,
+	)
+	return err
+}
+
+const listAuditLog = `-- name: ListAuditLog :many
+SELECT id, occurred_at, actor_user_id, token_type, token_id, request_id, action, entity_type, entity_id, owner_user_id, before_data, after_data, reason FROM audit_log
+WHERE ($1::varchar IS NULL OR entity_type = $1)
+  AND ($2::uuid IS NULL OR entity_id = $2)
+  AND ($3::varchar IS NULL OR actor_user_id = $3)
+  AND ($4::varchar[] IS NULL OR owner_user_id = ANY($4::varchar[]))
+  AND ($5::bigint IS NULL OR id < $5)
+ORDER BY id DESC
+LIMIT $6
+`
+
+type ListAuditLogParams struct {
+	EntityType   pgtype.Text `json:"entity_type"`
+	EntityID     pgtype.UUID `json:"entity_id"`
+	ActorUserID  pgtype.Text `json:"actor_user_id"`
+	OwnerUserIds []string    `json:"owner_user_ids"`
+	BeforeID     pgtype.Int8 `json:"before_id"`
+	RowLimit     int32       `json:"row_limit"`
+}
+
+// Newest first. owner_user_ids limits the entries to the given archers' data
+// when not NULL; before_id pages through older entries.
+func (q *Queries) ListAuditLog(ctx context.Context, arg ListAuditLogParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditLog,
+		arg.EntityType,
+		arg.EntityID,
+		arg.ActorUserID,
+		arg.OwnerUserIds,
+		arg.BeforeID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditLog{}
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.OccurredAt,
+			&i.ActorUserID,
+			&i.TokenType,
+			&i.TokenID,
+			&i.RequestID,
+			&i.Action,
+			&i.EntityType,
+			&i.EntityID,
+			&i.OwnerUserID,
+			&i.BeforeData,
+			&i.AfterData,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,166 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestAuditLog(t *testing.T) {
+	f := newFixture(t)
+	round, face := uuid.New(), uuid.New()
+	entries := []db.CreateAuditLogEntryParams{
+		{ActorUserID: "archer", Action: "create", EntityType: "round", EntityID: round, OwnerUserID: text("archer"),
+			AfterData: json.RawMessage(`{"name": "Club round"}`)},
+		{ActorUserID: "admin", Action: "create", EntityType: "target_face", EntityID: face},
+		{ActorUserID: "judge", Action: "update", EntityType: "round", EntityID: round, OwnerUserID: text("archer"),
+			BeforeData: json.RawMessage(`{"name": "Club round"}`), AfterData: json.RawMessage(`{"name": "Record"}`),
+			Reason: text("typo")},
+		{ActorUserID: "other", Action: "delete", EntityType: "round", EntityID: uuid.New(), OwnerUserID: text("other")},
+	}
+	for _, e := range entries {
+		e.TokenType, e.TokenID, e.RequestID = "jwt", text("jti"), text("req")
+		if err := f.q.CreateAuditLogEntry(f.ctx, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, err := f.q.ListAuditLog(f.ctx, db.ListAuditLogParams{RowLimit: 10})
+	if err != nil || len(all) != 4 {
+		t.Fatalf("ListAuditLog = %d entries, %v, want 4", len(all), err)
+	}
+	if e := all[1]; e.ActorUserID != "judge" || e.Reason != text("typo") || e.TokenType != "jwt" || e.RequestID != text("req") {
+		t.Errorf("entry not stored as given: %+v", e)
+	}
+	var after map[string]string
+	if err := json.Unmarshal(all[1].AfterData, &after); err != nil || after["name"] != "Record" {
+		t.Errorf("after data = %s, %v", all[1].AfterData, err)
+	}
+	// Entries are numbered newest first.
+	id := func(i int) pgtype.Int8 { return pgtype.Int8{Int64: all[i].ID, Valid: true} }
+
+	tests := []struct {
+		name   string
+		params db.ListAuditLogParams
+		want   []string // actors
+	}{
+		{"all", db.ListAuditLogParams{RowLimit: 10}, []string{"other", "judge", "admin", "archer"}},
+		{"limited", db.ListAuditLogParams{RowLimit: 2}, []string{"other", "judge"}},
+		{"older page", db.ListAuditLogParams{BeforeID: id(1), RowLimit: 10}, []string{"admin", "archer"}},
+		{"by entity type", db.ListAuditLogParams{EntityType: text("target_face"), RowLimit: 10}, []string{"admin"}},
+		{"by entity", db.ListAuditLogParams{EntityID: pgID(round), RowLimit: 10}, []string{"judge", "archer"}},
+		{"by actor", db.ListAuditLogParams{ActorUserID: text("judge"), RowLimit: 10}, []string{"judge"}},
+		{"by owners", db.ListAuditLogParams{OwnerUserIds: []string{"archer", "other"}, RowLimit: 10}, []string{"other", "judge", "archer"}},
+		{"no owners", db.ListAuditLogParams{OwnerUserIds: []string{}, RowLimit: 10}, []string{}},
+	}
+	for _, tt := range tests {
+		got, err := f.q.ListAuditLog(f.ctx, tt.params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actors := make([]string, len(got))
+		for i, e := range got {
+			actors[i] = e.ActorUserID
+		}
+		if len(actors) != len(tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, actors, tt.want)
+			continue
+		}
+		for i := range actors {
+			if actors[i] != tt.want[i] {
+				t.Errorf("%s: got %v, want %v", tt.name, actors, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestAuditLog_AppendOnly(t *testing.T) {
+	f := newFixture(t)
+	if err := f.q.CreateAuditLogEntry(f.ctx, db.CreateAuditLogEntryParams{
+		ActorUserID: "archer", TokenType: "jwt", Action: "create", EntityType: "round", EntityID: uuid.New(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sql := range []string{
+		`UPDATE audit_log SET actor_user_id = 'someone else'`,
+		`DELETE FROM audit_log`,
+		`TRUNCATE audit_log`,
+	} {
+		_, err := f.pool.Exec(f.ctx, sql)
+		expectCode(t, err, "P0001")
+	}
+
+	// An unknown action is rejected.
+	err := f.q.CreateAuditLogEntry(f.ctx, db.CreateAuditLogEntryParams{
+		ActorUserID: "archer", TokenType: "jwt", Action: "read", EntityType: "round", EntityID: uuid.New(),
+	})
+	expectCode(t, err, "23514")
+}
+
+func TestCreateAuditLogEntries(t *testing.T) {
+	f := newFixture(t)
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	err := f.q.CreateAuditLogEntries(f.ctx, db.CreateAuditLogEntriesParams{
+		ActorUserID: "judge", TokenType: "jwt", TokenID: text("jti"), RequestID: text("req"),
+		EntityType: "shot", EntityIds: ids, OwnerUserID: text("archer"), Reason: text("missed arrows"),
+		AfterData: [][]byte{[]byte(`{"score": 10}`), []byte(`{"score": 9}`), []byte(`{"score": 8}`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := f.q.ListAuditLog(f.ctx, db.ListAuditLogParams{RowLimit: 10})
+	if err != nil || len(entries) != len(ids) {
+		t.Fatalf("ListAuditLog = %d entries, %v, want %d", len(entries), err, len(ids))
+	}
+	for i, e := range entries {
+		// Newest first, so in reverse order of the batch.
+		want := ids[len(ids)-1-i]
+		if e.EntityID != want || e.Action != "create" || e.ActorUserID != "judge" || e.OwnerUserID != text("archer") ||
+			e.Reason != text("missed arrows") || e.RequestID != text("req") || e.BeforeData != nil {
+			t.Errorf("entry %d = %+v, want a create of %s", i, e, want)
+		}
+	}
+	var after map[string]int
+	if err := json.Unmarshal(entries[0].AfterData, &after); err != nil || after["score"] != 8 {
+		t.Errorf("after data = %s, %v", entries[0].AfterData, err)
+	}
+}
+
+// A change and its audit entry are committed together or not at all.
+func TestInTx(t *testing.T) {
+	f := newFixture(t)
+	entry := func(q *db.Queries) error {
+		return q.CreateAuditLogEntry(f.ctx, db.CreateAuditLogEntryParams{
+			ActorUserID: "archer", TokenType: "jwt", Action: "create", EntityType: "round", EntityID: uuid.New(),
+		})
+	}
+	count := func() int {
+		entries, err := f.q.ListAuditLog(f.ctx, db.ListAuditLogParams{RowLimit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return len(entries)
+	}
+
+	if err := f.q.InTx(f.ctx, entry); err != nil || count() != 1 {
+		t.Fatalf("expected the entry to be committed, got %d entries, %v", count(), err)
+	}
+
+	failed := errors.New("audit failed")
+	err := f.q.InTx(f.ctx, func(q *db.Queries) error {
+		if err := entry(q); err != nil {
+			return err
+		}
+		return failed
+	})
+	if !errors.Is(err, failed) || count() != 1 {
+		t.Errorf("expected the entry to be rolled back, got %d entries, %v", count(), err)
+	}
+}
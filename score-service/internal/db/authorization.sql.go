@@ -0,0 +1,125 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: authorization.sql
+
+package db
+
+import (
+	"context"
+)
+
+const addCoachAthlete = `-- name: AddCoachAthlete :one
+INSERT INTO coach_athletes (coach_user_id, athlete_user_id, granted_by)
+VALUES ($1, $2, 'admin')
+ON CONFLICT (coach_user_id, athlete_user_id) DO UPDATE
+SET granted_by = EXCLUDED.granted_by
+RETURNING coach_user_id, athlete_user_id, created_at, granted_by
+`
+
+type AddCoachAthleteParams struct {
+	CoachUserID   string `json:"coach_user_id"`
+	AthleteUserID string `json:"athlete_user_id"`
+}
+
+// Grants access as an admin, which revoking an invitation leaves in place.
+func (q *Queries) AddCoachAthlete(ctx context.Context, arg AddCoachAthleteParams) (CoachAthlete, error) {
+	row := q.db.QueryRow(ctx, addCoachAthlete, arg.CoachUserID, arg.AthleteUserID)
+	var i CoachAthlete
+	err := row.Scan(
+		&i.CoachUserID,
+		&i.AthleteUserID,
+		&i.CreatedAt,
+		&i.GrantedBy,
+	)
+	return i, err
+}
+
+const isCoachOf = `-- name: IsCoachOf :one
+SELECT EXISTS (
+    SELECT 1 FROM coach_athletes
+    WHERE coach_user_id = $1 AND athlete_user_id = $2
+)
+`
+
+type IsCoachOfParams struct {
+	CoachUserID   string `json:"coach_user_id"`
+	AthleteUserID string `json:"athlete_user_id"`
+}
+
+func (q *Queries) IsCoachOf(ctx context.Context, arg IsCoachOfParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isCoachOf, arg.CoachUserID, arg.AthleteUserID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listAthletesForCoach = `-- name: ListAthletesForCoach :many
+SELECT coach_user_id, athlete_user_id, created_at, granted_by FROM coach_athletes WHERE coach_user_id = $1 ORDER BY created_at
+`
+
+func (q *Queries) ListAthletesForCoach(ctx context.Context, coachUserID string) ([]CoachAthlete, error) {
+	rows, err := q.db.Query(ctx, listAthletesForCoach, coachUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CoachAthlete{}
+	for rows.Next() {
+		var i CoachAthlete
+		if err := rows.Scan(
+			&i.CoachUserID,
+			&i.AthleteUserID,
+			&i.CreatedAt,
+			&i.GrantedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserRoles = `-- name: ListUserRoles :many
+SELECT role FROM user_roles WHERE external_user_id = $1 ORDER BY role
+`
+
+func (q *Queries) ListUserRoles(ctx context.Context, externalUserID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listUserRoles, externalUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		items = append(items, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeCoachAthlete = `-- name: RemoveCoachAthlete :execrows
+DELETE FROM coach_athletes WHERE coach_user_id = $1 AND athlete_user_id = $2
+`
+
+type RemoveCoachAthleteParams struct {
+	CoachUserID   string `json:"coach_user_id"`
+	AthleteUserID string `json:"athlete_user_id"`
+}
+
+func (q *Queries) RemoveCoachAthlete(ctx context.Context, arg RemoveCoachAthleteParams) (int64, error) {
+	result, err := q.db.Exec(ctx, removeCoachAthlete, arg.CoachUserID, arg.AthleteUserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
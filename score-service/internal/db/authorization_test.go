@@ -0,0 +1,103 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"testing"
+)
+
+func (f *fixture) isCoachOf(coach, athlete string) bool {
+	f.t.Helper()
+	ok, err := f.q.IsCoachOf(f.ctx, db.IsCoachOfParams{CoachUserID: coach, AthleteUserID: athlete})
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return ok
+}
+
+func TestListUserRoles(t *testing.T) {
+	f := newFixture(t)
+	f.exec(`INSERT INTO user_roles (external_user_id, role) VALUES ('head', 'coach'), ('head', 'admin'), ('coach', 'coach')`)
+
+	tests := []struct {
+		user string
+		want []string
+	}{
+		{"head", []string{"admin", "coach"}},
+		{"coach", []string{"coach"}},
+		{"archer", []string{}},
+	}
+	for _, tt := range tests {
+		roles, err := f.q.ListUserRoles(f.ctx, tt.user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(roles) != len(tt.want) {
+			t.Errorf("%s: got roles %v, want %v", tt.user, roles, tt.want)
+			continue
+		}
+		for i := range roles {
+			if roles[i] != tt.want[i] {
+				t.Errorf("%s: got roles %v, want %v", tt.user, roles, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestCoachAthletes(t *testing.T) {
+	f := newFixture(t)
+	add := func(coach, athlete string) db.CoachAthlete {
+		ca, err := f.q.AddCoachAthlete(f.ctx, db.AddCoachAthleteParams{CoachUserID: coach, AthleteUserID: athlete})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ca
+	}
+
+	first := add("coach", "archer")
+	if first.GrantedBy != "admin" {
+		t.Errorf("GrantedBy = %q, want admin", first.GrantedBy)
+	}
+	add("coach", "other")
+	add("assistant", "archer")
+	// Adding a relationship again keeps the original.
+	if again := add("coach", "archer"); !again.CreatedAt.Equal(first.CreatedAt) {
+		t.Errorf("expected the relationship to be kept, created %v then %v", first.CreatedAt, again.CreatedAt)
+	}
+
+	if !f.isCoachOf("coach", "archer") || f.isCoachOf("archer", "coach") || f.isCoachOf("assistant", "other") {
+		t.Error("IsCoachOf does not follow the relationships added")
+	}
+
+	athletes, err := f.q.ListAthletesForCoach(f.ctx, "coach")
+	if err != nil || len(athletes) != 2 || athletes[0].AthleteUserID != "archer" || athletes[1].AthleteUserID != "other" {
+		t.Errorf("ListAthletesForCoach = %+v, %v", athletes, err)
+	}
+	coaches, err := f.q.ListCoachesForAthlete(f.ctx, "archer")
+	if err != nil || len(coaches) != 2 || coaches[0].CoachUserID != "coach" || coaches[1].CoachUserID != "assistant" {
+		t.Errorf("ListCoachesForAthlete = %+v, %v", coaches, err)
+	}
+
+	// Nobody coaches themselves.
+	_, err = f.q.AddCoachAthlete(f.ctx, db.AddCoachAthleteParams{CoachUserID: "archer", AthleteUserID: "archer"})
+	expectCode(t, err, "23514")
+
+	tests := []struct {
+		name           string
+		coach, athlete string
+		rows           int64
+	}{
+		{"removed", "coach", "archer", 1},
+		{"already removed", "coach", "archer", 0},
+		{"reversed", "archer", "assistant", 0},
+	}
+	for _, tt := range tests {
+		rows, err := f.q.RemoveCoachAthlete(f.ctx, db.RemoveCoachAthleteParams{CoachUserID: tt.coach, AthleteUserID: tt.athlete})
+		if err != nil || rows != tt.rows {
+			t.Errorf("%s: RemoveCoachAthlete = %d, %v, want %d", tt.name, rows, err, tt.rows)
+		}
+	}
+	if f.isCoachOf("coach", "archer") || !f.isCoachOf("coach", "other") {
+		t.Error("expected only the removed relationship to be gone")
+	}
+}
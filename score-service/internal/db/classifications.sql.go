@@ -0,0 +1,194 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: classifications.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getArcherClassifications = `-- name: GetArcherClassifications :many
+SELECT id, external_user_id, round_id, rule_id, classification, rank, bow_class, age_category, score, achieved_at FROM archer_classifications
+WHERE external_user_id = $1
+ORDER BY achieved_at DESC
+`
+
+func (q *Queries) GetArcherClassifications(ctx context.Context, externalUserID string) ([]ArcherClassification, error) {
+	rows, err := q.db.Query(ctx, getArcherClassifications, externalUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ArcherClassification{}
+	for rows.Next() {
+		var i ArcherClassification
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExternalUserID,
+			&i.RoundID,
+			&i.RuleID,
+			&i.Classification,
+			&i.Rank,
+			&i.BowClass,
+			&i.AgeCategory,
+			&i.Score,
+			&i.AchievedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBestClassificationRule = `-- name: GetBestClassificationRule :one
+SELECT id, round_template_id, age_category, gender, bow_class, classification, rank, min_score, created_at, updated_at FROM classification_rules
+WHERE round_template_id = $1
+  AND age_category = $2
+  AND gender = $3
+  AND bow_class = $4
+  AND min_score <= $5::INTEGER
+ORDER BY rank
+LIMIT 1
+`
+
+type GetBestClassificationRuleParams struct {
+	RoundTemplateID uuid.UUID `json:"round_template_id"`
+	AgeCategory     string    `json:"age_category"`
+	Gender          string    `json:"gender"`
+	BowClass        string    `json:"bow_class"`
+	Score           int32     `json:"score"`
+}
+
+func (q *Queries) GetBestClassificationRule(ctx context.Context, arg GetBestClassificationRuleParams) (ClassificationRule, error) {
+	row := q.db.QueryRow(ctx, getBestClassificationRule,
+		arg.RoundTemplateID,
+		arg.AgeCategory,
+		arg.Gender,
+		arg.BowClass,
+		arg.Score,
+	)
+	var i ClassificationRule
+	err := row.Scan(
+		&i.ID,
+		&i.RoundTemplateID,
+		&i.AgeCategory,
+		&i.Gender,
+		&i.BowClass,
+		&i.Classification,
+		&i.Rank,
+		&i.MinScore,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listClassificationRules = `-- name: ListClassificationRules :many
+SELECT id, round_template_id, age_category, gender, bow_class, classification, rank, min_score, created_at, updated_at FROM classification_rules
+WHERE ($1::UUID IS NULL OR round_template_id = $1::UUID)
+  AND ($2::VARCHAR IS NULL OR bow_class = $2::VARCHAR)
+ORDER BY round_template_id, age_category, gender, bow_class, rank
+`
+
+type ListClassificationRulesParams struct {
+	RoundTemplateID pgtype.UUID `json:"round_template_id"`
+	BowClass        pgtype.Text `json:"bow_class"`
+}
+
+func (q *Queries) ListClassificationRules(ctx context.Context, arg ListClassificationRulesParams) ([]ClassificationRule, error) {
+	rows, err := q.db.Query(ctx, listClassificationRules, arg.RoundTemplateID, arg.BowClass)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ClassificationRule{}
+	for rows.Next() {
+		var i ClassificationRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.RoundTemplateID,
+			&i.AgeCategory,
+			&i.Gender,
+			&i.BowClass,
+			&i.Classification,
+			&i.Rank,
+			&i.MinScore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertArcherClassification = `-- name: UpsertArcherClassification :one
+INSERT INTO archer_classifications (
+    external_user_id,
+    round_id,
+    rule_id,
+    classification,
+    rank,
+    bow_class,
+    age_category,
+    score
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (round_id) DO UPDATE
+SET rule_id = EXCLUDED.rule_id,
+    classification = EXCLUDED.classification,
+    rank = EXCLUDED.rank,
+    score = EXCLUDED.score,
+    achieved_at = NOW()
+    RETURNING id, external_user_id, round_id, rule_id, classification, rank, bow_class, age_category, score, achieved_at
+`
+
+type UpsertArcherClassificationParams struct {
+	ExternalUserID string    `json:"external_user_id"`
+	RoundID        uuid.UUID `json:"round_id"`
+	RuleID         uuid.UUID `json:"rule_id"`
+	Classification string    `json:"classification"`
+	Rank           int32     `json:"rank"`
+	BowClass       string    `json:"bow_class"`
+	AgeCategory    string    `json:"age_category"`
+	Score          int32     `json:"score"`
+}
+
+func (q *Queries) UpsertArcherClassification(ctx context.Context, arg UpsertArcherClassificationParams) (ArcherClassification, error) {
+	row := q.db.QueryRow(ctx, upsertArcherClassification,
+		arg.ExternalUserID,
+		arg.RoundID,
+		arg.RuleID,
+		arg.Classification,
+		arg.Rank,
+		arg.BowClass,
+		arg.AgeCategory,
+		arg.Score,
+	)
+	var i ArcherClassification
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalUserID,
+		&i.RoundID,
+		&i.RuleID,
+		&i.Classification,
+		&i.Rank,
+		&i.BowClass,
+		&i.AgeCategory,
+		&i.Score,
+		&i.AchievedAt,
+	)
+	return i, err
+}
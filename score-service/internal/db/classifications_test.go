@@ -0,0 +1,131 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestListClassificationRules(t *testing.T) {
+	f := newFixture(t)
+	wa70 := f.template("WA 70m")
+
+	// The migrations seed adult recurve rules for WA 70m only.
+	tests := []struct {
+		name   string
+		params db.ListClassificationRulesParams
+		count  int
+	}{
+		{"all", db.ListClassificationRulesParams{}, 18},
+		{"by template", db.ListClassificationRulesParams{RoundTemplateID: pgID(wa70.ID)}, 18},
+		{"other template", db.ListClassificationRulesParams{RoundTemplateID: pgID(f.template("WA 30m").ID)}, 0},
+		{"by bow class", db.ListClassificationRulesParams{BowClass: text("recurve")}, 18},
+		{"other bow class", db.ListClassificationRulesParams{BowClass: text("compound")}, 0},
+	}
+	for _, tt := range tests {
+		rules, err := f.q.ListClassificationRules(f.ctx, tt.params)
+		if err != nil || len(rules) != tt.count {
+			t.Errorf("%s: got %d rules, %v, want %d", tt.name, len(rules), err, tt.count)
+		}
+	}
+
+	// Ordered by gender, then from the highest classification down.
+	rules, err := f.q.ListClassificationRules(f.ctx, db.ListClassificationRulesParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules[0].Gender != "female" || rules[0].Rank != 1 || rules[8].Rank != 9 || rules[9].Gender != "male" {
+		t.Errorf("unexpected order: first %+v, tenth %+v", rules[0], rules[9])
+	}
+}
+
+func TestGetBestClassificationRule(t *testing.T) {
+	f := newFixture(t)
+	wa70 := f.template("WA 70m")
+
+	tests := []struct {
+		name           string
+		gender         string
+		score          int32
+		classification string // empty for none
+	}{
+		{"exactly the threshold", "male", 602, "Master Bowman"},
+		{"between thresholds", "male", 601, "Bowman 1st Class"},
+		{"top", "male", 720, "Elite Master Bowman"},
+		{"female thresholds", "female", 602, "Grand Master Bowman"},
+		{"below every threshold", "male", 193, ""},
+	}
+	for _, tt := range tests {
+		rule, err := f.q.GetBestClassificationRule(f.ctx, db.GetBestClassificationRuleParams{
+			RoundTemplateID: wa70.ID,
+			AgeCategory:     "adult",
+			Gender:          tt.gender,
+			BowClass:        "recurve",
+			Score:           tt.score,
+		})
+		if tt.classification == "" {
+			expectNoRows(t, err)
+			continue
+		}
+		if err != nil || rule.Classification != tt.classification {
+			t.Errorf("%s: got %q, %v, want %q", tt.name, rule.Classification, err, tt.classification)
+		}
+	}
+}
+
+func TestArcherClassifications(t *testing.T) {
+	f := newFixture(t)
+	wa70 := f.template("WA 70m")
+	rule := func(gender string, score int32) db.ClassificationRule {
+		r, err := f.q.GetBestClassificationRule(f.ctx, db.GetBestClassificationRuleParams{
+			RoundTemplateID: wa70.ID, AgeCategory: "adult", Gender: gender, BowClass: "recurve", Score: score,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	upsert := func(round db.QualificationRound, r db.ClassificationRule, score int32) db.ArcherClassification {
+		c, err := f.q.UpsertArcherClassification(f.ctx, db.UpsertArcherClassificationParams{
+			ExternalUserID: round.ExternalUserID,
+			RoundID:        round.ID,
+			RuleID:         r.ID,
+			Classification: r.Classification,
+			Rank:           r.Rank,
+			BowClass:       r.BowClass,
+			AgeCategory:    r.AgeCategory,
+			Score:          score,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	withTemplate := func(p *db.CreateQualificationRoundParams) {
+		p.RoundTemplateID, p.Gender = pgtype.UUID{Bytes: wa70.ID, Valid: true}, text("male")
+	}
+
+	first := f.round("archer", wa122, withTemplate)
+	second := f.round("archer", wa122, withTemplate)
+	f.round("other", wa122, withTemplate)
+
+	upsert(first, rule("male", 500), 500)
+	upsert(second, rule("male", 560), 560)
+	// Rescoring the first round replaces its classification.
+	rescored := upsert(first, rule("male", 610), 610)
+	if rescored.Classification != "Master Bowman" || rescored.Score != 610 || rescored.RoundID != first.ID {
+		t.Errorf("classification not replaced: %+v", rescored)
+	}
+
+	got, err := f.q.GetArcherClassifications(f.ctx, "archer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].RoundID != first.ID || got[1].RoundID != second.ID {
+		t.Errorf("expected one classification per round, most recent first, got %+v", got)
+	}
+	if none, err := f.q.GetArcherClassifications(f.ctx, "other"); err != nil || len(none) != 0 {
+		t.Errorf("expected no classifications for other, got %d, %v", len(none), err)
+	}
+}
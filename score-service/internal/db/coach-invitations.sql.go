@@ -0,0 +1,348 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: coach-invitations.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const acceptCoachInvitation = `-- name: AcceptCoachInvitation :one
+WITH accepted AS (
+    UPDATE coach_invitations
+    SET status = 'accepted',
+        coach_user_id = $1,
+        responded_at = NOW()
+    WHERE status = 'pending'
+      AND expires_at > NOW()
+      AND athlete_user_id <> $1
+      AND (
+          (id = $2 AND coach_user_id = $1)
+          OR (invite_code = $3 AND coach_user_id IS NULL)
+      )
+    RETURNING id, athlete_user_id, coach_user_id, invite_code, status, expires_at, responded_at, created_at, updated_at
+), granted AS (
+    INSERT INTO coach_athletes (coach_user_id, athlete_user_id, granted_by)
+    SELECT coach_user_id, athlete_user_id, 'invitation' FROM accepted
+    ON CONFLICT (coach_user_id, athlete_user_id) DO NOTHING
+)
+SELECT id, athlete_user_id, coach_user_id, invite_code, status, expires_at, responded_at, created_at, updated_at FROM accepted
+`
+
+type AcceptCoachInvitationParams struct {
+	CoachUserID pgtype.Text `json:"coach_user_id"`
+	ID          pgtype.UUID `json:"id"`
+	InviteCode  pgtype.Text `json:"invite_code"`
+}
+
+type AcceptCoachInvitationRow struct {
+	ID            uuid.UUID          `json:"id"`
+	AthleteUserID string             `json:"athlete_user_id"`
+	CoachUserID   pgtype.Text        `json:"coach_user_id"`
+	InviteCode    pgtype.Text        `json:"invite_code"`
+	Status        string             `json:"status"`
+	ExpiresAt     time.Time          `json:"expires_at"`
+	RespondedAt   pgtype.Timestamptz `json:"responded_at"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+// Accepts a pending, unexpired invitation addressed to the coach, or redeems
+// an invite code, and grants the coach access to the athlete in one statement.
+// Access an admin already granted stays theirs.
+func (q *Queries) AcceptCoachInvitation(ctx context.Context, arg AcceptCoachInvitationParams) (AcceptCoachInvitationRow, error) {
+	row := q.db.QueryRow(ctx, acceptCoachInvitation, arg.CoachUserID, arg.ID, arg.InviteCode)
+	var i AcceptCoachInvitationRow
+	err := row.Scan(
+		&i.ID,
+		&i.AthleteUserID,
+		&i.CoachUserID,
+		&i.InviteCode,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createCoachInvitation = `-- name: CreateCoachInvitation :one
+INSERT INTO coach_invitations (
+    athlete_user_id,
+    coach_user_id,
+    invite_code
+) VALUES ($1, $2, $3)
+RETURNING id, athlete_user_id, coach_user_id, invite_code, status, expires_at, responded_at, created_at, updated_at
+`
+
+type CreateCoachInvitationParams struct {
+	AthleteUserID string      `json:"athlete_user_id"`
+	CoachUserID   pgtype.Text `json:"coach_user_id"`
+	InviteCode    pgtype.Text `json:"invite_code"`
+}
+
+func (q *Queries) CreateCoachInvitation(ctx context.Context, arg CreateCoachInvitationParams) (CoachInvitation, error) {
+	row := q.db.QueryRow(ctx, createCoachInvitation, arg.AthleteUserID, arg.CoachUserID, arg.InviteCode)
+	var i CoachInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.AthleteUserID,
+		&i.CoachUserID,
+		&i.InviteCode,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const declineCoachInvitation = `-- name: DeclineCoachInvitation :one
+UPDATE coach_invitations
+SET status = 'declined',
+    responded_at = NOW()
+WHERE id = $1 AND coach_user_id = $2 AND status = 'pending'
+RETURNING id, athlete_user_id, coach_user_id, invite_code, status, expires_at, responded_at, created_at, updated_at
+`
+
+type DeclineCoachInvitationParams struct {
+	ID          uuid.UUID   `json:"id"`
+	CoachUserID pgtype.Text `json:"coach_user_id"`
+}
+
+func (q *Queries) DeclineCoachInvitation(ctx context.Context, arg DeclineCoachInvitationParams) (CoachInvitation, error) {
+	row := q.db.QueryRow(ctx, declineCoachInvitation, arg.ID, arg.CoachUserID)
+	var i CoachInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.AthleteUserID,
+		&i.CoachUserID,
+		&i.InviteCode,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getCoachInvitation = `-- name: GetCoachInvitation :one
+SELECT id, athlete_user_id, coach_user_id, invite_code, status, expires_at, responded_at, created_at, updated_at FROM coach_invitations WHERE id = $1
+`
+
+func (q *Queries) GetCoachInvitation(ctx context.Context, id uuid.UUID) (CoachInvitation, error) {
+	row := q.db.QueryRow(ctx, getCoachInvitation, id)
+	var i CoachInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.AthleteUserID,
+		&i.CoachUserID,
+		&i.InviteCode,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listCoachInvitationsForAthlete = `-- name: ListCoachInvitationsForAthlete :many
+SELECT id, athlete_user_id, coach_user_id, invite_code, status, expires_at, responded_at, created_at, updated_at FROM coach_invitations WHERE athlete_user_id = $1 ORDER BY created_at DESC
+`
+
+func (q *Queries) ListCoachInvitationsForAthlete(ctx context.Context, athleteUserID string) ([]CoachInvitation, error) {
+	rows, err := q.db.Query(ctx, listCoachInvitationsForAthlete, athleteUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CoachInvitation{}
+	for rows.Next() {
+		var i CoachInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.AthleteUserID,
+			&i.CoachUserID,
+			&i.InviteCode,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.RespondedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCoachInvitationsForCoach = `-- name: ListCoachInvitationsForCoach :many
+SELECT id, athlete_user_id, coach_user_id, invite_code, status, expires_at, responded_at, created_at, updated_at FROM coach_invitations WHERE coach_user_id = $1 ORDER BY created_at DESC
+`
+
+func (q *Queries) ListCoachInvitationsForCoach(ctx context.Context, coachUserID pgtype.Text) ([]CoachInvitation, error) {
+	rows, err := q.db.Query(ctx, listCoachInvitationsForCoach, coachUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CoachInvitation{}
+	for rows.Next() {
+		var i CoachInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.AthleteUserID,
+			&i.CoachUserID,
+			&i.InviteCode,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.RespondedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCoachesForAthlete = `-- name: ListCoachesForAthlete :many
+SELECT coach_user_id, athlete_user_id, created_at, granted_by FROM coach_athletes WHERE athlete_user_id = $1 ORDER BY created_at
+`
+
+func (q *Queries) ListCoachesForAthlete(ctx context.Context, athleteUserID string) ([]CoachAthlete, error) {
+	rows, err := q.db.Query(ctx, listCoachesForAthlete, athleteUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CoachAthlete{}
+	for rows.Next() {
+		var i CoachAthlete
+		if err := rows.Scan(
+			&i.CoachUserID,
+			&i.AthleteUserID,
+			&i.CreatedAt,
+			&i.GrantedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeCoach = `-- name: RevokeCoach :execrows
+WITH revoked AS (
+    UPDATE coach_invitations ci
+    SET status = 'revoked',
+        responded_at = NOW()
+    WHERE ci.athlete_user_id = $1
+      AND ci.coach_user_id = $2
+      AND ci.status = 'accepted'
+)
+DELETE FROM coach_athletes ca
+WHERE ca.athlete_user_id = $1 AND ca.coach_user_id = $2
+`
+
+type RevokeCoachParams struct {
+	AthleteUserID string `json:"athlete_user_id"`
+	CoachUserID   string `json:"coach_user_id"`
+}
+
+// Removes a coach's access to the athlete and revokes the invitations that granted it.
+func (q *Queries) RevokeCoach(ctx context.Context, arg RevokeCoachParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeCoach, arg.AthleteUserID, arg.CoachUserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeCoachInvitation = `-- name: RevokeCoachInvitation :one
+WITH revoked AS (
+    UPDATE coach_invitations ci
+    SET status = 'revoked',
+        responded_at = NOW()
+    FROM coach_invitations previous
+    WHERE previous.id = ci.id
+      AND ci.id = $1
+      AND ci.athlete_user_id = $2
+      AND ci.status IN ('pending', 'accepted')
+    RETURNING ci.id, ci.athlete_user_id, ci.coach_user_id, ci.invite_code, ci.status, ci.expires_at, ci.responded_at, ci.created_at, ci.updated_at, previous.status AS previous_status
+), revoked_access AS (
+    DELETE FROM coach_athletes ca
+    USING revoked r
+    WHERE r.previous_status = 'accepted'
+      AND ca.coach_user_id = r.coach_user_id
+      AND ca.athlete_user_id = r.athlete_user_id
+      AND ca.granted_by = 'invitation'
+      AND NOT EXISTS (
+        SELECT 1 FROM coach_invitations other
+        WHERE other.id <> r.id
+          AND other.coach_user_id = r.coach_user_id
+          AND other.athlete_user_id = r.athlete_user_id
+          AND other.status = 'accepted'
+    )
+)
+SELECT id, athlete_user_id, coach_user_id, invite_code, status, expires_at, responded_at, created_at, updated_at
+FROM revoked
+`
+
+type RevokeCoachInvitationParams struct {
+	ID            uuid.UUID `json:"id"`
+	AthleteUserID string    `json:"athlete_user_id"`
+}
+
+type RevokeCoachInvitationRow struct {
+	ID            uuid.UUID          `json:"id"`
+	AthleteUserID string             `json:"athlete_user_id"`
+	CoachUserID   pgtype.Text        `json:"coach_user_id"`
+	InviteCode    pgtype.Text        `json:"invite_code"`
+	Status        string             `json:"status"`
+	ExpiresAt     time.Time          `json:"expires_at"`
+	RespondedAt   pgtype.Timestamptz `json:"responded_at"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+// Revokes a pending or accepted invitation and the access it granted, unless
+// an admin granted the access or another accepted invitation still does.
+// The self-join exposes the status from before the update.
+func (q *Queries) RevokeCoachInvitation(ctx context.Context, arg RevokeCoachInvitationParams) (RevokeCoachInvitationRow, error) {
+	row := q.db.QueryRow(ctx, revokeCoachInvitation, arg.ID, arg.AthleteUserID)
+	var i RevokeCoachInvitationRow
+	err := row.Scan(
+		&i.ID,
+		&i.AthleteUserID,
+		&i.CoachUserID,
+		&i.InviteCode,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,271 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (f *fixture) invite(athlete string, coach, code pgtype.Text) db.CoachInvitation {
+	f.t.Helper()
+	inv, err := f.q.CreateCoachInvitation(f.ctx, db.CreateCoachInvitationParams{
+		AthleteUserID: athlete,
+		CoachUserID:   coach,
+		InviteCode:    code,
+	})
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	return inv
+}
+
+func TestCoachInvitations(t *testing.T) {
+	f := newFixture(t)
+
+	direct := f.invite("archer", text("coach"), pgtype.Text{})
+	if direct.Status != "pending" || direct.RespondedAt.Valid || !direct.ExpiresAt.After(direct.CreatedAt) {
+		t.Errorf("expected a pending invitation, got %+v", direct)
+	}
+	code := f.invite("archer", pgtype.Text{}, text("ABCD1234"))
+	f.invite("other", text("coach"), pgtype.Text{})
+
+	if got, err := f.q.GetCoachInvitation(f.ctx, code.ID); err != nil || got.InviteCode != text("ABCD1234") {
+		t.Errorf("GetCoachInvitation = %+v, %v", got, err)
+	}
+	_, err := f.q.GetCoachInvitation(f.ctx, uuid.New())
+	expectNoRows(t, err)
+
+	forAthlete, err := f.q.ListCoachInvitationsForAthlete(f.ctx, "archer")
+	if err != nil || len(forAthlete) != 2 || forAthlete[0].ID != code.ID || forAthlete[1].ID != direct.ID {
+		t.Errorf("ListCoachInvitationsForAthlete = %+v, %v, want newest first", forAthlete, err)
+	}
+	forCoach, err := f.q.ListCoachInvitationsForCoach(f.ctx, text("coach"))
+	if err != nil || len(forCoach) != 2 {
+		t.Errorf("ListCoachInvitationsForCoach = %+v, %v", forCoach, err)
+	}
+
+	invalid := []struct {
+		name   string
+		params db.CreateCoachInvitationParams
+		code   string
+	}{
+		{"no coach or code", db.CreateCoachInvitationParams{AthleteUserID: "archer"}, "23514"},
+		{"self", db.CreateCoachInvitationParams{AthleteUserID: "archer", CoachUserID: text("archer")}, "23514"},
+		{"duplicate code", db.CreateCoachInvitationParams{AthleteUserID: "other", InviteCode: text("ABCD1234")}, "23505"},
+	}
+	for _, tt := range invalid {
+		_, err := f.q.CreateCoachInvitation(f.ctx, tt.params)
+		expectCode(t, err, tt.code)
+	}
+}
+
+func TestAcceptCoachInvitation(t *testing.T) {
+	f := newFixture(t)
+	direct := f.invite("archer", text("coach"), pgtype.Text{})
+	code := f.invite("other", pgtype.Text{}, text("REDEEM01"))
+	expired := f.invite("third", text("coach"), pgtype.Text{})
+	f.exec(`UPDATE coach_invitations SET expires_at = NOW() - INTERVAL '1 day' WHERE id = $1`, expired.ID)
+
+	tests := []struct {
+		name   string
+		params db.AcceptCoachInvitationParams
+		want   uuid.UUID // uuid.Nil for no rows
+	}{
+		{"someone else's invitation", db.AcceptCoachInvitationParams{ID: pgID(direct.ID), CoachUserID: text("assistant")}, uuid.Nil},
+		{"by id", db.AcceptCoachInvitationParams{ID: pgID(direct.ID), CoachUserID: text("coach")}, direct.ID},
+		{"already accepted", db.AcceptCoachInvitationParams{ID: pgID(direct.ID), CoachUserID: text("coach")}, uuid.Nil},
+		{"expired", db.AcceptCoachInvitationParams{ID: pgID(expired.ID), CoachUserID: text("coach")}, uuid.Nil},
+		{"own code", db.AcceptCoachInvitationParams{InviteCode: text("REDEEM01"), CoachUserID: text("other")}, uuid.Nil},
+		{"unknown code", db.AcceptCoachInvitationParams{InviteCode: text("UNKNOWN0"), CoachUserID: text("assistant")}, uuid.Nil},
+		{"by code", db.AcceptCoachInvitationParams{InviteCode: text("REDEEM01"), CoachUserID: text("assistant")}, code.ID},
+		{"code redeemed", db.AcceptCoachInvitationParams{InviteCode: text("REDEEM01"), CoachUserID: text("coach")}, uuid.Nil},
+	}
+	for _, tt := range tests {
+		row, err := f.q.AcceptCoachInvitation(f.ctx, tt.params)
+		if tt.want == uuid.Nil {
+			expectNoRows(t, err)
+			continue
+		}
+		if err != nil || row.ID != tt.want || row.Status != "accepted" || !row.RespondedAt.Valid {
+			t.Errorf("%s: AcceptCoachInvitation = %+v, %v", tt.name, row, err)
+		}
+	}
+
+	// Accepting grants access in the same statement.
+	if !f.isCoachOf("coach", "archer") || !f.isCoachOf("assistant", "other") || f.isCoachOf("coach", "third") {
+		t.Error("expected access for the accepted invitations only")
+	}
+	if got, err := f.q.GetCoachInvitation(f.ctx, code.ID); err != nil || got.CoachUserID != text("assistant") {
+		t.Errorf("expected the redeeming coach on the invitation, got %+v, %v", got, err)
+	}
+}
+
+func TestDeclineCoachInvitation(t *testing.T) {
+	f := newFixture(t)
+	inv := f.invite("archer", text("coach"), pgtype.Text{})
+
+	_, err := f.q.DeclineCoachInvitation(f.ctx, db.DeclineCoachInvitationParams{ID: inv.ID, CoachUserID: text("assistant")})
+	expectNoRows(t, err)
+
+	declined, err := f.q.DeclineCoachInvitation(f.ctx, db.DeclineCoachInvitationParams{ID: inv.ID, CoachUserID: text("coach")})
+	if err != nil || declined.Status != "declined" || !declined.RespondedAt.Valid {
+		t.Fatalf("DeclineCoachInvitation = %+v, %v", declined, err)
+	}
+	_, err = f.q.DeclineCoachInvitation(f.ctx, db.DeclineCoachInvitationParams{ID: inv.ID, CoachUserID: text("coach")})
+	expectNoRows(t, err)
+
+	// A declined invitation can no longer be accepted.
+	_, err = f.q.AcceptCoachInvitation(f.ctx, db.AcceptCoachInvitationParams{ID: pgID(inv.ID), CoachUserID: text("coach")})
+	expectNoRows(t, err)
+	if f.isCoachOf("coach", "archer") {
+		t.Error("declining granted access")
+	}
+}
+
+func TestRevokeCoachInvitation(t *testing.T) {
+	f := newFixture(t)
+	pending := f.invite("archer", text("coach"), pgtype.Text{})
+	accepted := f.invite("archer", text("assistant"), pgtype.Text{})
+	if _, err := f.q.AcceptCoachInvitation(f.ctx, db.AcceptCoachInvitationParams{
+		ID: pgID(accepted.ID), CoachUserID: text("assistant"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// Access granted outside the invitation is left alone.
+	if _, err := f.q.AddCoachAthlete(f.ctx, db.AddCoachAthleteParams{CoachUserID: "coach", AthleteUserID: "archer"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		id      uuid.UUID
+		athlete string
+		revoked bool
+	}{
+		{"another athlete", pending.ID, "other", false},
+		{"pending", pending.ID, "archer", true},
+		{"already revoked", pending.ID, "archer", false},
+		{"accepted", accepted.ID, "archer", true},
+	}
+	for _, tt := range tests {
+		row, err := f.q.RevokeCoachInvitation(f.ctx, db.RevokeCoachInvitationParams{ID: tt.id, AthleteUserID: tt.athlete})
+		if !tt.revoked {
+			expectNoRows(t, err)
+			continue
+		}
+		if err != nil || row.ID != tt.id || row.Status != "revoked" {
+			t.Errorf("%s: RevokeCoachInvitation = %+v, %v", tt.name, row, err)
+		}
+	}
+
+	if f.isCoachOf("assistant", "archer") {
+		t.Error("expected revoking the accepted invitation to remove access")
+	}
+	if !f.isCoachOf("coach", "archer") {
+		t.Error("expected revoking a pending invitation to keep existing access")
+	}
+}
+
+// Revoking an accepted invitation keeps access that an admin or another
+// accepted invitation granted.
+func (f *fixture) accept(athlete, coach string) db.CoachInvitation {
+	f.t.Helper()
+	inv := f.invite(athlete, text(coach), pgtype.Text{})
+	if _, err := f.q.AcceptCoachInvitation(f.ctx, db.AcceptCoachInvitationParams{
+		ID: pgID(inv.ID), CoachUserID: text(coach),
+	}); err != nil {
+		f.t.Fatal(err)
+	}
+	return inv
+}
+
+func TestRevokeCoachInvitation_KeepsOtherGrants(t *testing.T) {
+	f := newFixture(t)
+	revoke := func(inv db.CoachInvitation) {
+		t.Helper()
+		if _, err := f.q.RevokeCoachInvitation(f.ctx, db.RevokeCoachInvitationParams{
+			ID: inv.ID, AthleteUserID: inv.AthleteUserID,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	grantedBy := func(coach, athlete string) string {
+		t.Helper()
+		coaches, err := f.q.ListCoachesForAthlete(f.ctx, athlete)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range coaches {
+			if c.CoachUserID == coach {
+				return c.GrantedBy
+			}
+		}
+		return ""
+	}
+
+	// Granted by an admin before and after an invitation.
+	before := f.accept("archer", "coach")
+	if _, err := f.q.AddCoachAthlete(f.ctx, db.AddCoachAthleteParams{CoachUserID: "coach", AthleteUserID: "archer"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.q.AddCoachAthlete(f.ctx, db.AddCoachAthleteParams{CoachUserID: "coach", AthleteUserID: "other"}); err != nil {
+		t.Fatal(err)
+	}
+	after := f.accept("other", "coach")
+	if grantedBy("coach", "archer") != "admin" || grantedBy("coach", "other") != "admin" {
+		t.Errorf("expected admin grants to stay admin grants, got %q and %q",
+			grantedBy("coach", "archer"), grantedBy("coach", "other"))
+	}
+	revoke(before)
+	revoke(after)
+	if !f.isCoachOf("coach", "archer") || !f.isCoachOf("coach", "other") {
+		t.Error("expected revoking invitations to keep access granted by an admin")
+	}
+
+	// Granted by two invitations.
+	first, second := f.accept("third", "assistant"), f.accept("third", "assistant")
+	if got := grantedBy("assistant", "third"); got != "invitation" {
+		t.Errorf("granted_by = %q, want invitation", got)
+	}
+	revoke(first)
+	if !f.isCoachOf("assistant", "third") {
+		t.Error("expected the second accepted invitation to keep access")
+	}
+	revoke(second)
+	if f.isCoachOf("assistant", "third") {
+		t.Error("expected revoking the last accepted invitation to remove access")
+	}
+}
+
+func TestRevokeCoach(t *testing.T) {
+	f := newFixture(t)
+	inv := f.invite("archer", text("coach"), pgtype.Text{})
+	if _, err := f.q.AcceptCoachInvitation(f.ctx, db.AcceptCoachInvitationParams{
+		ID: pgID(inv.ID), CoachUserID: text("coach"),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	pending := f.invite("archer", text("coach"), pgtype.Text{})
+
+	rows, err := f.q.RevokeCoach(f.ctx, db.RevokeCoachParams{AthleteUserID: "archer", CoachUserID: "coach"})
+	if err != nil || rows != 1 {
+		t.Fatalf("RevokeCoach = %d, %v, want 1", rows, err)
+	}
+	if f.isCoachOf("coach", "archer") {
+		t.Error("expected access to be removed")
+	}
+	for _, tt := range []struct {
+		id     uuid.UUID
+		status string
+	}{{inv.ID, "revoked"}, {pending.ID, "pending"}} {
+		if got, err := f.q.GetCoachInvitation(f.ctx, tt.id); err != nil || got.Status != tt.status {
+			t.Errorf("invitation %s is %q, %v, want %q", tt.id, got.Status, err, tt.status)
+		}
+	}
+
+	if rows, err := f.q.RevokeCoach(f.ctx, db.RevokeCoachParams{AthleteUserID: "archer", CoachUserID: "coach"}); err != nil || rows != 0 {
+		t.Errorf("RevokeCoach again = %d, %v, want 0", rows, err)
+	}
+}
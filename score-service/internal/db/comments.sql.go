@@ -0,0 +1,203 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: comments.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createComment = `-- name: CreateComment :one
+INSERT INTO comments (
+    author_user_id,
+    round_id,
+    set_id,
+    shot_id,
+    body
+) VALUES ($1, $2, $3, $4, $5)
+RETURNING id, author_user_id, round_id, set_id, shot_id, body, created_at, updated_at, deleted_at
+`
+
+type CreateCommentParams struct {
+	AuthorUserID string      `json:"author_user_id"`
+	RoundID      uuid.UUID   `json:"round_id"`
+	SetID        pgtype.UUID `json:"set_id"`
+	ShotID       pgtype.UUID `json:"shot_id"`
+	Body         string      `json:"body"`
+}
+
+func (q *Queries) CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error) {
+	row := q.db.QueryRow(ctx, createComment,
+		arg.AuthorUserID,
+		arg.RoundID,
+		arg.SetID,
+		arg.ShotID,
+		arg.Body,
+	)
+	var i Comment
+	err := row.Scan(
+		&i.ID,
+		&i.AuthorUserID,
+		&i.RoundID,
+		&i.SetID,
+		&i.ShotID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const deleteComment = `-- name: DeleteComment :execrows
+UPDATE comments SET deleted_at = NOW()
+WHERE id = $1 AND author_user_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteCommentParams struct {
+	ID           uuid.UUID `json:"id"`
+	AuthorUserID string    `json:"author_user_id"`
+}
+
+func (q *Queries) DeleteComment(ctx context.Context, arg DeleteCommentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteComment, arg.ID, arg.AuthorUserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getComment = `-- name: GetComment :one
+SELECT id, author_user_id, round_id, set_id, shot_id, body, created_at, updated_at, deleted_at FROM comments WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetComment(ctx context.Context, id uuid.UUID) (Comment, error) {
+	row := q.db.QueryRow(ctx, getComment, id)
+	var i Comment
+	err := row.Scan(
+		&i.ID,
+		&i.AuthorUserID,
+		&i.RoundID,
+		&i.SetID,
+		&i.ShotID,
+		&i.Body,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const listCommentsForRound = `-- name: ListCommentsForRound :many
+SELECT id, author_user_id, round_id, set_id, shot_id, body, created_at, updated_at, deleted_at FROM comments
+WHERE round_id = $1 AND set_id IS NULL AND deleted_at IS NULL
+ORDER BY created_at
+`
+
+// Comments on the round itself, not on its sets or shots.
+func (q *Queries) ListCommentsForRound(ctx context.Context, roundID uuid.UUID) ([]Comment, error) {
+	rows, err := q.db.Query(ctx, listCommentsForRound, roundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Comment{}
+	for rows.Next() {
+		var i Comment
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorUserID,
+			&i.RoundID,
+			&i.SetID,
+			&i.ShotID,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommentsForSet = `-- name: ListCommentsForSet :many
+SELECT id, author_user_id, round_id, set_id, shot_id, body, created_at, updated_at, deleted_at FROM comments
+WHERE set_id = $1 AND shot_id IS NULL AND deleted_at IS NULL
+ORDER BY created_at
+`
+
+func (q *Queries) ListCommentsForSet(ctx context.Context, setID pgtype.UUID) ([]Comment, error) {
+	rows, err := q.db.Query(ctx, listCommentsForSet, setID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Comment{}
+	for rows.Next() {
+		var i Comment
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorUserID,
+			&i.RoundID,
+			&i.SetID,
+			&i.ShotID,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCommentsForShot = `-- name: ListCommentsForShot :many
+SELECT id, author_user_id, round_id, set_id, shot_id, body, created_at, updated_at, deleted_at FROM comments
+WHERE shot_id = $1 AND deleted_at IS NULL
+ORDER BY created_at
+`
+
+func (q *Queries) ListCommentsForShot(ctx context.Context, shotID pgtype.UUID) ([]Comment, error) {
+	rows, err := q.db.Query(ctx, listCommentsForShot, shotID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Comment{}
+	for rows.Next() {
+		var i Comment
+		if err := rows.Scan(
+			&i.ID,
+			&i.AuthorUserID,
+			&i.RoundID,
+			&i.SetID,
+			&i.ShotID,
+			&i.Body,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
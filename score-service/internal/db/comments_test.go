@@ -0,0 +1,90 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestComments(t *testing.T) {
+	f := newFixture(t)
+	round := f.round("archer", wa122)
+	set := f.set(round.ID, 1, 6)
+	shot := f.shoot(set.ID, [2]float64{0, 0})[0]
+	comment := func(author string, setID, shotID pgtype.UUID, body string) db.Comment {
+		c, err := f.q.CreateComment(f.ctx, db.CreateCommentParams{
+			AuthorUserID: author, RoundID: round.ID, SetID: setID, ShotID: shotID, Body: body,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+
+	onRound := comment("coach", pgtype.UUID{}, pgtype.UUID{}, "Good round")
+	reply := comment("archer", pgtype.UUID{}, pgtype.UUID{}, "Thanks")
+	onSet := comment("coach", pgID(set.ID), pgtype.UUID{}, "Tight end")
+	onShot := comment("coach", pgID(set.ID), pgID(shot.ID), "Perfect")
+
+	if got, err := f.q.GetComment(f.ctx, onShot.ID); err != nil || got.Body != "Perfect" || got.ShotID != pgID(shot.ID) {
+		t.Errorf("GetComment = %+v, %v", got, err)
+	}
+
+	// Each level lists its own comments only, oldest first.
+	lists := []struct {
+		name string
+		list func() ([]db.Comment, error)
+		want []uuid.UUID
+	}{
+		{"round", func() ([]db.Comment, error) { return f.q.ListCommentsForRound(f.ctx, round.ID) }, []uuid.UUID{onRound.ID, reply.ID}},
+		{"set", func() ([]db.Comment, error) { return f.q.ListCommentsForSet(f.ctx, pgID(set.ID)) }, []uuid.UUID{onSet.ID}},
+		{"shot", func() ([]db.Comment, error) { return f.q.ListCommentsForShot(f.ctx, pgID(shot.ID)) }, []uuid.UUID{onShot.ID}},
+	}
+	check := func(when string) {
+		for _, l := range lists {
+			comments, err := l.list()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(comments) != len(l.want) {
+				t.Errorf("%s: %s has %d comments, want %d", when, l.name, len(comments), len(l.want))
+				continue
+			}
+			for i, c := range comments {
+				if c.ID != l.want[i] {
+					t.Errorf("%s: %s comment %d is %s, want %s", when, l.name, i, c.ID, l.want[i])
+				}
+			}
+		}
+	}
+	check("created")
+
+	// A shot comment must name its set.
+	_, err := f.q.CreateComment(f.ctx, db.CreateCommentParams{
+		AuthorUserID: "coach", RoundID: round.ID, ShotID: pgID(shot.ID), Body: "Orphan",
+	})
+	expectCode(t, err, "23514")
+
+	tests := []struct {
+		name   string
+		id     uuid.UUID
+		author string
+		rows   int64
+	}{
+		{"not the author", reply.ID, "coach", 0},
+		{"deleted", reply.ID, "archer", 1},
+		{"already deleted", reply.ID, "archer", 0},
+	}
+	for _, tt := range tests {
+		rows, err := f.q.DeleteComment(f.ctx, db.DeleteCommentParams{ID: tt.id, AuthorUserID: tt.author})
+		if err != nil || rows != tt.rows {
+			t.Errorf("%s: DeleteComment = %d, %v, want %d", tt.name, rows, err, tt.rows)
+		}
+	}
+	_, err = f.q.GetComment(f.ctx, reply.ID)
+	expectNoRows(t, err)
+	lists[0].want = []uuid.UUID{onRound.ID}
+	check("after delete")
+}
@@ -0,0 +1,197 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: handicaps.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const getArcherHandicap = `-- name: GetArcherHandicap :one
+SELECT external_user_id, bow_class, handicap, rounds_count, updated_at FROM archer_handicaps WHERE external_user_id = $1 AND bow_class = $2
+`
+
+type GetArcherHandicapParams struct {
+	ExternalUserID string `json:"external_user_id"`
+	BowClass       string `json:"bow_class"`
+}
+
+func (q *Queries) GetArcherHandicap(ctx context.Context, arg GetArcherHandicapParams) (ArcherHandicap, error) {
+	row := q.db.QueryRow(ctx, getArcherHandicap, arg.ExternalUserID, arg.BowClass)
+	var i ArcherHandicap
+	err := row.Scan(
+		&i.ExternalUserID,
+		&i.BowClass,
+		&i.Handicap,
+		&i.RoundsCount,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getArcherHandicaps = `-- name: GetArcherHandicaps :many
+SELECT external_user_id, bow_class, handicap, rounds_count, updated_at FROM archer_handicaps WHERE external_user_id = $1 ORDER BY bow_class
+`
+
+func (q *Queries) GetArcherHandicaps(ctx context.Context, externalUserID string) ([]ArcherHandicap, error) {
+	rows, err := q.db.Query(ctx, getArcherHandicaps, externalUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ArcherHandicap{}
+	for rows.Next() {
+		var i ArcherHandicap
+		if err := rows.Scan(
+			&i.ExternalUserID,
+			&i.BowClass,
+			&i.Handicap,
+			&i.RoundsCount,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecentRoundHandicaps = `-- name: GetRecentRoundHandicaps :many
+SELECT handicap::INTEGER AS handicap
+FROM qualification_rounds
+WHERE external_user_id = $1
+  AND bow_class = $2
+  AND handicap IS NOT NULL
+  AND deleted_at IS NULL
+ORDER BY end_time DESC
+LIMIT $3
+`
+
+type GetRecentRoundHandicapsParams struct {
+	ExternalUserID string `json:"external_user_id"`
+	BowClass       string `json:"bow_class"`
+	Limit          int32  `json:"limit"`
+}
+
+func (q *Queries) GetRecentRoundHandicaps(ctx context.Context, arg GetRecentRoundHandicapsParams) ([]int32, error) {
+	rows, err := q.db.Query(ctx, getRecentRoundHandicaps, arg.ExternalUserID, arg.BowClass, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int32{}
+	for rows.Next() {
+		var handicap int32
+		if err := rows.Scan(&handicap); err != nil {
+			return nil, err
+		}
+		items = append(items, handicap)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRoundTemplate = `-- name: GetRoundTemplate :one
+SELECT id, name, distance, total_sets, shots_per_set, target_face_id, description, created_at, updated_at, deleted_at FROM round_templates WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetRoundTemplate(ctx context.Context, id uuid.UUID) (RoundTemplate, error) {
+	row := q.db.QueryRow(ctx, getRoundTemplate, id)
+	var i RoundTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Distance,
+		&i.TotalSets,
+		&i.ShotsPerSet,
+		&i.TargetFaceID,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const listRoundTemplates = `-- name: ListRoundTemplates :many
+SELECT id, name, distance, total_sets, shots_per_set, target_face_id, description, created_at, updated_at, deleted_at FROM round_templates WHERE deleted_at IS NULL ORDER BY name
+`
+
+func (q *Queries) ListRoundTemplates(ctx context.Context) ([]RoundTemplate, error) {
+	rows, err := q.db.Query(ctx, listRoundTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []RoundTemplate{}
+	for rows.Next() {
+		var i RoundTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Distance,
+			&i.TotalSets,
+			&i.ShotsPerSet,
+			&i.TargetFaceID,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertArcherHandicap = `-- name: UpsertArcherHandicap :one
+INSERT INTO archer_handicaps (
+    external_user_id,
+    bow_class,
+    handicap,
+    rounds_count
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT (external_user_id, bow_class) DO UPDATE
+SET handicap = EXCLUDED.handicap,
+    rounds_count = EXCLUDED.rounds_count,
+    updated_at = NOW()
+    RETURNING external_user_id, bow_class, handicap, rounds_count, updated_at
+`
+
+type UpsertArcherHandicapParams struct {
+	ExternalUserID string `json:"external_user_id"`
+	BowClass       string `json:"bow_class"`
+	Handicap       int32  `json:"handicap"`
+	RoundsCount    int32  `json:"rounds_count"`
+}
+
+func (q *Queries) UpsertArcherHandicap(ctx context.Context, arg UpsertArcherHandicapParams) (ArcherHandicap, error) {
+	row := q.db.QueryRow(ctx, upsertArcherHandicap,
+		arg.ExternalUserID,
+		arg.BowClass,
+		arg.Handicap,
+		arg.RoundsCount,
+	)
+	var i ArcherHandicap
+	err := row.Scan(
+		&i.ExternalUserID,
+		&i.BowClass,
+		&i.Handicap,
+		&i.RoundsCount,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,138 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestRoundTemplates(t *testing.T) {
+	f := newFixture(t)
+
+	templates, err := f.q.ListRoundTemplates(f.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		name                        string
+		distance, sets, shotsPerSet int32
+		face                        string
+	}{
+		{"NFAA 300", 18, 12, 5, spot40},
+		{"WA 30m", 30, 6, 6, wa80},
+		{"WA 50m", 50, 12, 6, wa122},
+		{"WA 60m", 60, 12, 6, wa122},
+		{"WA 70m", 70, 12, 6, wa122},
+	}
+	if len(templates) != len(want) {
+		t.Fatalf("expected %d templates, got %d", len(want), len(templates))
+	}
+	for i, w := range want {
+		rt := templates[i]
+		if rt.Name != w.name || rt.Distance != w.distance || rt.TotalSets != w.sets ||
+			rt.ShotsPerSet != w.shotsPerSet || rt.TargetFaceID != f.face(w.face).ID {
+			t.Errorf("template %d = %+v, want %+v", i, rt, w)
+		}
+	}
+
+	got, err := f.q.GetRoundTemplate(f.ctx, templates[0].ID)
+	if err != nil || got.Name != "NFAA 300" {
+		t.Errorf("GetRoundTemplate = %+v, %v", got, err)
+	}
+	_, err = f.q.GetRoundTemplate(f.ctx, uuid.New())
+	expectNoRows(t, err)
+}
+
+func TestGetRecentRoundHandicaps(t *testing.T) {
+	f := newFixture(t)
+
+	// Rounds are completed in this order; only rated ones count.
+	rounds := []struct {
+		user, bowClass string
+		handicap       pgtype.Int4
+	}{
+		{"archer", "recurve", pgtype.Int4{Int32: 50, Valid: true}},
+		{"archer", "recurve", pgtype.Int4{Int32: 45, Valid: true}},
+		{"archer", "recurve", pgtype.Int4{}},
+		{"archer", "compound", pgtype.Int4{Int32: 30, Valid: true}},
+		{"other", "recurve", pgtype.Int4{Int32: 20, Valid: true}},
+		{"archer", "recurve", pgtype.Int4{Int32: 40, Valid: true}},
+	}
+	for _, r := range rounds {
+		round := f.round(r.user, wa122, func(p *db.CreateQualificationRoundParams) { p.BowClass = r.bowClass })
+		if _, err := f.q.CompleteQualificationRound(f.ctx, db.CompleteQualificationRoundParams{
+			ID: round.ID, Handicap: r.handicap,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	deleted := f.round("archer", wa122)
+	if _, err := f.q.CompleteQualificationRound(f.ctx, db.CompleteQualificationRoundParams{
+		ID: deleted.ID, Handicap: pgtype.Int4{Int32: 1, Valid: true},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	f.exec(`UPDATE qualification_rounds SET deleted_at = NOW() WHERE id = $1`, deleted.ID)
+
+	tests := []struct {
+		name   string
+		params db.GetRecentRoundHandicapsParams
+		want   []int32
+	}{
+		{"newest first", db.GetRecentRoundHandicapsParams{ExternalUserID: "archer", BowClass: "recurve", Limit: 10}, []int32{40, 45, 50}},
+		{"limited", db.GetRecentRoundHandicapsParams{ExternalUserID: "archer", BowClass: "recurve", Limit: 2}, []int32{40, 45}},
+		{"by bow class", db.GetRecentRoundHandicapsParams{ExternalUserID: "archer", BowClass: "compound", Limit: 10}, []int32{30}},
+		{"none", db.GetRecentRoundHandicapsParams{ExternalUserID: "archer", BowClass: "longbow", Limit: 10}, []int32{}},
+	}
+	for _, tt := range tests {
+		got, err := f.q.GetRecentRoundHandicaps(f.ctx, tt.params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestArcherHandicaps(t *testing.T) {
+	f := newFixture(t)
+
+	upserts := []db.UpsertArcherHandicapParams{
+		{ExternalUserID: "archer", BowClass: "recurve", Handicap: 50, RoundsCount: 1},
+		{ExternalUserID: "archer", BowClass: "compound", Handicap: 30, RoundsCount: 2},
+		{ExternalUserID: "other", BowClass: "recurve", Handicap: 20, RoundsCount: 3},
+		{ExternalUserID: "archer", BowClass: "recurve", Handicap: 47, RoundsCount: 2},
+	}
+	var first db.ArcherHandicap
+	for i, params := range upserts {
+		h, err := f.q.UpsertArcherHandicap(f.ctx, params)
+		if err != nil || h.Handicap != params.Handicap || h.RoundsCount != params.RoundsCount {
+			t.Fatalf("UpsertArcherHandicap(%+v) = %+v, %v", params, h, err)
+		}
+		if i == 0 {
+			first = h
+		}
+	}
+
+	got, err := f.q.GetArcherHandicap(f.ctx, db.GetArcherHandicapParams{ExternalUserID: "archer", BowClass: "recurve"})
+	if err != nil || got.Handicap != 47 || got.RoundsCount != 2 || !got.UpdatedAt.After(first.UpdatedAt) {
+		t.Errorf("expected the second recurve handicap to replace the first, got %+v, %v", got, err)
+	}
+	_, err = f.q.GetArcherHandicap(f.ctx, db.GetArcherHandicapParams{ExternalUserID: "archer", BowClass: "longbow"})
+	expectNoRows(t, err)
+
+	all, err := f.q.GetArcherHandicaps(f.ctx, "archer")
+	if err != nil || len(all) != 2 || all[0].BowClass != "compound" || all[1].BowClass != "recurve" {
+		t.Errorf("GetArcherHandicaps = %+v, %v, want compound then recurve", all, err)
+	}
+}
@@ -0,0 +1,259 @@
+package db_test
+
+import (
+	"archy/scores/internal/database/dbtest"
+	"archy/scores/internal/db"
+	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// The tests in this package run every query against a migrated database from
+// dbtest; see that package for how the server is found.
+func TestMain(m *testing.M) { os.Exit(dbtest.Main(m)) }
+
+// Every method of db.Querier must be called by a test in this package, so a
+// new query cannot be added without one.
+func TestQuerier_EveryMethodIsTested(t *testing.T) {
+	files, err := filepath.Glob("*_test.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	called := make(map[string]bool)
+	fset := token.NewFileSet()
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok {
+				if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+					called[sel.Sel.Name] = true
+				}
+			}
+			return true
+		})
+	}
+
+	querier := reflect.TypeOf((*db.Querier)(nil)).Elem()
+	for i := 0; i < querier.NumMethod(); i++ {
+		if name := querier.Method(i).Name; !called[name] {
+			t.Errorf("no test calls %s", name)
+		}
+	}
+}
+
+// fixture holds a fresh database and builds the rows most tests need.
+type fixture struct {
+	t    testing.TB
+	ctx  context.Context
+	pool *pgxpool.Pool
+	q    *db.Queries
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	t.Parallel()
+	return openFixture(t)
+}
+
+// openFixture is newFixture for benchmarks, which must not run in parallel.
+func openFixture(tb testing.TB) *fixture {
+	tb.Helper()
+	pool := dbtest.New(tb)
+	return &fixture{t: tb, ctx: context.Background(), pool: pool, q: db.New(pool)}
+}
+
+// with returns the fixture reporting to t, for use in subtests.
+func (f *fixture) with(t testing.TB) *fixture {
+	c := *f
+	c.t = t
+	return &c
+}
+
+// exec runs SQL that no query provides, to set up states such as expired
+// invitations or deleted rounds.
+func (f *fixture) exec(sql string, args ...any) {
+	f.t.Helper()
+	if _, err := f.pool.Exec(f.ctx, sql, args...); err != nil {
+		f.t.Fatalf("failed to run %q: %v", sql, err)
+	}
+}
+
+// face returns one of the target faces seeded by the migrations.
+func (f *fixture) face(name string) db.TargetFace {
+	f.t.Helper()
+	var id uuid.UUID
+	if err := f.pool.QueryRow(f.ctx, `SELECT id FROM target_faces WHERE name = $1`, name).Scan(&id); err != nil {
+		f.t.Fatalf("failed to find target face %s: %v", name, err)
+	}
+	face, err := f.q.GetTargetFace(f.ctx, id)
+	if err != nil {
+		f.t.Fatalf("failed to get target face %s: %v", name, err)
+	}
+	return face
+}
+
+// template returns one of the round templates seeded by the migrations.
+func (f *fixture) template(name string) db.RoundTemplate {
+	f.t.Helper()
+	templates, err := f.q.ListRoundTemplates(f.ctx)
+	if err != nil {
+		f.t.Fatalf("failed to list round templates: %v", err)
+	}
+	for _, rt := range templates {
+		if rt.Name == name {
+			return rt
+		}
+	}
+	f.t.Fatalf("no round template %s", name)
+	return db.RoundTemplate{}
+}
+
+const (
+	wa122  = "WA 122cm 10-zone"
+	wa80   = "WA 80cm 10-zone"
+	spot40 = "3-Spot Vertical"
+)
+
+// round creates a 70 m recurve training round of 12 ends of 6 arrows on the
+// face, for the user, after applying the changes to its parameters.
+func (f *fixture) round(user, face string, changes ...func(*db.CreateQualificationRoundParams)) db.QualificationRound {
+	f.t.Helper()
+	params := db.CreateQualificationRoundParams{
+		ExternalUserID: user,
+		RoundType:      "training",
+		Name:           "Test round",
+		Distance:       70,
+		TotalSets:      12,
+		ShotsPerSet:    6,
+		TargetFaceID:   f.face(face).ID,
+		BowClass:       "recurve",
+		AgeCategory:    "adult",
+	}
+	for _, change := range changes {
+		change(&params)
+	}
+	round, err := f.q.CreateQualificationRound(f.ctx, params)
+	if err != nil {
+		f.t.Fatalf("failed to create round: %v", err)
+	}
+	return round
+}
+
+func (f *fixture) set(roundID uuid.UUID, number, maxShots int32) db.Set {
+	f.t.Helper()
+	set, err := f.q.CreateSet(f.ctx, db.CreateSetParams{
+		SetNumber:     number,
+		MaxShots:      maxShots,
+		ParentRoundID: pgID(roundID),
+	})
+	if err != nil {
+		f.t.Fatalf("failed to create set: %v", err)
+	}
+	return set
+}
+
+// shoot adds arrows to the set in one batch, as the batch endpoint does.
+func (f *fixture) shoot(setID uuid.UUID, arrows ...[2]float64) []db.Shot {
+	f.t.Helper()
+	params := db.BatchCreateShotsParams{SetID: setID, Notes: make([]string, len(arrows))}
+	for _, a := range arrows {
+		params.Xs = append(params.Xs, number(f.t, a[0]))
+		params.Ys = append(params.Ys, number(f.t, a[1]))
+	}
+	shots, err := f.q.BatchCreateShots(f.ctx, params)
+	if err != nil {
+		f.t.Fatalf("failed to shoot: %v", err)
+	}
+	return shots
+}
+
+func (f *fixture) getSet(id uuid.UUID) db.Set {
+	f.t.Helper()
+	set, err := f.q.GetSet(f.ctx, id)
+	if err != nil {
+		f.t.Fatalf("failed to get set: %v", err)
+	}
+	return set
+}
+
+func (f *fixture) getRound(id uuid.UUID) db.QualificationRound {
+	f.t.Helper()
+	round, err := f.q.GetQualificationRound(f.ctx, id)
+	if err != nil {
+		f.t.Fatalf("failed to get round: %v", err)
+	}
+	return round
+}
+
+func number(t testing.TB, v float64) pgtype.Numeric {
+	t.Helper()
+	var n pgtype.Numeric
+	if err := n.Scan(strconv.FormatFloat(v, 'f', -1, 64)); err != nil {
+		t.Fatalf("failed to build number %v: %v", v, err)
+	}
+	return n
+}
+
+// float returns the value of a numeric column, NaN for NULL.
+func float(t testing.TB, n pgtype.Numeric) float64 {
+	t.Helper()
+	if !n.Valid {
+		return math.NaN()
+	}
+	v, err := n.Float64Value()
+	if err != nil {
+		t.Fatalf("failed to read number: %v", err)
+	}
+	return v.Float64
+}
+
+// equal compares numbers as stored in DECIMAL(5,2) columns; NaN equals NaN
+// so that NULL can be expected.
+func equal(a, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	return math.Abs(a-b) < 0.005
+}
+
+func text(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: true}
+}
+
+func pgID(u uuid.UUID) pgtype.UUID {
+	return pgtype.UUID{Bytes: u, Valid: true}
+}
+
+func expectNoRows(t *testing.T, err error) {
+	t.Helper()
+	if !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("expected no rows, got %v", err)
+	}
+}
+
+// expectCode checks that err is a Postgres error with the SQLSTATE code, such
+// as 23505 for a unique violation.
+func expectCode(t *testing.T, err error, code string) {
+	t.Helper()
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != code {
+		t.Errorf("expected SQLSTATE %s, got %v", code, err)
+	}
+}
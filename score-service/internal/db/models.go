@@ -5,33 +5,180 @@
 package db
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// Classifications earned by archers, one per completed round
+type ArcherClassification struct {
+	ID             uuid.UUID `json:"id"`
+	ExternalUserID string    `json:"external_user_id"`
+	RoundID        uuid.UUID `json:"round_id"`
+	RuleID         uuid.UUID `json:"rule_id"`
+	Classification string    `json:"classification"`
+	Rank           int32     `json:"rank"`
+	BowClass       string    `json:"bow_class"`
+	AgeCategory    string    `json:"age_category"`
+	Score          int32     `json:"score"`
+	AchievedAt     time.Time `json:"achieved_at"`
+}
+
+// Rolling handicap per archer and bow class (average of the most recent rated rounds)
+type ArcherHandicap struct {
+	ExternalUserID string    `json:"external_user_id"`
+	BowClass       string    `json:"bow_class"`
+	Handicap       int32     `json:"handicap"`
+	RoundsCount    int32     `json:"rounds_count"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type AuditLog struct {
+	ID          int64           `json:"id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	ActorUserID string          `json:"actor_user_id"`
+	TokenType   string          `json:"token_type"`
+	TokenID     pgtype.Text     `json:"token_id"`
+	RequestID   pgtype.Text     `json:"request_id"`
+	Action      string          `json:"action"`
+	EntityType  string          `json:"entity_type"`
+	EntityID    uuid.UUID       `json:"entity_id"`
+	OwnerUserID pgtype.Text     `json:"owner_user_id"`
+	BeforeData  json.RawMessage `json:"before_data"`
+	AfterData   json.RawMessage `json:"after_data"`
+	Reason      pgtype.Text     `json:"reason"`
+}
+
+// Score thresholds for classifications per round template, age category, gender and bow class
+type ClassificationRule struct {
+	ID              uuid.UUID `json:"id"`
+	RoundTemplateID uuid.UUID `json:"round_template_id"`
+	AgeCategory     string    `json:"age_category"`
+	Gender          string    `json:"gender"`
+	BowClass        string    `json:"bow_class"`
+	Classification  string    `json:"classification"`
+	Rank            int32     `json:"rank"`
+	MinScore        int32     `json:"min_score"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// Coach to athlete relationships granting read access
+type CoachAthlete struct {
+	CoachUserID   string    `json:"coach_user_id"`
+	AthleteUserID string    `json:"athlete_user_id"`
+	CreatedAt     time.Time `json:"created_at"`
+	// admin, or invitation while an accepted invitation grants the access
+	GrantedBy string `json:"granted_by"`
+}
+
+// Invitations from athletes to coaches
+type CoachInvitation struct {
+	ID            uuid.UUID          `json:"id"`
+	AthleteUserID string             `json:"athlete_user_id"`
+	CoachUserID   pgtype.Text        `json:"coach_user_id"`
+	InviteCode    pgtype.Text        `json:"invite_code"`
+	Status        string             `json:"status"`
+	ExpiresAt     time.Time          `json:"expires_at"`
+	RespondedAt   pgtype.Timestamptz `json:"responded_at"`
+	CreatedAt     time.Time          `json:"created_at"`
+	UpdatedAt     time.Time          `json:"updated_at"`
+}
+
+// Comments by archers and their coaches on rounds, sets and shots
+type Comment struct {
+	ID           uuid.UUID          `json:"id"`
+	AuthorUserID string             `json:"author_user_id"`
+	RoundID      uuid.UUID          `json:"round_id"`
+	SetID        pgtype.UUID        `json:"set_id"`
+	ShotID       pgtype.UUID        `json:"shot_id"`
+	Body         string             `json:"body"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+	DeletedAt    pgtype.Timestamptz `json:"deleted_at"`
+}
+
+// Hashed personal access tokens; the plain token is only shown once
+type PersonalAccessToken struct {
+	ID             uuid.UUID          `json:"id"`
+	ExternalUserID string             `json:"external_user_id"`
+	Name           string             `json:"name"`
+	TokenPrefix    string             `json:"token_prefix"`
+	TokenHash      []byte             `json:"token_hash"`
+	Scopes         []string           `json:"scopes"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+	LastUsedAt     pgtype.Timestamptz `json:"last_used_at"`
+	CreatedAt      time.Time          `json:"created_at"`
+	RevokedAt      pgtype.Timestamptz `json:"revoked_at"`
+}
+
 // Training sessions or qualification rounds
 type QualificationRound struct {
 	ID uuid.UUID `json:"id"`
 	// User ID from external authentication service (JWT claim: user_id or sub)
-	ExternalUserID string             `json:"external_user_id"`
-	RoundType      string             `json:"round_type"`
-	Name           string             `json:"name"`
-	Distance       int32              `json:"distance"`
-	TotalSets      int32              `json:"total_sets"`
-	ShotsPerSet    int32              `json:"shots_per_set"`
-	TotalScore     int32              `json:"total_score"`
-	AverageScore   pgtype.Numeric     `json:"average_score"`
-	CompletedSets  int32              `json:"completed_sets"`
-	StartTime      pgtype.Timestamptz `json:"start_time"`
-	EndTime        pgtype.Timestamptz `json:"end_time"`
-	Notes          pgtype.Text        `json:"notes"`
-	TargetFaceID   uuid.UUID          `json:"target_face_id"`
-	CompetitionID  pgtype.UUID        `json:"competition_id"`
-	CreatedAt      time.Time          `json:"created_at"`
-	UpdatedAt      time.Time          `json:"updated_at"`
-	DeletedAt      pgtype.Timestamptz `json:"deleted_at"`
+	ExternalUserID string `json:"external_user_id"`
+	RoundType      string `json:"round_type"`
+	Name           string `json:"name"`
+	Distance       int32  `json:"distance"`
+	TotalSets      int32  `json:"total_sets"`
+	ShotsPerSet    int32  `json:"shots_per_set"`
+	TotalScore     int32  `json:"total_score"`
+	// Average score per arrow over all shots of the round
+	AverageScore pgtype.Numeric `json:"average_score"`
+	// Number of ends with shots_count = max_shots
+	CompletedSets   int32              `json:"completed_sets"`
+	StartTime       pgtype.Timestamptz `json:"start_time"`
+	EndTime         pgtype.Timestamptz `json:"end_time"`
+	Notes           pgtype.Text        `json:"notes"`
+	TargetFaceID    uuid.UUID          `json:"target_face_id"`
+	CompetitionID   pgtype.UUID        `json:"competition_id"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
+	BowClass        string             `json:"bow_class"`
+	RoundTemplateID pgtype.UUID        `json:"round_template_id"`
+	// Archery GB-style handicap (0-150) achieved in this round
+	Handicap    pgtype.Int4 `json:"handicap"`
+	AgeCategory string      `json:"age_category"`
+	Gender      pgtype.Text `json:"gender"`
+	ShotsCount  int32       `json:"shots_count"`
+	TenCount    int32       `json:"ten_count"`
+	XCount      int32       `json:"x_count"`
+	MissCount   int32       `json:"miss_count"`
+}
+
+type RateLimitBucket struct {
+	BucketKey string    `json:"bucket_key"`
+	Tokens    float64   `json:"tokens"`
+	Allowed   bool      `json:"allowed"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Standard round definitions (WA 70m, WA 30m, etc.)
+type RoundTemplate struct {
+	ID           uuid.UUID          `json:"id"`
+	Name         string             `json:"name"`
+	Distance     int32              `json:"distance"`
+	TotalSets    int32              `json:"total_sets"`
+	ShotsPerSet  int32              `json:"shots_per_set"`
+	TargetFaceID uuid.UUID          `json:"target_face_id"`
+	Description  pgtype.Text        `json:"description"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+	DeletedAt    pgtype.Timestamptz `json:"deleted_at"`
+}
+
+type ScoreSignature struct {
+	ID           uuid.UUID   `json:"id"`
+	RoundID      uuid.UUID   `json:"round_id"`
+	SetID        pgtype.UUID `json:"set_id"`
+	SignerUserID string      `json:"signer_user_id"`
+	SignerRole   string      `json:"signer_role"`
+	Reason       pgtype.Text `json:"reason"`
+	ScoresHash   string      `json:"scores_hash"`
+	SignedAt     time.Time   `json:"signed_at"`
 }
 
 // Series of shots (typically 3 or 6 arrows)
@@ -90,3 +237,10 @@ type TargetFace struct {
 	UpdatedAt       time.Time          `json:"updated_at"`
 	DeletedAt       pgtype.Timestamptz `json:"deleted_at"`
 }
+
+// Roles granted locally, merged with roles from JWT claims
+type UserRole struct {
+	ExternalUserID string    `json:"external_user_id"`
+	Role           string    `json:"role"`
+	CreatedAt      time.Time `json:"created_at"`
+}
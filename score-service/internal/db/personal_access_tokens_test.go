@@ -0,0 +1,91 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestPersonalAccessTokens(t *testing.T) {
+	f := newFixture(t)
+	create := func(user, name string, hash byte, expires pgtype.Timestamptz) db.PersonalAccessToken {
+		token, err := f.q.CreatePersonalAccessToken(f.ctx, db.CreatePersonalAccessTokenParams{
+			ExternalUserID: user,
+			Name:           name,
+			TokenPrefix:    "archy_" + name,
+			TokenHash:      []byte{hash},
+			Scopes:         []string{"rounds:read"},
+			ExpiresAt:      expires,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	phone := create("archer", "phone", 1, pgtype.Timestamptz{})
+	laptop := create("archer", "laptop", 2, pgtype.Timestamptz{Time: time.Now().Add(time.Hour), Valid: true})
+	expired := create("archer", "old", 3, pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true})
+	create("other", "phone", 4, pgtype.Timestamptz{})
+	if len(phone.Scopes) != 1 || phone.Scopes[0] != "rounds:read" || phone.LastUsedAt.Valid || phone.RevokedAt.Valid {
+		t.Errorf("token not stored as given: %+v", phone)
+	}
+
+	// Hashes are unique.
+	_, err := f.q.CreatePersonalAccessToken(f.ctx, db.CreatePersonalAccessTokenParams{
+		ExternalUserID: "other", Name: "copy", TokenPrefix: "archy_copy", TokenHash: []byte{1}, Scopes: []string{},
+	})
+	expectCode(t, err, "23505")
+
+	if got, err := f.q.GetActivePersonalAccessToken(f.ctx, []byte{2}); err != nil || got.ID != laptop.ID {
+		t.Errorf("GetActivePersonalAccessToken = %+v, %v", got, err)
+	}
+	_, err = f.q.GetActivePersonalAccessToken(f.ctx, []byte{3})
+	expectNoRows(t, err)
+	_, err = f.q.GetActivePersonalAccessToken(f.ctx, []byte{9})
+	expectNoRows(t, err)
+
+	// Use is recorded at most once a minute.
+	if err := f.q.TouchPersonalAccessToken(f.ctx, phone.ID); err != nil {
+		t.Fatal(err)
+	}
+	touched, err := f.q.GetActivePersonalAccessToken(f.ctx, []byte{1})
+	if err != nil || !touched.LastUsedAt.Valid {
+		t.Fatalf("expected the token to be touched, got %+v, %v", touched, err)
+	}
+	if err := f.q.TouchPersonalAccessToken(f.ctx, phone.ID); err != nil {
+		t.Fatal(err)
+	}
+	if again, err := f.q.GetActivePersonalAccessToken(f.ctx, []byte{1}); err != nil ||
+		!again.LastUsedAt.Time.Equal(touched.LastUsedAt.Time) {
+		t.Errorf("expected a second touch within a minute to be skipped, got %+v, %v", again, err)
+	}
+
+	tests := []struct {
+		name string
+		id   uuid.UUID
+		user string
+		rows int64
+	}{
+		{"another user's token", phone.ID, "other", 0},
+		{"revoked", phone.ID, "archer", 1},
+		{"already revoked", phone.ID, "archer", 0},
+	}
+	for _, tt := range tests {
+		rows, err := f.q.RevokePersonalAccessToken(f.ctx, db.RevokePersonalAccessTokenParams{ID: tt.id, ExternalUserID: tt.user})
+		if err != nil || rows != tt.rows {
+			t.Errorf("%s: RevokePersonalAccessToken = %d, %v, want %d", tt.name, rows, err, tt.rows)
+		}
+	}
+	_, err = f.q.GetActivePersonalAccessToken(f.ctx, []byte{1})
+	expectNoRows(t, err)
+
+	// Expired tokens are listed until revoked, newest first.
+	tokens, err := f.q.ListPersonalAccessTokens(f.ctx, "archer")
+	if err != nil || len(tokens) != 2 || tokens[0].ID != expired.ID || tokens[1].ID != laptop.ID {
+		t.Errorf("ListPersonalAccessTokens = %+v, %v", tokens, err)
+	}
+}
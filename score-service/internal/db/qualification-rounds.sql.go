@@ -12,6 +12,54 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const completeQualificationRound = `-- name: CompleteQualificationRound :one
+UPDATE qualification_rounds
+SET end_time = COALESCE(end_time, NOW()),
+    handicap = $2
+WHERE id = $1 AND deleted_at IS NULL
+    RETURNING id, external_user_id, round_type, name, distance, total_sets, shots_per_set, total_score, average_score, completed_sets, start_time, end_time, notes, target_face_id, competition_id, created_at, updated_at, deleted_at, bow_class, round_template_id, handicap, age_category, gender, shots_count, ten_count, x_count, miss_count
+`
+
+type CompleteQualificationRoundParams struct {
+	ID       uuid.UUID   `json:"id"`
+	Handicap pgtype.Int4 `json:"handicap"`
+}
+
+func (q *Queries) CompleteQualificationRound(ctx context.Context, arg CompleteQualificationRoundParams) (QualificationRound, error) {
+	row := q.db.QueryRow(ctx, completeQualificationRound, arg.ID, arg.Handicap)
+	var i QualificationRound
+	err := row.Scan(
+		&i.ID,
+		&i.ExternalUserID,
+		&i.RoundType,
+		&i.Name,
+		&i.Distance,
+		&i.TotalSets,
+		&i.ShotsPerSet,
+		&i.TotalScore,
+		&i.AverageScore,
+		&i.CompletedSets,
+		&i.StartTime,
+		&i.EndTime,
+		&i.Notes,
+		&i.TargetFaceID,
+		&i.CompetitionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.BowClass,
+		&i.RoundTemplateID,
+		&i.Handicap,
+		&i.AgeCategory,
+		&i.Gender,
+		&i.ShotsCount,
+		&i.TenCount,
+		&i.XCount,
+		&i.MissCount,
+	)
+	return i, err
+}
+
 const createQualificationRound = `-- name: CreateQualificationRound :one
 INSERT INTO qualification_rounds (
     external_user_id,
@@ -22,21 +70,29 @@ INSERT INTO qualification_rounds (
     shots_per_set,
     target_face_id,
     notes,
-    start_time
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-    RETURNING id, external_user_id, round_type, name, distance, total_sets, shots_per_set, total_score, average_score, completed_sets, start_time, end_time, notes, target_face_id, competition_id, created_at, updated_at, deleted_at
+    start_time,
+    bow_class,
+    round_template_id,
+    age_category,
+    gender
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+    RETURNING id, external_user_id, round_type, name, distance, total_sets, shots_per_set, total_score, average_score, completed_sets, start_time, end_time, notes, target_face_id, competition_id, created_at, updated_at, deleted_at, bow_class, round_template_id, handicap, age_category, gender, shots_count, ten_count, x_count, miss_count
 `
 
 type CreateQualificationRoundParams struct {
-	ExternalUserID string             `json:"external_user_id"`
-	RoundType      string             `json:"round_type"`
-	Name           string             `json:"name"`
-	Distance       int32              `json:"distance"`
-	TotalSets      int32              `json:"total_sets"`
-	ShotsPerSet    int32              `json:"shots_per_set"`
-	TargetFaceID   uuid.UUID          `json:"target_face_id"`
-	Notes          pgtype.Text        `json:"notes"`
-	StartTime      pgtype.Timestamptz `json:"start_time"`
+	ExternalUserID  string             `json:"external_user_id"`
+	RoundType       string             `json:"round_type"`
+	Name            string             `json:"name"`
+	Distance        int32              `json:"distance"`
+	TotalSets       int32              `json:"total_sets"`
+	ShotsPerSet     int32              `json:"shots_per_set"`
+	TargetFaceID    uuid.UUID          `json:"target_face_id"`
+	Notes           pgtype.Text        `json:"notes"`
+	StartTime       pgtype.Timestamptz `json:"start_time"`
+	BowClass        string             `json:"bow_class"`
+	RoundTemplateID pgtype.UUID        `json:"round_template_id"`
+	AgeCategory     string             `json:"age_category"`
+	Gender          pgtype.Text        `json:"gender"`
 }
 
 func (q *Queries) CreateQualificationRound(ctx context.Context, arg CreateQualificationRoundParams) (QualificationRound, error) {
@@ -50,6 +106,10 @@ func (q *Queries) CreateQualificationRound(ctx context.Context, arg CreateQualif
 		arg.TargetFaceID,
 		arg.Notes,
 		arg.StartTime,
+		arg.BowClass,
+		arg.RoundTemplateID,
+		arg.AgeCategory,
+		arg.Gender,
 	)
 	var i QualificationRound
 	err := row.Scan(
@@ -71,12 +131,21 @@ func (q *Queries) CreateQualificationRound(ctx context.Context, arg CreateQualif
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.BowClass,
+		&i.RoundTemplateID,
+		&i.Handicap,
+		&i.AgeCategory,
+		&i.Gender,
+		&i.ShotsCount,
+		&i.TenCount,
+		&i.XCount,
+		&i.MissCount,
 	)
 	return i, err
 }
 
 const getQualificationRound = `-- name: GetQualificationRound :one
-SELECT id, external_user_id, round_type, name, distance, total_sets, shots_per_set, total_score, average_score, completed_sets, start_time, end_time, notes, target_face_id, competition_id, created_at, updated_at, deleted_at FROM qualification_rounds WHERE id = $1 AND deleted_at IS NULL
+SELECT id, external_user_id, round_type, name, distance, total_sets, shots_per_set, total_score, average_score, completed_sets, start_time, end_time, notes, target_face_id, competition_id, created_at, updated_at, deleted_at, bow_class, round_template_id, handicap, age_category, gender, shots_count, ten_count, x_count, miss_count FROM qualification_rounds WHERE id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetQualificationRound(ctx context.Context, id uuid.UUID) (QualificationRound, error) {
@@ -101,12 +170,21 @@ func (q *Queries) GetQualificationRound(ctx context.Context, id uuid.UUID) (Qual
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.BowClass,
+		&i.RoundTemplateID,
+		&i.Handicap,
+		&i.AgeCategory,
+		&i.Gender,
+		&i.ShotsCount,
+		&i.TenCount,
+		&i.XCount,
+		&i.MissCount,
 	)
 	return i, err
 }
 
 const getQualificationRoundsForUser = `-- name: GetQualificationRoundsForUser :many
-SELECT id, external_user_id, round_type, name, distance, total_sets, shots_per_set, total_score, average_score, completed_sets, start_time, end_time, notes, target_face_id, competition_id, created_at, updated_at, deleted_at FROM qualification_rounds WHERE external_user_id = $1 AND deleted_at IS NULL
+SELECT id, external_user_id, round_type, name, distance, total_sets, shots_per_set, total_score, average_score, completed_sets, start_time, end_time, notes, target_face_id, competition_id, created_at, updated_at, deleted_at, bow_class, round_template_id, handicap, age_category, gender, shots_count, ten_count, x_count, miss_count FROM qualification_rounds WHERE external_user_id = $1 AND deleted_at IS NULL
 `
 
 func (q *Queries) GetQualificationRoundsForUser(ctx context.Context, externalUserID string) ([]QualificationRound, error) {
@@ -137,6 +215,15 @@ func (q *Queries) GetQualificationRoundsForUser(ctx context.Context, externalUse
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.BowClass,
+			&i.RoundTemplateID,
+			&i.Handicap,
+			&i.AgeCategory,
+			&i.Gender,
+			&i.ShotsCount,
+			&i.TenCount,
+			&i.XCount,
+			&i.MissCount,
 		); err != nil {
 			return nil, err
 		}
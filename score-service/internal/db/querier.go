@@ -6,22 +6,109 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	// Accepts a pending, unexpired invitation addressed to the coach, or redeems
+	// an invite code, and grants the coach access to the athlete in one statement.
+	// Access an admin already granted stays theirs.
+	AcceptCoachInvitation(ctx context.Context, arg AcceptCoachInvitationParams) (AcceptCoachInvitationRow, error)
+	// Grants access as an admin, which revoking an invitation leaves in place.
+	AddCoachAthlete(ctx context.Context, arg AddCoachAthleteParams) (CoachAthlete, error)
+	// Scores every shot against the round's target face and inserts them in a
+	// single statement, so set and round statistics are refreshed once.
 	BatchCreateShots(ctx context.Context, arg BatchCreateShotsParams) ([]Shot, error)
+	CompleteQualificationRound(ctx context.Context, arg CompleteQualificationRoundParams) (QualificationRound, error)
+	// Records entities of one type created together by one request, such as the
+	// arrows of a batch, with after_data parallel to entity_ids.
+	CreateAuditLogEntries(ctx context.Context, arg CreateAuditLogEntriesParams) error
+	CreateAuditLogEntry(ctx context.Context, arg CreateAuditLogEntryParams) error
+	CreateCoachInvitation(ctx context.Context, arg CreateCoachInvitationParams) (CoachInvitation, error)
+	CreateComment(ctx context.Context, arg CreateCommentParams) (Comment, error)
+	CreatePersonalAccessToken(ctx context.Context, arg CreatePersonalAccessTokenParams) (PersonalAccessToken, error)
 	CreateQualificationRound(ctx context.Context, arg CreateQualificationRoundParams) (QualificationRound, error)
+	CreateScoreSignature(ctx context.Context, arg CreateScoreSignatureParams) (ScoreSignature, error)
 	CreateSet(ctx context.Context, arg CreateSetParams) (Set, error)
+	// The score is always calculated from the coordinates and the round's target face.
 	CreateShot(ctx context.Context, arg CreateShotParams) (Shot, error)
+	CreateTargetFace(ctx context.Context, arg CreateTargetFaceParams) (TargetFace, error)
+	DeclineCoachInvitation(ctx context.Context, arg DeclineCoachInvitationParams) (CoachInvitation, error)
+	DeleteComment(ctx context.Context, arg DeleteCommentParams) (int64, error)
+	DeleteIdleRateLimitBuckets(ctx context.Context, idleBefore time.Time) (int64, error)
+	DeleteShot(ctx context.Context, arg DeleteShotParams) (int64, error)
+	DeleteTargetFace(ctx context.Context, id uuid.UUID) (int64, error)
+	GetActivePersonalAccessToken(ctx context.Context, tokenHash []byte) (PersonalAccessToken, error)
+	GetArcherClassifications(ctx context.Context, externalUserID string) ([]ArcherClassification, error)
+	GetArcherHandicap(ctx context.Context, arg GetArcherHandicapParams) (ArcherHandicap, error)
+	GetArcherHandicaps(ctx context.Context, externalUserID string) ([]ArcherHandicap, error)
+	GetBestClassificationRule(ctx context.Context, arg GetBestClassificationRuleParams) (ClassificationRule, error)
+	GetCoachInvitation(ctx context.Context, id uuid.UUID) (CoachInvitation, error)
+	GetComment(ctx context.Context, id uuid.UUID) (Comment, error)
 	GetQualificationRound(ctx context.Context, id uuid.UUID) (QualificationRound, error)
 	GetQualificationRoundsForUser(ctx context.Context, externalUserID string) ([]QualificationRound, error)
+	GetRecentRoundHandicaps(ctx context.Context, arg GetRecentRoundHandicapsParams) ([]int32, error)
+	GetRoundTemplate(ctx context.Context, id uuid.UUID) (RoundTemplate, error)
 	GetSet(ctx context.Context, id uuid.UUID) (Set, error)
+	// Returns the archer who owns the round the set belongs to.
+	GetSetOwner(ctx context.Context, arg GetSetOwnerParams) (string, error)
 	GetSetsForQualificationRound(ctx context.Context, parentRoundID pgtype.UUID) ([]Set, error)
 	GetShot(ctx context.Context, id uuid.UUID) (Shot, error)
+	GetShotPositionsForUser(ctx context.Context, arg GetShotPositionsForUserParams) ([]GetShotPositionsForUserRow, error)
 	GetShotsBySet(ctx context.Context, setID uuid.UUID) ([]Shot, error)
+	GetTargetFace(ctx context.Context, id uuid.UUID) (TargetFace, error)
+	// Rounds keep the face they were shot on, so scoring looks up deleted faces too.
+	GetTargetFaceIncludingDeleted(ctx context.Context, id uuid.UUID) (TargetFace, error)
+	IsCoachOf(ctx context.Context, arg IsCoachOfParams) (bool, error)
+	// An end is signed when it or its whole round carries a signature.
+	IsSetSigned(ctx context.Context, arg IsSetSignedParams) (bool, error)
+	// Any round counts, deleted or not, since its shots were scored on the face.
+	IsTargetFaceInUse(ctx context.Context, targetFaceID uuid.UUID) (bool, error)
+	ListAthletesForCoach(ctx context.Context, coachUserID string) ([]CoachAthlete, error)
+	// Newest first. owner_user_ids limits the entries to the given archers' data
+	// when not NULL; before_id pages through older entries.
+	ListAuditLog(ctx context.Context, arg ListAuditLogParams) ([]AuditLog, error)
+	ListClassificationRules(ctx context.Context, arg ListClassificationRulesParams) ([]ClassificationRule, error)
+	ListCoachInvitationsForAthlete(ctx context.Context, athleteUserID string) ([]CoachInvitation, error)
+	ListCoachInvitationsForCoach(ctx context.Context, coachUserID pgtype.Text) ([]CoachInvitation, error)
+	ListCoachesForAthlete(ctx context.Context, athleteUserID string) ([]CoachAthlete, error)
+	// Comments on the round itself, not on its sets or shots.
+	ListCommentsForRound(ctx context.Context, roundID uuid.UUID) ([]Comment, error)
+	ListCommentsForSet(ctx context.Context, setID pgtype.UUID) ([]Comment, error)
+	ListCommentsForShot(ctx context.Context, shotID pgtype.UUID) ([]Comment, error)
+	ListPersonalAccessTokens(ctx context.Context, externalUserID string) ([]PersonalAccessToken, error)
+	ListQualificationRoundIDs(ctx context.Context) ([]uuid.UUID, error)
+	// Every scored arrow of a round in a stable order, for hashing.
+	ListRoundShotsForVerification(ctx context.Context, parentRoundID pgtype.UUID) ([]ListRoundShotsForVerificationRow, error)
+	ListRoundTemplates(ctx context.Context) ([]RoundTemplate, error)
+	ListScoreSignatures(ctx context.Context, roundID uuid.UUID) ([]ScoreSignature, error)
+	ListTargetFaces(ctx context.Context) ([]TargetFace, error)
+	ListUserRoles(ctx context.Context, externalUserID string) ([]string, error)
+	// Locks a round until the transaction ends, so that its scores are not signed
+	// while they change nor changed while they are signed.
+	LockRoundScores(ctx context.Context, id uuid.UUID) error
+	RefreshRoundStatistics(ctx context.Context, parentRoundID pgtype.UUID) error
+	RemoveCoachAthlete(ctx context.Context, arg RemoveCoachAthleteParams) (int64, error)
+	// Removes a coach's access to the athlete and revokes the invitations that granted it.
+	RevokeCoach(ctx context.Context, arg RevokeCoachParams) (int64, error)
+	// Revokes a pending or accepted invitation and the access it granted, unless
+	// an admin granted the access or another accepted invitation still does.
+	// The self-join exposes the status from before the update.
+	RevokeCoachInvitation(ctx context.Context, arg RevokeCoachInvitationParams) (RevokeCoachInvitationRow, error)
+	RevokePersonalAccessToken(ctx context.Context, arg RevokePersonalAccessTokenParams) (int64, error)
+	// Refills the bucket for the time since its last update and takes a token if
+	// one is available, atomically so concurrent instances share the budget.
+	TakeRateLimitToken(ctx context.Context, arg TakeRateLimitTokenParams) (TakeRateLimitTokenRow, error)
+	// Records use of a token, at most once a minute to avoid a write per request.
+	TouchPersonalAccessToken(ctx context.Context, id uuid.UUID) error
+	// Moves a shot and rescores it against the round's target face.
+	UpdateShot(ctx context.Context, arg UpdateShotParams) (Shot, error)
+	UpdateTargetFace(ctx context.Context, arg UpdateTargetFaceParams) (TargetFace, error)
+	UpsertArcherClassification(ctx context.Context, arg UpsertArcherClassificationParams) (ArcherClassification, error)
+	UpsertArcherHandicap(ctx context.Context, arg UpsertArcherHandicapParams) (ArcherHandicap, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,63 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTakeRateLimitToken(t *testing.T) {
+	f := newFixture(t)
+	// Without a refill rate, a bucket holds exactly its burst.
+	empty := db.TakeRateLimitTokenParams{BucketKey: "archer:write", Burst: 2, Rate: 0}
+
+	steps := []struct {
+		name    string
+		params  db.TakeRateLimitTokenParams
+		tokens  float64
+		allowed bool
+	}{
+		{"new bucket", empty, 1, true},
+		{"last token", empty, 0, true},
+		{"empty", empty, 0, false},
+		{"still empty", empty, 0, false},
+		{"other bucket", db.TakeRateLimitTokenParams{BucketKey: "other:write", Burst: 2, Rate: 0}, 1, true},
+	}
+	for _, step := range steps {
+		got, err := f.q.TakeRateLimitToken(f.ctx, step.params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Allowed != step.allowed || math.Abs(got.Tokens-step.tokens) > 1e-9 {
+			t.Errorf("%s: got %+v, want %v tokens, allowed %v", step.name, got, step.tokens, step.allowed)
+		}
+	}
+
+	// Time refills the bucket up to its burst.
+	f.exec(`UPDATE rate_limit_buckets SET updated_at = NOW() - INTERVAL '1 hour' WHERE bucket_key = 'archer:write'`)
+	got, err := f.q.TakeRateLimitToken(f.ctx, db.TakeRateLimitTokenParams{BucketKey: "archer:write", Burst: 2, Rate: 1})
+	if err != nil || !got.Allowed || math.Abs(got.Tokens-1) > 1e-9 {
+		t.Errorf("expected a refilled bucket, got %+v, %v", got, err)
+	}
+}
+
+func TestDeleteIdleRateLimitBuckets(t *testing.T) {
+	f := newFixture(t)
+	for _, key := range []string{"idle", "busy"} {
+		if _, err := f.q.TakeRateLimitToken(f.ctx, db.TakeRateLimitTokenParams{BucketKey: key, Burst: 5, Rate: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f.exec(`UPDATE rate_limit_buckets SET updated_at = NOW() - INTERVAL '1 hour' WHERE bucket_key = 'idle'`)
+
+	rows, err := f.q.DeleteIdleRateLimitBuckets(f.ctx, time.Now().Add(-time.Minute))
+	if err != nil || rows != 1 {
+		t.Fatalf("DeleteIdleRateLimitBuckets = %d, %v, want 1", rows, err)
+	}
+	// The idle bucket starts over at its burst.
+	got, err := f.q.TakeRateLimitToken(f.ctx, db.TakeRateLimitTokenParams{BucketKey: "idle", Burst: 5, Rate: 0})
+	if err != nil || got.Tokens != 4 {
+		t.Errorf("expected a new bucket, got %+v, %v", got, err)
+	}
+}
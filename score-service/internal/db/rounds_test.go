@@ -0,0 +1,215 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestQualificationRounds(t *testing.T) {
+	f := newFixture(t)
+	template := f.template("WA 70m")
+	start := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	round, err := f.q.CreateQualificationRound(f.ctx, db.CreateQualificationRoundParams{
+		ExternalUserID:  "archer",
+		RoundType:       "qualification",
+		Name:            "Club record attempt",
+		Distance:        70,
+		TotalSets:       12,
+		ShotsPerSet:     6,
+		TargetFaceID:    template.TargetFaceID,
+		Notes:           text("windy"),
+		StartTime:       pgtype.Timestamptz{Time: start, Valid: true},
+		BowClass:        "compound",
+		RoundTemplateID: pgID(template.ID),
+		AgeCategory:     "50+",
+		Gender:          text("female"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if round.ExternalUserID != "archer" || round.Name != "Club record attempt" || round.BowClass != "compound" ||
+		round.AgeCategory != "50+" || round.Gender != text("female") || round.RoundTemplateID != pgID(template.ID) ||
+		!round.StartTime.Time.Equal(start) || round.Notes != text("windy") {
+		t.Errorf("round not stored as given: %+v", round)
+	}
+	if round.TotalScore != 0 || round.ShotsCount != 0 || round.EndTime.Valid || round.Handicap.Valid {
+		t.Errorf("expected a new round to be empty and open: %+v", round)
+	}
+
+	if got := f.getRound(round.ID); got.ID != round.ID || got.Name != round.Name {
+		t.Errorf("GetQualificationRound = %+v", got)
+	}
+
+	// Completing sets the end time once; the handicap can be replaced.
+	completed, err := f.q.CompleteQualificationRound(f.ctx, db.CompleteQualificationRoundParams{
+		ID:       round.ID,
+		Handicap: pgtype.Int4{Int32: 42, Valid: true},
+	})
+	if err != nil || !completed.EndTime.Valid || completed.Handicap.Int32 != 42 {
+		t.Fatalf("CompleteQualificationRound = %+v, %v", completed, err)
+	}
+	again, err := f.q.CompleteQualificationRound(f.ctx, db.CompleteQualificationRoundParams{ID: round.ID})
+	if err != nil || !again.EndTime.Time.Equal(completed.EndTime.Time) || again.Handicap.Valid {
+		t.Errorf("expected the end time to stay and the handicap to be cleared: %+v, %v", again, err)
+	}
+
+	// Deleted rounds are gone.
+	f.exec(`UPDATE qualification_rounds SET deleted_at = NOW() WHERE id = $1`, round.ID)
+	_, err = f.q.GetQualificationRound(f.ctx, round.ID)
+	expectNoRows(t, err)
+	_, err = f.q.CompleteQualificationRound(f.ctx, db.CompleteQualificationRoundParams{ID: round.ID})
+	expectNoRows(t, err)
+	_, err = f.q.GetQualificationRound(f.ctx, uuid.New())
+	expectNoRows(t, err)
+}
+
+func TestGetQualificationRoundsForUser(t *testing.T) {
+	f := newFixture(t)
+	first := f.round("archer", wa122)
+	second := f.round("archer", wa80)
+	deleted := f.round("archer", wa122)
+	f.round("other", wa122)
+	f.exec(`UPDATE qualification_rounds SET deleted_at = NOW() WHERE id = $1`, deleted.ID)
+
+	tests := []struct {
+		user string
+		want []uuid.UUID
+	}{
+		{"archer", []uuid.UUID{first.ID, second.ID}},
+		{"nobody", nil},
+	}
+	for _, tt := range tests {
+		rounds, err := f.q.GetQualificationRoundsForUser(f.ctx, tt.user)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[uuid.UUID]bool)
+		for _, r := range rounds {
+			got[r.ID] = true
+		}
+		if len(rounds) != len(tt.want) {
+			t.Errorf("%s: expected %d rounds, got %d", tt.user, len(tt.want), len(rounds))
+		}
+		for _, id := range tt.want {
+			if !got[id] {
+				t.Errorf("%s: round %s missing", tt.user, id)
+			}
+		}
+	}
+}
+
+func TestSets(t *testing.T) {
+	f := newFixture(t)
+	round := f.round("archer", wa122)
+	// Created out of order, listed by number.
+	third, first := f.set(round.ID, 3, 6), f.set(round.ID, 1, 3)
+	deleted := f.set(round.ID, 2, 6)
+	f.exec(`UPDATE sets SET deleted_at = NOW() WHERE id = $1`, deleted.ID)
+
+	if first.SetNumber != 1 || first.MaxShots != 3 || first.ParentRoundID != pgID(round.ID) || first.ShotsCount != 0 {
+		t.Errorf("set not stored as given: %+v", first)
+	}
+	if got := f.getSet(third.ID); got.SetNumber != 3 {
+		t.Errorf("GetSet = %+v", got)
+	}
+	_, err := f.q.GetSet(f.ctx, deleted.ID)
+	expectNoRows(t, err)
+
+	sets, err := f.q.GetSetsForQualificationRound(f.ctx, pgID(round.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sets) != 2 || sets[0].ID != first.ID || sets[1].ID != third.ID {
+		t.Errorf("expected ends 1 and 3, got %+v", sets)
+	}
+
+	// A set needs a round or a match.
+	_, err = f.q.CreateSet(f.ctx, db.CreateSetParams{SetNumber: 1, MaxShots: 6})
+	expectCode(t, err, "23514")
+}
+
+func TestGetSetOwner(t *testing.T) {
+	f := newFixture(t)
+	round := f.round("archer", wa122)
+	set := f.set(round.ID, 1, 6)
+	other := f.round("other", wa122)
+
+	owner, err := f.q.GetSetOwner(f.ctx, db.GetSetOwnerParams{ID: set.ID, ParentRoundID: pgID(round.ID)})
+	if err != nil || owner != "archer" {
+		t.Errorf("GetSetOwner = %q, %v", owner, err)
+	}
+
+	// The set must belong to the round given.
+	_, err = f.q.GetSetOwner(f.ctx, db.GetSetOwnerParams{ID: set.ID, ParentRoundID: pgID(other.ID)})
+	expectNoRows(t, err)
+
+	f.exec(`UPDATE qualification_rounds SET deleted_at = NOW() WHERE id = $1`, round.ID)
+	_, err = f.q.GetSetOwner(f.ctx, db.GetSetOwnerParams{ID: set.ID, ParentRoundID: pgID(round.ID)})
+	expectNoRows(t, err)
+}
+
+func TestGetShotPositionsForUser(t *testing.T) {
+	f := newFixture(t)
+	training := f.round("archer", wa122)
+	indoor := f.round("archer", spot40, func(p *db.CreateQualificationRoundParams) {
+		p.RoundType, p.Distance = "qualification", 18
+	})
+	f.round("other", wa122)
+
+	end2, end1 := f.set(training.ID, 2, 6), f.set(training.ID, 1, 6)
+	f.shoot(end1.ID, [2]float64{0, 0}, [2]float64{100, 0})
+	f.shoot(end2.ID, [2]float64{0, 200})
+	deleted := f.shoot(end2.ID, [2]float64{10, 10})[0]
+	if _, err := f.q.DeleteShot(f.ctx, db.DeleteShotParams{ID: deleted.ID, SetID: end2.ID}); err != nil {
+		t.Fatal(err)
+	}
+	f.shoot(f.set(indoor.ID, 1, 5).ID, [2]float64{0, 50})
+
+	type position struct {
+		round      uuid.UUID
+		set, arrow int32
+		score      int32
+		x, y, dist float64
+	}
+	all := []position{
+		{training.ID, 1, 1, 10, 0, 0, 0},
+		{training.ID, 1, 2, 9, 100, 0, 100},
+		{training.ID, 2, 1, 7, 0, 200, 200},
+		{indoor.ID, 1, 1, 4, 0, 50, 50},
+	}
+
+	tests := []struct {
+		name   string
+		params db.GetShotPositionsForUserParams
+		want   []position
+	}{
+		{"all rounds", db.GetShotPositionsForUserParams{ExternalUserID: "archer"}, all},
+		{"by round type", db.GetShotPositionsForUserParams{ExternalUserID: "archer", RoundType: text("training")}, all[:3]},
+		{"by distance", db.GetShotPositionsForUserParams{ExternalUserID: "archer", Distance: pgtype.Int4{Int32: 18, Valid: true}}, all[3:]},
+		{"no match", db.GetShotPositionsForUserParams{ExternalUserID: "archer", RoundType: text("warmup")}, nil},
+		{"other archer", db.GetShotPositionsForUserParams{ExternalUserID: "other"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := f.q.GetShotPositionsForUser(f.ctx, tt.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("expected %d arrows, got %d", len(tt.want), len(rows))
+			}
+			for i, row := range rows {
+				got := position{row.RoundID, row.SetNumber, row.ArrowNumber, row.Score,
+					float(t, row.X), float(t, row.Y), float(t, row.DistanceFromCenter)}
+				if got != tt.want[i] {
+					t.Errorf("arrow %d = %+v, want %+v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: score-signatures.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createScoreSignature = `-- name: CreateScoreSignature :one
+INSERT INTO score_signatures (
+    round_id,
+    set_id,
+    signer_user_id,
+    signer_role,
+    reason,
+    scores_hash
+) VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, round_id, set_id, signer_user_id, signer_role, reason, scores_hash, signed_at
+`
+
+type CreateScoreSignatureParams struct {
+	RoundID      uuid.UUID   `json:"round_id"`
+	SetID        pgtype.UUID `json:"set_id"`
+	SignerUserID string      `json:"signer_user_id"`
+	SignerRole   string      `json:"signer_role"`
+	Reason       pgtype.Text `json:"reason"`
+	ScoresHash   string      `json:"scores_hash"`
+}
+
+func (q *Queries) CreateScoreSignature(ctx context.Context, arg CreateScoreSignatureParams) (ScoreSignature, error) {
+	row := q.db.QueryRow(ctx, createScoreSignature,
+		arg.RoundID,
+		arg.SetID,
+		arg.SignerUserID,
+		arg.SignerRole,
+		arg.Reason,
+		arg.ScoresHash,
+	)
+	var i ScoreSignature
+	err := row.Scan(
+		&i.ID,
+		&i.RoundID,
+		&i.SetID,
+		&i.SignerUserID,
+		&i.SignerRole,
+		&i.Reason,
+		&i.ScoresHash,
+		&i.SignedAt,
+	)
+	return i, err
+}
+
+const isSetSigned = `-- name: IsSetSigned :one
+SELECT EXISTS (
+    SELECT 1 FROM score_signatures
+    WHERE round_id = $1
+      AND (set_id IS NULL OR set_id = $2)
+)
+`
+
+type IsSetSignedParams struct {
+	RoundID uuid.UUID   `json:"round_id"`
+	SetID   pgtype.UUID `json:"set_id"`
+}
+
+// An end is signed when it or its whole round carries a signature.
+func (q *Queries) IsSetSigned(ctx context.Context, arg IsSetSignedParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isSetSigned, arg.RoundID, arg.SetID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listRoundShotsForVerification = `-- name: ListRoundShotsForVerification :many
+SELECT
+    s.id AS set_id,
+    s.set_number,
+    sh.id,
+    sh.x,
+    sh.y,
+    sh.score,
+    sh.is_x,
+    sh.is_miss
+FROM sets s
+         JOIN shots sh ON sh.set_id = s.id AND sh.deleted_at IS NULL
+WHERE s.parent_round_id = $1 AND s.deleted_at IS NULL
+ORDER BY s.set_number, s.id, sh.created_at, sh.id
+`
+
+type ListRoundShotsForVerificationRow struct {
+	SetID     uuid.UUID      `json:"set_id"`
+	SetNumber int32          `json:"set_number"`
+	ID        uuid.UUID      `json:"id"`
+	X         pgtype.Numeric `json:"x"`
+	Y         pgtype.Numeric `json:"y"`
+	Score     int32          `json:"score"`
+	IsX       bool           `json:"is_x"`
+	IsMiss    bool           `json:"is_miss"`
+}
+
+// Every scored arrow of a round in a stable order, for hashing.
+func (q *Queries) ListRoundShotsForVerification(ctx context.Context, parentRoundID pgtype.UUID) ([]ListRoundShotsForVerificationRow, error) {
+	rows, err := q.db.Query(ctx, listRoundShotsForVerification, parentRoundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListRoundShotsForVerificationRow{}
+	for rows.Next() {
+		var i ListRoundShotsForVerificationRow
+		if err := rows.Scan(
+			&i.SetID,
+			&i.SetNumber,
+			&i.ID,
+			&i.X,
+			&i.Y,
+			&i.Score,
+			&i.IsX,
+			&i.IsMiss,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScoreSignatures = `-- name: ListScoreSignatures :many
+SELECT id, round_id, set_id, signer_user_id, signer_role, reason, scores_hash, signed_at FROM score_signatures
+WHERE round_id = $1
+ORDER BY signed_at, id
+`
+
+func (q *Queries) ListScoreSignatures(ctx context.Context, roundID uuid.UUID) ([]ScoreSignature, error) {
+	rows, err := q.db.Query(ctx, listScoreSignatures, roundID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScoreSignature{}
+	for rows.Next() {
+		var i ScoreSignature
+		if err := rows.Scan(
+			&i.ID,
+			&i.RoundID,
+			&i.SetID,
+			&i.SignerUserID,
+			&i.SignerRole,
+			&i.Reason,
+			&i.ScoresHash,
+			&i.SignedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockRoundScores = `-- name: LockRoundScores :exec
+SELECT 1 FROM qualification_rounds
+WHERE id = $1
+FOR UPDATE
+`
+
+// Locks a round until the transaction ends, so that its scores are not signed
+// while they change nor changed while they are signed.
+func (q *Queries) LockRoundScores(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, lockRoundScores, id)
+	return err
+}
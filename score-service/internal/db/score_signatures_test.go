@@ -0,0 +1,152 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestScoreSignatures(t *testing.T) {
+	f := newFixture(t)
+	round := f.round("archer", wa122)
+	first, second := f.set(round.ID, 1, 6), f.set(round.ID, 2, 6)
+	sign := func(set pgtype.UUID, signer, role string, reason pgtype.Text) (db.ScoreSignature, error) {
+		return f.q.CreateScoreSignature(f.ctx, db.CreateScoreSignatureParams{
+			RoundID: round.ID, SetID: set, SignerUserID: signer, SignerRole: role, Reason: reason, ScoresHash: "hash",
+		})
+	}
+	isSigned := func(set uuid.UUID) bool {
+		ok, err := f.q.IsSetSigned(f.ctx, db.IsSetSignedParams{RoundID: round.ID, SetID: pgID(set)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ok
+	}
+
+	if isSigned(first.ID) {
+		t.Error("expected an unsigned end")
+	}
+	signature, err := sign(pgID(first.ID), "archer", "archer", pgtype.Text{})
+	if err != nil || signature.SetID != pgID(first.ID) || signature.ScoresHash != "hash" {
+		t.Fatalf("CreateScoreSignature = %+v, %v", signature, err)
+	}
+	if !isSigned(first.ID) || isSigned(second.ID) {
+		t.Error("expected only the signed end to be signed")
+	}
+
+	tests := []struct {
+		name   string
+		set    pgtype.UUID
+		signer string
+		role   string
+		reason pgtype.Text
+		code   string // empty when allowed
+	}{
+		{"witness of the same end", pgID(first.ID), "witness", "witness", pgtype.Text{}, ""},
+		{"archer again", pgID(first.ID), "archer", "archer", pgtype.Text{}, "23505"},
+		{"archer of the round", pgtype.UUID{}, "archer", "archer", pgtype.Text{}, ""},
+		{"archer of the round again", pgtype.UUID{}, "archer", "archer", pgtype.Text{}, "23505"},
+		{"judge without a reason", pgID(first.ID), "judge", "judge", pgtype.Text{}, "23514"},
+		{"judge", pgID(first.ID), "judge", "judge", text("line call"), ""},
+		{"judge again", pgID(first.ID), "judge", "judge", text("second look"), ""},
+		{"unknown role", pgID(first.ID), "coach", "coach", pgtype.Text{}, "23514"},
+	}
+	for _, tt := range tests {
+		_, err := sign(tt.set, tt.signer, tt.role, tt.reason)
+		if tt.code == "" {
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+			}
+			continue
+		}
+		expectCode(t, err, tt.code)
+	}
+
+	// A round signature signs every end.
+	if !isSigned(second.ID) {
+		t.Error("expected the round signature to cover the second end")
+	}
+
+	signatures, err := f.q.ListScoreSignatures(f.ctx, round.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"archer", "witness", "archer", "judge", "judge"}
+	if len(signatures) != len(want) {
+		t.Fatalf("expected %d signatures, got %d", len(want), len(signatures))
+	}
+	for i, s := range signatures {
+		if s.SignerRole != want[i] {
+			t.Errorf("signature %d by %s, want %s in order of signing", i, s.SignerRole, want[i])
+		}
+	}
+}
+
+func TestLockRoundScores(t *testing.T) {
+	f := newFixture(t)
+	round := f.round("archer", wa122)
+	lock := func(ctx context.Context) error {
+		return f.q.InTx(ctx, func(q *db.Queries) error { return q.LockRoundScores(ctx, round.ID) })
+	}
+
+	err := f.q.InTx(f.ctx, func(q *db.Queries) error {
+		if err := q.LockRoundScores(f.ctx, round.ID); err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(f.ctx, 200*time.Millisecond)
+		defer cancel()
+		if err := lock(ctx); !pgconn.Timeout(err) {
+			t.Errorf("expected a second lock to wait for the first, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := lock(f.ctx); err != nil {
+		t.Errorf("expected the lock to be released with its transaction, got %v", err)
+	}
+}
+
+func TestListRoundShotsForVerification(t *testing.T) {
+	f := newFixture(t)
+	round := f.round("archer", wa122)
+	second, first := f.set(round.ID, 2, 6), f.set(round.ID, 1, 6)
+	f.shoot(first.ID, [2]float64{0, 0}, [2]float64{0, 700})
+	deleted := f.shoot(first.ID, [2]float64{10, 10})[0]
+	if _, err := f.q.DeleteShot(f.ctx, db.DeleteShotParams{ID: deleted.ID, SetID: first.ID}); err != nil {
+		t.Fatal(err)
+	}
+	f.shoot(second.ID, [2]float64{100, 0})
+	f.shoot(f.set(f.round("archer", wa122).ID, 1, 6).ID, [2]float64{0, 0})
+
+	rows, err := f.q.ListRoundShotsForVerification(f.ctx, pgID(round.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	type arrow struct {
+		set         int32
+		x, y        float64
+		score       int32
+		isX, isMiss bool
+	}
+	want := []arrow{
+		{1, 0, 0, 10, true, false},
+		{1, 0, 700, 0, false, true},
+		{2, 100, 0, 9, false, false},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("expected %d arrows, got %d", len(want), len(rows))
+	}
+	for i, row := range rows {
+		got := arrow{row.SetNumber, float(t, row.X), float(t, row.Y), row.Score, row.IsX, row.IsMiss}
+		if got != want[i] {
+			t.Errorf("arrow %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
@@ -81,6 +81,26 @@ func (q *Queries) GetSet(ctx context.Context, id uuid.UUID) (Set, error) {
 	return i, err
 }
 
+const getSetOwner = `-- name: GetSetOwner :one
+SELECT qr.external_user_id
+FROM sets s
+         JOIN qualification_rounds qr ON qr.id = s.parent_round_id
+WHERE s.id = $1 AND s.parent_round_id = $2 AND s.deleted_at IS NULL AND qr.deleted_at IS NULL
+`
+
+type GetSetOwnerParams struct {
+	ID            uuid.UUID   `json:"id"`
+	ParentRoundID pgtype.UUID `json:"parent_round_id"`
+}
+
+// Returns the archer who owns the round the set belongs to.
+func (q *Queries) GetSetOwner(ctx context.Context, arg GetSetOwnerParams) (string, error) {
+	row := q.db.QueryRow(ctx, getSetOwner, arg.ID, arg.ParentRoundID)
+	var external_user_id string
+	err := row.Scan(&external_user_id)
+	return external_user_id, err
+}
+
 const getSetsForQualificationRound = `-- name: GetSetsForQualificationRound :many
 SELECT id, set_number, max_shots, total_score, average_score, shots_count, ten_count, x_count, miss_count, grouping_diameter, grouping_center_x, grouping_center_y, parent_round_id, parent_match_id, created_at, updated_at, deleted_at FROM sets WHERE parent_round_id = $1 AND deleted_at IS NULL ORDER BY set_number
 `
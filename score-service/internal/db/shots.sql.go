@@ -13,6 +13,29 @@ import (
 )
 
 const batchCreateShots = `-- name: BatchCreateShots :many
+WITH target_info AS (
+    SELECT qr.target_face_id
+    FROM qualification_rounds qr
+             JOIN sets s ON qr.id = s.parent_round_id
+    WHERE s.id = $1 AND qr.deleted_at IS NULL AND s.deleted_at IS NULL
+    LIMIT 1
+), input AS (
+    SELECT
+        i.x,
+        i.y,
+        i.notes,
+        i.ord,
+        calculate_shot_score(i.x, i.y, target_info.target_face_id) AS score
+    FROM (
+             SELECT
+                 ($2::DECIMAL[])[ord] AS x,
+                 ($3::DECIMAL[])[ord] AS y,
+                 ($4::TEXT[])[ord] AS notes,
+                 ord
+             FROM generate_subscripts($2::DECIMAL[], 1) AS ord
+         ) i
+             CROSS JOIN target_info
+)
 INSERT INTO shots (
     x,
     y,
@@ -21,40 +44,41 @@ INSERT INTO shots (
     is_ten,
     is_x,
     is_miss,
-    set_id
-) SELECT
-      unnest($1::DECIMAL[]),
-      unnest($2::DECIMAL[]),
-      unnest($3::INTEGER[]),
-      unnest($4::DECIMAL[]),
-      unnest($5::BOOLEAN[]),
-      unnest($6::BOOLEAN[]),
-      unnest($7::BOOLEAN[]),
-      $8
-          RETURNING id, x, y, score, distance_from_center, is_ten, is_x, is_miss, notes, set_id, created_at, updated_at, deleted_at
+    notes,
+    set_id,
+    created_at
+)
+SELECT
+    x,
+    y,
+    score,
+    SQRT(POWER(x, 2) + POWER(y, 2)),
+    score = 10,
+    score = 10 AND SQRT(POWER(x, 2) + POWER(y, 2)) < 30.5,
+    score = 0,
+    NULLIF(notes, ''),
+    $1,
+    clock_timestamp()
+FROM input
+ORDER BY ord
+    RETURNING id, x, y, score, distance_from_center, is_ten, is_x, is_miss, notes, set_id, created_at, updated_at, deleted_at
 `
 
 type BatchCreateShotsParams struct {
-	Column1 []pgtype.Numeric `json:"column_1"`
-	Column2 []pgtype.Numeric `json:"column_2"`
-	Column3 []int32          `json:"column_3"`
-	Column4 []pgtype.Numeric `json:"column_4"`
-	Column5 []bool           `json:"column_5"`
-	Column6 []bool           `json:"column_6"`
-	Column7 []bool           `json:"column_7"`
-	SetID   uuid.UUID        `json:"set_id"`
+	SetID uuid.UUID        `json:"set_id"`
+	Xs    []pgtype.Numeric `json:"xs"`
+	Ys    []pgtype.Numeric `json:"ys"`
+	Notes []string         `json:"notes"`
 }
 
+// Scores every shot against the round's target face and inserts them in a
+// single statement, so set and round statistics are refreshed once.
 func (q *Queries) BatchCreateShots(ctx context.Context, arg BatchCreateShotsParams) ([]Shot, error) {
 	rows, err := q.db.Query(ctx, batchCreateShots,
-		arg.Column1,
-		arg.Column2,
-		arg.Column3,
-		arg.Column4,
-		arg.Column5,
-		arg.Column6,
-		arg.Column7,
 		arg.SetID,
+		arg.Xs,
+		arg.Ys,
+		arg.Notes,
 	)
 	if err != nil {
 		return nil, err
@@ -90,13 +114,12 @@ func (q *Queries) BatchCreateShots(ctx context.Context, arg BatchCreateShotsPara
 
 const createShot = `-- name: CreateShot :one
 WITH target_info AS (
-    SELECT zones_config, max_score, has_x
-    FROM target_faces tf
-             JOIN qualification_rounds qr ON tf.id = qr.target_face_id
+    SELECT calculate_shot_score($1::DECIMAL, $2::DECIMAL, qr.target_face_id) AS score
+    FROM qualification_rounds qr
              JOIN sets s ON qr.id = s.parent_round_id
-    WHERE s.id = $4 AND tf.deleted_at IS NULL AND qr.deleted_at IS NULL AND s.deleted_at IS NULL
+    WHERE s.id = $4 AND qr.deleted_at IS NULL AND s.deleted_at IS NULL
     LIMIT 1
-    )
+)
 INSERT INTO shots (
     x,
     y,
@@ -107,34 +130,33 @@ INSERT INTO shots (
     is_miss,
     notes,
     set_id
-) VALUES (
-    $1,
-    $2,
-    $3,
-    SQRT(POWER($1, 2) + POWER($2, 2)),
-    (SELECT calculate_shot_score($1, $2, (SELECT target_face_id FROM qualification_rounds qr JOIN sets s ON qr.id = s.parent_round_id WHERE s.id = $4 LIMIT 1))) = 10,
-    (SELECT calculate_shot_score($1, $2, (SELECT target_face_id FROM qualification_rounds qr JOIN sets s ON qr.id = s.parent_round_id WHERE s.id = $4 LIMIT 1))) = 10
-    AND SQRT(POWER($1, 2) + POWER($2, 2)) < 30.5,
-    (SELECT calculate_shot_score($1, $2, (SELECT target_face_id FROM qualification_rounds qr JOIN sets s ON qr.id = s.parent_round_id WHERE s.id = $4 LIMIT 1))) = 0,
-    $4,
-    $5
 )
+SELECT
+    $1::DECIMAL,
+    $2::DECIMAL,
+    score,
+    SQRT(POWER($1::DECIMAL, 2) + POWER($2::DECIMAL, 2)),
+    score = 10,
+    score = 10 AND SQRT(POWER($1::DECIMAL, 2) + POWER($2::DECIMAL, 2)) < 30.5,
+    score = 0,
+    $3,
+    $4
+FROM target_info
     RETURNING id, x, y, score, distance_from_center, is_ten, is_x, is_miss, notes, set_id, created_at, updated_at, deleted_at
 `
 
 type CreateShotParams struct {
 	X     pgtype.Numeric `json:"x"`
 	Y     pgtype.Numeric `json:"y"`
-	Score int32          `json:"score"`
 	Notes pgtype.Text    `json:"notes"`
 	SetID uuid.UUID      `json:"set_id"`
 }
 
+// The score is always calculated from the coordinates and the round's target face.
 func (q *Queries) CreateShot(ctx context.Context, arg CreateShotParams) (Shot, error) {
 	row := q.db.QueryRow(ctx, createShot,
 		arg.X,
 		arg.Y,
-		arg.Score,
 		arg.Notes,
 		arg.SetID,
 	)
@@ -157,6 +179,24 @@ func (q *Queries) CreateShot(ctx context.Context, arg CreateShotParams) (Shot, e
 	return i, err
 }
 
+const deleteShot = `-- name: DeleteShot :execrows
+UPDATE shots SET deleted_at = NOW()
+WHERE id = $1 AND set_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteShotParams struct {
+	ID    uuid.UUID `json:"id"`
+	SetID uuid.UUID `json:"set_id"`
+}
+
+func (q *Queries) DeleteShot(ctx context.Context, arg DeleteShotParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteShot, arg.ID, arg.SetID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getShot = `-- name: GetShot :one
 SELECT id, x, y, score, distance_from_center, is_ten, is_x, is_miss, notes, set_id, created_at, updated_at, deleted_at FROM shots
 WHERE id = $1 AND deleted_at IS NULL
@@ -222,3 +262,60 @@ func (q *Queries) GetShotsBySet(ctx context.Context, setID uuid.UUID) ([]Shot, e
 	}
 	return items, nil
 }
+
+const updateShot = `-- name: UpdateShot :one
+UPDATE shots sh
+SET x = $1::DECIMAL,
+    y = $2::DECIMAL,
+    score = t.score,
+    distance_from_center = SQRT(POWER($1::DECIMAL, 2) + POWER($2::DECIMAL, 2)),
+    is_ten = t.score = 10,
+    is_x = t.score = 10 AND SQRT(POWER($1::DECIMAL, 2) + POWER($2::DECIMAL, 2)) < 30.5,
+    is_miss = t.score = 0,
+    notes = $3
+FROM (
+         SELECT calculate_shot_score($1::DECIMAL, $2::DECIMAL, qr.target_face_id) AS score
+         FROM qualification_rounds qr
+                  JOIN sets s ON qr.id = s.parent_round_id
+         WHERE s.id = $5 AND qr.deleted_at IS NULL AND s.deleted_at IS NULL
+         LIMIT 1
+     ) t
+WHERE sh.id = $4 AND sh.set_id = $5 AND sh.deleted_at IS NULL
+    RETURNING sh.id, sh.x, sh.y, sh.score, sh.distance_from_center, sh.is_ten, sh.is_x, sh.is_miss, sh.notes, sh.set_id, sh.created_at, sh.updated_at, sh.deleted_at
+`
+
+type UpdateShotParams struct {
+	X     pgtype.Numeric `json:"x"`
+	Y     pgtype.Numeric `json:"y"`
+	Notes pgtype.Text    `json:"notes"`
+	ID    uuid.UUID      `json:"id"`
+	SetID uuid.UUID      `json:"set_id"`
+}
+
+// Moves a shot and rescores it against the round's target face.
+func (q *Queries) UpdateShot(ctx context.Context, arg UpdateShotParams) (Shot, error) {
+	row := q.db.QueryRow(ctx, updateShot,
+		arg.X,
+		arg.Y,
+		arg.Notes,
+		arg.ID,
+		arg.SetID,
+	)
+	var i Shot
+	err := row.Scan(
+		&i.ID,
+		&i.X,
+		&i.Y,
+		&i.Score,
+		&i.DistanceFromCenter,
+		&i.IsTen,
+		&i.IsX,
+		&i.IsMiss,
+		&i.Notes,
+		&i.SetID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,196 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Shots are scored by calculate_shot_score from their coordinates: the score
+// of the smallest zone whose radius reaches the arrow, 0 outside the face. An
+// X is a 10 within 30.5 mm of the centre.
+func TestCreateShot_Scoring(t *testing.T) {
+	f := newFixture(t)
+
+	tests := []struct {
+		name     string
+		face     string
+		x, y     float64
+		score    int32
+		distance float64
+		ten, x10 bool
+	}{
+		{"centre", wa122, 0, 0, 10, 0, true, true},
+		{"inside the X ring", wa122, 30, 0, 10, 30, true, true},
+		{"on the X ring", wa122, 0, -30.5, 10, 30.5, true, false},
+		{"on the 10 line", wa122, 61, 0, 10, 61, true, false},
+		{"just outside the 10", wa122, 61.01, 0, 9, 61.01, false, false},
+		{"diagonal", wa122, 300, 400, 2, 500, false, false},
+		{"on the edge", wa122, -610, 0, 1, 610, false, false},
+		{"off the face", wa122, 0, -610.01, 0, 610.01, false, false},
+		{"small face", wa80, 0, 81, 8, 81, false, false},
+		{"3-spot centre", spot40, 0, 0, 5, 0, false, false},
+		{"3-spot edge", spot40, 120, -160, 1, 200, false, false},
+		{"3-spot miss", spot40, 0, 200.5, 0, 200.5, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := f.with(t)
+			set := f.set(f.round("archer", tt.face).ID, 1, 6)
+
+			shot, err := f.q.CreateShot(f.ctx, db.CreateShotParams{
+				X:     number(t, tt.x),
+				Y:     number(t, tt.y),
+				Notes: text("note"),
+				SetID: set.ID,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if shot.Score != tt.score || shot.IsTen != tt.ten || shot.IsX != tt.x10 || shot.IsMiss != (tt.score == 0) {
+				t.Errorf("got score %d ten %v x %v miss %v, want %d %v %v %v",
+					shot.Score, shot.IsTen, shot.IsX, shot.IsMiss, tt.score, tt.ten, tt.x10, tt.score == 0)
+			}
+			if d := float(t, shot.DistanceFromCenter); !equal(d, tt.distance) {
+				t.Errorf("distance from centre = %v, want %v", d, tt.distance)
+			}
+			if shot.Notes != text("note") || shot.SetID != set.ID {
+				t.Errorf("unexpected notes %v or set %v", shot.Notes, shot.SetID)
+			}
+		})
+	}
+}
+
+func TestBatchCreateShots(t *testing.T) {
+	f := newFixture(t)
+	set := f.set(f.round("archer", wa122).ID, 1, 6)
+
+	shots, err := f.q.BatchCreateShots(f.ctx, db.BatchCreateShotsParams{
+		SetID: set.ID,
+		Xs:    []pgtype.Numeric{number(t, 0), number(t, 100), number(t, 0)},
+		Ys:    []pgtype.Numeric{number(t, 0), number(t, 0), number(t, 700)},
+		Notes: []string{"", "pulled right", ""},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		score int32
+		notes string
+	}{{10, ""}, {9, "pulled right"}, {0, ""}}
+	if len(shots) != len(want) {
+		t.Fatalf("expected %d shots, got %d", len(want), len(shots))
+	}
+	for i, shot := range shots {
+		if shot.Score != want[i].score || shot.Notes.String != want[i].notes || shot.Notes.Valid != (want[i].notes != "") {
+			t.Errorf("shot %d: got score %d notes %v, want %d %q", i, shot.Score, shot.Notes, want[i].score, want[i].notes)
+		}
+	}
+
+	// The arrows keep the order they were shot in.
+	got, err := f.q.GetShotsBySet(f.ctx, set.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range got {
+		if got[i].ID != shots[i].ID {
+			t.Fatalf("GetShotsBySet returned the shots out of order")
+		}
+	}
+}
+
+// The queries only score shots of live sets in live rounds; the services
+// check that before writing.
+func TestShots_DeletedRound(t *testing.T) {
+	f := newFixture(t)
+	round := f.round("archer", wa122)
+	set := f.set(round.ID, 1, 6)
+	shot := f.shoot(set.ID, [2]float64{0, 0})[0]
+	f.exec(`UPDATE qualification_rounds SET deleted_at = NOW() WHERE id = $1`, round.ID)
+
+	_, err := f.q.CreateShot(f.ctx, db.CreateShotParams{X: number(t, 0), Y: number(t, 0), SetID: set.ID})
+	expectNoRows(t, err)
+
+	shots, err := f.q.BatchCreateShots(f.ctx, db.BatchCreateShotsParams{
+		SetID: set.ID,
+		Xs:    []pgtype.Numeric{number(t, 0)},
+		Ys:    []pgtype.Numeric{number(t, 0)},
+		Notes: []string{""},
+	})
+	if err != nil || len(shots) != 0 {
+		t.Errorf("expected no shots to be created, got %d, %v", len(shots), err)
+	}
+
+	_, err = f.q.UpdateShot(f.ctx, db.UpdateShotParams{X: number(t, 1), Y: number(t, 1), ID: shot.ID, SetID: set.ID})
+	expectNoRows(t, err)
+}
+
+func TestUpdateShot(t *testing.T) {
+	f := newFixture(t)
+	set := f.set(f.round("archer", wa122).ID, 1, 6)
+	shot := f.shoot(set.ID, [2]float64{0, 700})[0]
+
+	updated, err := f.q.UpdateShot(f.ctx, db.UpdateShotParams{
+		X:     number(t, -3),
+		Y:     number(t, 4),
+		Notes: text("moved"),
+		ID:    shot.ID,
+		SetID: set.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Score != 10 || !updated.IsTen || !updated.IsX || updated.IsMiss ||
+		!equal(float(t, updated.DistanceFromCenter), 5) || updated.Notes != text("moved") {
+		t.Errorf("shot not rescored: %+v", updated)
+	}
+	if !updated.UpdatedAt.After(shot.UpdatedAt) {
+		t.Errorf("expected updated_at to move, %v then %v", shot.UpdatedAt, updated.UpdatedAt)
+	}
+
+	// A shot is only found in its own set.
+	other := f.set(f.round("archer", wa122).ID, 1, 6)
+	_, err = f.q.UpdateShot(f.ctx, db.UpdateShotParams{X: number(t, 0), Y: number(t, 0), ID: shot.ID, SetID: other.ID})
+	expectNoRows(t, err)
+}
+
+func TestGetAndDeleteShot(t *testing.T) {
+	f := newFixture(t)
+	set := f.set(f.round("archer", wa122).ID, 1, 6)
+	shots := f.shoot(set.ID, [2]float64{0, 0}, [2]float64{100, 0})
+
+	got, err := f.q.GetShot(f.ctx, shots[0].ID)
+	if err != nil || got.ID != shots[0].ID || got.Score != 10 {
+		t.Fatalf("GetShot = %+v, %v", got, err)
+	}
+
+	tests := []struct {
+		name  string
+		id    uuid.UUID
+		setID uuid.UUID
+		rows  int64
+	}{
+		{"wrong set", shots[0].ID, uuid.New(), 0},
+		{"unknown shot", uuid.New(), set.ID, 0},
+		{"deleted", shots[0].ID, set.ID, 1},
+		{"already deleted", shots[0].ID, set.ID, 0},
+	}
+	for _, tt := range tests {
+		rows, err := f.q.DeleteShot(f.ctx, db.DeleteShotParams{ID: tt.id, SetID: tt.setID})
+		if err != nil || rows != tt.rows {
+			t.Errorf("%s: DeleteShot = %d, %v, want %d", tt.name, rows, err, tt.rows)
+		}
+	}
+
+	_, err = f.q.GetShot(f.ctx, shots[0].ID)
+	expectNoRows(t, err)
+	remaining, err := f.q.GetShotsBySet(f.ctx, set.ID)
+	if err != nil || len(remaining) != 1 || remaining[0].ID != shots[1].ID {
+		t.Errorf("expected only the second shot to remain, got %d, %v", len(remaining), err)
+	}
+}
@@ -0,0 +1,46 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: statistics.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listQualificationRoundIDs = `-- name: ListQualificationRoundIDs :many
+SELECT id FROM qualification_rounds ORDER BY created_at
+`
+
+func (q *Queries) ListQualificationRoundIDs(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listQualificationRoundIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const refreshRoundStatistics = `-- name: RefreshRoundStatistics :exec
+SELECT refresh_set_statistics(ARRAY(SELECT id FROM sets WHERE parent_round_id = $1)::UUID[])
+`
+
+func (q *Queries) RefreshRoundStatistics(ctx context.Context, parentRoundID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, refreshRoundStatistics, parentRoundID)
+	return err
+}
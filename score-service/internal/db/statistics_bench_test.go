@@ -0,0 +1,190 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// benchRound creates a round of empty ends of six arrows on the 122 cm face.
+func (f *fixture) benchRound(ends int) (db.QualificationRound, []uuid.UUID) {
+	f.t.Helper()
+	round := f.round("benchmark", wa122, func(p *db.CreateQualificationRoundParams) { p.TotalSets = int32(ends) })
+	setIDs := make([]uuid.UUID, ends)
+	for i := range setIDs {
+		setIDs[i] = f.set(round.ID, int32(i+1), 6).ID
+	}
+	return round, setIDs
+}
+
+// endParams draws an end of six arrows for BatchCreateShots.
+func endParams(tb testing.TB, rng *rand.Rand, setID uuid.UUID) db.BatchCreateShotsParams {
+	params := db.BatchCreateShotsParams{SetID: setID, Notes: make([]string, 6)}
+	for range 6 {
+		params.Xs = append(params.Xs, number(tb, float64(int(rng.NormFloat64()*6000))/100))
+		params.Ys = append(params.Ys, number(tb, float64(int(rng.NormFloat64()*6000))/100))
+	}
+	return params
+}
+
+// The statistics triggers run once per statement and update only the sets
+// the statement touched and their round, so the rows they write grow with
+// the statements and sets, never with the arrows already in the round. The
+// counts come from pg_stat_xact_user_tables, which covers the open
+// transaction only.
+func TestShotTriggers_WorkIsLinear(t *testing.T) {
+	f := newFixture(t)
+	rng := rand.New(rand.NewSource(1))
+
+	updates := func(t *testing.T, tx pgx.Tx) (sets, rounds int64) {
+		t.Helper()
+		err := tx.QueryRow(f.ctx, `
+			SELECT
+				COALESCE(SUM(n_tup_upd) FILTER (WHERE relname = 'sets'), 0),
+				COALESCE(SUM(n_tup_upd) FILTER (WHERE relname = 'qualification_rounds'), 0)
+			FROM pg_stat_xact_user_tables`).Scan(&sets, &rounds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sets, rounds
+	}
+
+	for _, ends := range []int{6, 12, 24} {
+		t.Run(fmt.Sprintf("ends=%d", ends), func(t *testing.T) {
+			f := f.with(t)
+			_, setIDs := f.benchRound(ends)
+
+			t.Run("one statement per end", func(t *testing.T) {
+				tx, err := f.pool.Begin(f.ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer tx.Rollback(f.ctx)
+				q := f.q.WithTx(tx)
+				for _, setID := range setIDs {
+					if _, err := q.BatchCreateShots(f.ctx, endParams(t, rng, setID)); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if sets, rounds := updates(t, tx); sets != int64(ends) || rounds != int64(ends) {
+					t.Errorf("%d ends updated sets %d times and the round %d times, want %d each", ends, sets, rounds, ends)
+				}
+			})
+
+			t.Run("one statement for the round", func(t *testing.T) {
+				tx, err := f.pool.Begin(f.ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer tx.Rollback(f.ctx)
+				insertShots(t, f, tx, roundShots(t, rng, setIDs))
+				if sets, rounds := updates(t, tx); sets != int64(ends) || rounds != 1 {
+					t.Errorf("%d ends updated sets %d times and the round %d times, want %d and 1", ends, sets, rounds, ends)
+				}
+			})
+		})
+	}
+}
+
+// arrowBatch holds arrows for several sets, inserted by insertShots.
+type arrowBatch struct {
+	setIDs []uuid.UUID
+	xs, ys []pgtype.Numeric
+}
+
+// roundShots draws an end of six arrows for every set.
+func roundShots(tb testing.TB, rng *rand.Rand, setIDs []uuid.UUID) arrowBatch {
+	var s arrowBatch
+	for _, setID := range setIDs {
+		params := endParams(tb, rng, setID)
+		for range params.Xs {
+			s.setIDs = append(s.setIDs, setID)
+		}
+		s.xs, s.ys = append(s.xs, params.Xs...), append(s.ys, params.Ys...)
+	}
+	return s
+}
+
+// insertShots adds the arrows in one statement spanning their sets, without
+// scoring, so only the triggers run per set.
+func insertShots(tb testing.TB, f *fixture, tx pgx.Tx, s arrowBatch) {
+	tb.Helper()
+	_, err := tx.Exec(f.ctx, `
+		INSERT INTO shots (x, y, score, distance_from_center, set_id)
+		SELECT x, y, 0, SQRT(POWER(x, 2) + POWER(y, 2)), set_id
+		FROM unnest($1::UUID[], $2::DECIMAL[], $3::DECIMAL[]) AS t(set_id, x, y)`,
+		s.setIDs, s.xs, s.ys)
+	if err != nil {
+		tb.Fatalf("failed to insert shots: %v", err)
+	}
+}
+
+// BenchmarkBatchInsertRound measures shot insertion with the statistics
+// triggers enabled, on a database from dbtest. TestShotTriggers_WorkIsLinear
+// checks that the trigger work per arrow is flat; the ns/arrow metric shows
+// what it costs.
+//
+//	go test -run '^$' -bench BatchInsertRound ./internal/db/
+func BenchmarkBatchInsertRound(b *testing.B) {
+	f := openFixture(b)
+	rng := rand.New(rand.NewSource(1))
+
+	for _, ends := range []int{6, 12, 24, 48} {
+		arrows := ends * 6
+
+		// One BatchCreateShots statement per end, as the batch endpoint does.
+		b.Run(fmt.Sprintf("per-end/arrows=%d", arrows), func(b *testing.B) {
+			f := f.with(b)
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				round, setIDs := f.benchRound(ends)
+				batches := make([]db.BatchCreateShotsParams, len(setIDs))
+				for i, setID := range setIDs {
+					batches[i] = endParams(b, rng, setID)
+				}
+				b.StartTimer()
+
+				for _, params := range batches {
+					if _, err := f.q.BatchCreateShots(f.ctx, params); err != nil {
+						b.Fatalf("failed to insert shots: %v", err)
+					}
+				}
+
+				b.StopTimer()
+				f.exec(`DELETE FROM qualification_rounds WHERE id = $1`, round.ID)
+				b.StartTimer()
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*arrows), "ns/arrow")
+		})
+
+		// The whole round in a single statement spanning every set.
+		b.Run(fmt.Sprintf("single-statement/arrows=%d", arrows), func(b *testing.B) {
+			f := f.with(b)
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				round, setIDs := f.benchRound(ends)
+				batch := roundShots(b, rng, setIDs)
+				tx, err := f.pool.Begin(f.ctx)
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.StartTimer()
+
+				insertShots(b, f, tx, batch)
+				if err := tx.Commit(f.ctx); err != nil {
+					b.Fatal(err)
+				}
+
+				b.StopTimer()
+				f.exec(`DELETE FROM qualification_rounds WHERE id = $1`, round.ID)
+				b.StartTimer()
+			}
+			b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*arrows), "ns/arrow")
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// setStats are the statistics the shot triggers keep on a set.
+type setStats struct {
+	shots, total, tens, xs, misses int32
+	average                        float64
+	diameter, centerX, centerY     float64 // NaN for NULL
+}
+
+func statsOfSet(t *testing.T, s db.Set) setStats {
+	return setStats{
+		shots:    s.ShotsCount,
+		total:    s.TotalScore,
+		tens:     s.TenCount,
+		xs:       s.XCount,
+		misses:   s.MissCount,
+		average:  float(t, s.AverageScore),
+		diameter: float(t, s.GroupingDiameter),
+		centerX:  float(t, s.GroupingCenterX),
+		centerY:  float(t, s.GroupingCenterY),
+	}
+}
+
+func (s setStats) equal(o setStats) bool {
+	return s.shots == o.shots && s.total == o.total && s.tens == o.tens && s.xs == o.xs && s.misses == o.misses &&
+		equal(s.average, o.average) && equal(s.diameter, o.diameter) &&
+		equal(s.centerX, o.centerX) && equal(s.centerY, o.centerY)
+}
+
+// roundStats are the statistics refresh_set_statistics keeps on a round.
+type roundStats struct {
+	shots, total, completed, tens, xs, misses int32
+	average                                   float64
+}
+
+func statsOfRound(t *testing.T, r db.QualificationRound) roundStats {
+	return roundStats{
+		shots:     r.ShotsCount,
+		total:     r.TotalScore,
+		completed: r.CompletedSets,
+		tens:      r.TenCount,
+		xs:        r.XCount,
+		misses:    r.MissCount,
+		average:   float(t, r.AverageScore),
+	}
+}
+
+func (r roundStats) equal(o roundStats) bool {
+	return r.shots == o.shots && r.total == o.total && r.completed == o.completed &&
+		r.tens == o.tens && r.xs == o.xs && r.misses == o.misses && equal(r.average, o.average)
+}
+
+var null = math.NaN()
+
+// Each step changes the shots of a round of two ends of three arrows on the
+// 122 cm face and checks the statistics the statement-level shot triggers
+// leave on both sets and on the round. The grouping diameter is twice the
+// root of the summed sample variances of x and y.
+func TestShotTriggers_Statistics(t *testing.T) {
+	f := newFixture(t)
+	round := f.round("archer", wa122, func(p *db.CreateQualificationRoundParams) { p.TotalSets, p.ShotsPerSet = 2, 3 })
+	first, second := f.set(round.ID, 1, 3), f.set(round.ID, 2, 3)
+	var shots []db.Shot
+
+	steps := []struct {
+		name          string
+		change        func(t *testing.T)
+		first, second setStats
+		round         roundStats
+	}{
+		{
+			name:   "empty",
+			change: func(t *testing.T) {},
+			first:  setStats{diameter: null, centerX: null, centerY: null},
+			second: setStats{diameter: null, centerX: null, centerY: null},
+		},
+		{
+			name:   "one arrow has no grouping diameter",
+			change: func(t *testing.T) { shots = f.with(t).shoot(first.ID, [2]float64{0, 0}) },
+			first:  setStats{shots: 1, total: 10, tens: 1, xs: 1, average: 10, diameter: null},
+			second: setStats{diameter: null, centerX: null, centerY: null},
+			round:  roundStats{shots: 1, total: 10, tens: 1, xs: 1, average: 10},
+		},
+		{
+			name: "a full end",
+			change: func(t *testing.T) {
+				shots = append(shots, f.with(t).shoot(first.ID, [2]float64{100, 0}, [2]float64{0, 700})...)
+			},
+			first:  setStats{shots: 3, total: 19, tens: 1, xs: 1, misses: 1, average: 6.33, diameter: 816.50, centerX: 33.33, centerY: 233.33},
+			second: setStats{diameter: null, centerX: null, centerY: null},
+			round:  roundStats{shots: 3, total: 19, completed: 1, tens: 1, xs: 1, misses: 1, average: 6.33},
+		},
+		{
+			name: "a partial end is not completed",
+			change: func(t *testing.T) {
+				f.with(t).shoot(second.ID, [2]float64{10, 5}, [2]float64{-10, -5})
+			},
+			first:  setStats{shots: 3, total: 19, tens: 1, xs: 1, misses: 1, average: 6.33, diameter: 816.50, centerX: 33.33, centerY: 233.33},
+			second: setStats{shots: 2, total: 20, tens: 2, xs: 2, average: 10, diameter: 31.62, centerX: 0, centerY: 0},
+			round:  roundStats{shots: 5, total: 39, completed: 1, tens: 3, xs: 3, misses: 1, average: 7.8},
+		},
+		{
+			name: "moving the miss rescores the end",
+			change: func(t *testing.T) {
+				if _, err := f.q.UpdateShot(f.ctx, db.UpdateShotParams{
+					X: number(t, 0), Y: number(t, 0), ID: shots[2].ID, SetID: first.ID,
+				}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			first:  setStats{shots: 3, total: 29, tens: 2, xs: 2, average: 9.67, diameter: 115.47, centerX: 33.33, centerY: 0},
+			second: setStats{shots: 2, total: 20, tens: 2, xs: 2, average: 10, diameter: 31.62, centerX: 0, centerY: 0},
+			round:  roundStats{shots: 5, total: 49, completed: 1, tens: 4, xs: 4, average: 9.8},
+		},
+		{
+			name: "deleting an arrow reopens the end",
+			change: func(t *testing.T) {
+				if _, err := f.q.DeleteShot(f.ctx, db.DeleteShotParams{ID: shots[0].ID, SetID: first.ID}); err != nil {
+					t.Fatal(err)
+				}
+			},
+			first:  setStats{shots: 2, total: 19, tens: 1, xs: 1, average: 9.5, diameter: 141.42, centerX: 50, centerY: 0},
+			second: setStats{shots: 2, total: 20, tens: 2, xs: 2, average: 10, diameter: 31.62, centerX: 0, centerY: 0},
+			round:  roundStats{shots: 4, total: 39, tens: 3, xs: 3, average: 9.75},
+		},
+		{
+			name: "an end without arrows is reset",
+			change: func(t *testing.T) {
+				for _, shot := range shots[1:] {
+					if _, err := f.q.DeleteShot(f.ctx, db.DeleteShotParams{ID: shot.ID, SetID: first.ID}); err != nil {
+						t.Fatal(err)
+					}
+				}
+			},
+			first:  setStats{diameter: null, centerX: null, centerY: null},
+			second: setStats{shots: 2, total: 20, tens: 2, xs: 2, average: 10, diameter: 31.62, centerX: 0, centerY: 0},
+			round:  roundStats{shots: 2, total: 20, tens: 2, xs: 2, average: 10},
+		},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			step.change(t)
+			f := f.with(t)
+
+			if got := statsOfSet(t, f.getSet(first.ID)); !got.equal(step.first) {
+				t.Errorf("first end = %+v, want %+v", got, step.first)
+			}
+			if got := statsOfSet(t, f.getSet(second.ID)); !got.equal(step.second) {
+				t.Errorf("second end = %+v, want %+v", got, step.second)
+			}
+			if got := statsOfRound(t, f.getRound(round.ID)); !got.equal(step.round) {
+				t.Errorf("round = %+v, want %+v", got, step.round)
+			}
+		})
+	}
+}
+
+// Statistics of one round do not leak into another.
+func TestShotTriggers_OtherRoundsUntouched(t *testing.T) {
+	f := newFixture(t)
+	mine := f.round("archer", wa122)
+	theirs := f.round("other", wa122)
+	f.shoot(f.set(mine.ID, 1, 6).ID, [2]float64{0, 0})
+	f.shoot(f.set(theirs.ID, 1, 6).ID, [2]float64{100, 0}, [2]float64{0, 130})
+
+	if got := f.getRound(mine.ID); got.ShotsCount != 1 || got.TotalScore != 10 {
+		t.Errorf("expected 1 arrow for 10, got %d for %d", got.ShotsCount, got.TotalScore)
+	}
+	if got := f.getRound(theirs.ID); got.ShotsCount != 2 || got.TotalScore != 17 {
+		t.Errorf("expected 2 arrows for 17, got %d for %d", got.ShotsCount, got.TotalScore)
+	}
+}
+
+// RefreshRoundStatistics repairs statistics that drifted from the shots.
+func TestRefreshRoundStatistics(t *testing.T) {
+	f := newFixture(t)
+	round := f.round("archer", wa122, func(p *db.CreateQualificationRoundParams) { p.ShotsPerSet = 2 })
+	first, second := f.set(round.ID, 1, 2), f.set(round.ID, 2, 2)
+	f.shoot(first.ID, [2]float64{0, 0}, [2]float64{100, 0})
+	f.shoot(second.ID, [2]float64{0, 200})
+
+	f.exec(`UPDATE sets SET total_score = 0, shots_count = 0, grouping_diameter = NULL WHERE parent_round_id = $1`, round.ID)
+	f.exec(`UPDATE qualification_rounds SET total_score = 0, shots_count = 0, completed_sets = 0 WHERE id = $1`, round.ID)
+
+	if err := f.q.RefreshRoundStatistics(f.ctx, pgID(round.ID)); err != nil {
+		t.Fatal(err)
+	}
+	if got := statsOfSet(t, f.getSet(first.ID)); !got.equal(setStats{
+		shots: 2, total: 19, tens: 1, xs: 1, average: 9.5, diameter: 141.42, centerX: 50, centerY: 0,
+	}) {
+		t.Errorf("first end = %+v", got)
+	}
+	if got := statsOfRound(t, f.getRound(round.ID)); !got.equal(roundStats{
+		shots: 3, total: 26, completed: 1, tens: 1, xs: 1, average: 8.67,
+	}) {
+		t.Errorf("round = %+v", got)
+	}
+}
+
+func TestListQualificationRoundIDs(t *testing.T) {
+	f := newFixture(t)
+	var want []uuid.UUID
+	for _, user := range []string{"archer", "other", "archer"} {
+		want = append(want, f.round(user, wa122).ID)
+	}
+	// Deleted rounds are listed too, so a backfill covers them.
+	f.exec(`UPDATE qualification_rounds SET deleted_at = NOW() WHERE id = $1`, want[1])
+
+	got, err := f.q.ListQualificationRoundIDs(f.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d rounds, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("round %d is %s, want %s in order of creation", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,235 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: target-faces.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createTargetFace = `-- name: CreateTargetFace :one
+INSERT INTO target_faces (
+    name,
+    standard,
+    total_diameter,
+    scoring_diameter,
+    zones_config,
+    max_score,
+    has_x,
+    description
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, name, standard, total_diameter, scoring_diameter, zones_config, max_score, has_x, description, created_at, updated_at, deleted_at
+`
+
+type CreateTargetFaceParams struct {
+	Name            string      `json:"name"`
+	Standard        string      `json:"standard"`
+	TotalDiameter   int32       `json:"total_diameter"`
+	ScoringDiameter int32       `json:"scoring_diameter"`
+	ZonesConfig     []byte      `json:"zones_config"`
+	MaxScore        int32       `json:"max_score"`
+	HasX            bool        `json:"has_x"`
+	Description     pgtype.Text `json:"description"`
+}
+
+func (q *Queries) CreateTargetFace(ctx context.Context, arg CreateTargetFaceParams) (TargetFace, error) {
+	row := q.db.QueryRow(ctx, createTargetFace,
+		arg.Name,
+		arg.Standard,
+		arg.TotalDiameter,
+		arg.ScoringDiameter,
+		arg.ZonesConfig,
+		arg.MaxScore,
+		arg.HasX,
+		arg.Description,
+	)
+	var i TargetFace
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Standard,
+		&i.TotalDiameter,
+		&i.ScoringDiameter,
+		&i.ZonesConfig,
+		&i.MaxScore,
+		&i.HasX,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const deleteTargetFace = `-- name: DeleteTargetFace :execrows
+UPDATE target_faces SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) DeleteTargetFace(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTargetFace, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getTargetFace = `-- name: GetTargetFace :one
+SELECT id, name, standard, total_diameter, scoring_diameter, zones_config, max_score, has_x, description, created_at, updated_at, deleted_at FROM target_faces WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetTargetFace(ctx context.Context, id uuid.UUID) (TargetFace, error) {
+	row := q.db.QueryRow(ctx, getTargetFace, id)
+	var i TargetFace
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Standard,
+		&i.TotalDiameter,
+		&i.ScoringDiameter,
+		&i.ZonesConfig,
+		&i.MaxScore,
+		&i.HasX,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getTargetFaceIncludingDeleted = `-- name: GetTargetFaceIncludingDeleted :one
+SELECT id, name, standard, total_diameter, scoring_diameter, zones_config, max_score, has_x, description, created_at, updated_at, deleted_at FROM target_faces WHERE id = $1
+`
+
+// Rounds keep the face they were shot on, so scoring looks up deleted faces too.
+func (q *Queries) GetTargetFaceIncludingDeleted(ctx context.Context, id uuid.UUID) (TargetFace, error) {
+	row := q.db.QueryRow(ctx, getTargetFaceIncludingDeleted, id)
+	var i TargetFace
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Standard,
+		&i.TotalDiameter,
+		&i.ScoringDiameter,
+		&i.ZonesConfig,
+		&i.MaxScore,
+		&i.HasX,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const isTargetFaceInUse = `-- name: IsTargetFaceInUse :one
+SELECT EXISTS (
+    SELECT 1 FROM qualification_rounds WHERE target_face_id = $1
+)
+`
+
+// Any round counts, deleted or not, since its shots were scored on the face.
+func (q *Queries) IsTargetFaceInUse(ctx context.Context, targetFaceID uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, isTargetFaceInUse, targetFaceID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listTargetFaces = `-- name: ListTargetFaces :many
+SELECT id, name, standard, total_diameter, scoring_diameter, zones_config, max_score, has_x, description, created_at, updated_at, deleted_at FROM target_faces WHERE deleted_at IS NULL ORDER BY standard, name
+`
+
+func (q *Queries) ListTargetFaces(ctx context.Context) ([]TargetFace, error) {
+	rows, err := q.db.Query(ctx, listTargetFaces)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TargetFace{}
+	for rows.Next() {
+		var i TargetFace
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Standard,
+			&i.TotalDiameter,
+			&i.ScoringDiameter,
+			&i.ZonesConfig,
+			&i.MaxScore,
+			&i.HasX,
+			&i.Description,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateTargetFace = `-- name: UpdateTargetFace :one
+UPDATE target_faces
+SET name = $2,
+    standard = $3,
+    total_diameter = $4,
+    scoring_diameter = $5,
+    zones_config = $6,
+    max_score = $7,
+    has_x = $8,
+    description = $9
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, name, standard, total_diameter, scoring_diameter, zones_config, max_score, has_x, description, created_at, updated_at, deleted_at
+`
+
+type UpdateTargetFaceParams struct {
+	ID              uuid.UUID   `json:"id"`
+	Name            string      `json:"name"`
+	Standard        string      `json:"standard"`
+	TotalDiameter   int32       `json:"total_diameter"`
+	ScoringDiameter int32       `json:"scoring_diameter"`
+	ZonesConfig     []byte      `json:"zones_config"`
+	MaxScore        int32       `json:"max_score"`
+	HasX            bool        `json:"has_x"`
+	Description     pgtype.Text `json:"description"`
+}
+
+func (q *Queries) UpdateTargetFace(ctx context.Context, arg UpdateTargetFaceParams) (TargetFace, error) {
+	row := q.db.QueryRow(ctx, updateTargetFace,
+		arg.ID,
+		arg.Name,
+		arg.Standard,
+		arg.TotalDiameter,
+		arg.ScoringDiameter,
+		arg.ZonesConfig,
+		arg.MaxScore,
+		arg.HasX,
+		arg.Description,
+	)
+	var i TargetFace
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Standard,
+		&i.TotalDiameter,
+		&i.ScoringDiameter,
+		&i.ZonesConfig,
+		&i.MaxScore,
+		&i.HasX,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,161 @@
+package db_test
+
+import (
+	"archy/scores/internal/db"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestListTargetFaces(t *testing.T) {
+	f := newFixture(t)
+
+	faces, err := f.q.ListTargetFaces(f.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Seeded by the migrations, by standard and name.
+	want := []string{spot40, wa122, wa80}
+	if len(faces) != len(want) {
+		t.Fatalf("expected %d faces, got %d", len(want), len(faces))
+	}
+	for i, face := range faces {
+		if face.Name != want[i] {
+			t.Errorf("face %d is %s, want %s", i, face.Name, want[i])
+		}
+	}
+}
+
+func TestTargetFaces(t *testing.T) {
+	f := newFixture(t)
+	zones := []byte(`[{"score": 6, "radius": 20}, {"score": 5, "radius": 40}]`)
+
+	face, err := f.q.CreateTargetFace(f.ctx, db.CreateTargetFaceParams{
+		Name:            "Field 20cm",
+		Standard:        "IFAA",
+		TotalDiameter:   200,
+		ScoringDiameter: 80,
+		ZonesConfig:     zones,
+		MaxScore:        6,
+		Description:     text("Field face"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stored []map[string]float64
+	if err := json.Unmarshal(face.ZonesConfig, &stored); err != nil || len(stored) != 2 || stored[0]["radius"] != 20 {
+		t.Errorf("zones not stored as given: %s, %v", face.ZonesConfig, err)
+	}
+
+	got, err := f.q.GetTargetFace(f.ctx, face.ID)
+	if err != nil || got.Name != "Field 20cm" || got.Description != text("Field face") {
+		t.Errorf("GetTargetFace = %+v, %v", got, err)
+	}
+
+	// Names are unique.
+	_, err = f.q.CreateTargetFace(f.ctx, db.CreateTargetFaceParams{
+		Name: wa122, Standard: "WA", TotalDiameter: 1, ScoringDiameter: 1, ZonesConfig: zones, MaxScore: 6,
+	})
+	expectCode(t, err, "23505")
+
+	// The new zones score shots of rounds on the face.
+	updated, err := f.q.UpdateTargetFace(f.ctx, db.UpdateTargetFaceParams{
+		ID:              face.ID,
+		Name:            "Field 20cm",
+		Standard:        "IFAA",
+		TotalDiameter:   200,
+		ScoringDiameter: 80,
+		ZonesConfig:     []byte(`[{"score": 5, "radius": 20}, {"score": 4, "radius": 40}]`),
+		MaxScore:        5,
+		HasX:            true,
+	})
+	if err != nil || updated.MaxScore != 5 || !updated.HasX || updated.Description.Valid ||
+		!updated.UpdatedAt.After(face.UpdatedAt) {
+		t.Fatalf("UpdateTargetFace = %+v, %v", updated, err)
+	}
+	shot := f.shoot(f.set(f.round("archer", "Field 20cm").ID, 1, 3).ID, [2]float64{0, 30})[0]
+	if shot.Score != 4 {
+		t.Errorf("expected the updated zones to score 4, got %d", shot.Score)
+	}
+
+	tests := []struct {
+		name string
+		id   uuid.UUID
+		rows int64
+	}{
+		{"deleted", face.ID, 1},
+		{"already deleted", face.ID, 0},
+		{"unknown", uuid.New(), 0},
+	}
+	for _, tt := range tests {
+		if rows, err := f.q.DeleteTargetFace(f.ctx, tt.id); err != nil || rows != tt.rows {
+			t.Errorf("%s: DeleteTargetFace = %d, %v, want %d", tt.name, rows, err, tt.rows)
+		}
+	}
+	_, err = f.q.GetTargetFace(f.ctx, face.ID)
+	expectNoRows(t, err)
+	_, err = f.q.UpdateTargetFace(f.ctx, db.UpdateTargetFaceParams{ID: face.ID, Name: "x", ZonesConfig: zones})
+	expectNoRows(t, err)
+}
+
+func TestIsTargetFaceInUse(t *testing.T) {
+	f := newFixture(t)
+	deleted := f.round("archer", wa80)
+	f.exec(`UPDATE qualification_rounds SET deleted_at = NOW() WHERE id = $1`, deleted.ID)
+	f.round("archer", wa122)
+
+	tests := []struct {
+		face  string
+		inUse bool
+	}{
+		{wa122, true},
+		{wa80, true}, // a deleted round's shots were still scored on it
+		{spot40, false},
+	}
+	for _, tt := range tests {
+		inUse, err := f.q.IsTargetFaceInUse(f.ctx, f.face(tt.face).ID)
+		if err != nil || inUse != tt.inUse {
+			t.Errorf("IsTargetFaceInUse(%s) = %v, %v, want %v", tt.face, inUse, err, tt.inUse)
+		}
+	}
+}
+
+// Faces deleted before faces in use were protected still score the rounds
+// shot on them.
+func TestTargetFaces_DeletedFaceKeepsScoring(t *testing.T) {
+	f := newFixture(t)
+	round := f.round("archer", wa122)
+	set := f.set(round.ID, 1, 6)
+	shot := f.shoot(set.ID, [2]float64{0, 0}, [2]float64{100, 0})[0]
+
+	face := f.face(wa122)
+	if rows, err := f.q.DeleteTargetFace(f.ctx, face.ID); err != nil || rows != 1 {
+		t.Fatalf("DeleteTargetFace = %d, %v", rows, err)
+	}
+	_, err := f.q.GetTargetFace(f.ctx, face.ID)
+	expectNoRows(t, err)
+	if got, err := f.q.GetTargetFaceIncludingDeleted(f.ctx, face.ID); err != nil || got.Name != wa122 || !got.DeletedAt.Valid {
+		t.Errorf("GetTargetFaceIncludingDeleted = %+v, %v", got, err)
+	}
+
+	created, err := f.q.CreateShot(f.ctx, db.CreateShotParams{X: number(t, 0), Y: number(t, 130), SetID: set.ID})
+	if err != nil || created.Score != 8 {
+		t.Errorf("CreateShot scored %d, %v, want 8", created.Score, err)
+	}
+	if batch := f.shoot(set.ID, [2]float64{200, 0}); batch[0].Score != 7 {
+		t.Errorf("BatchCreateShots scored %d, want 7", batch[0].Score)
+	}
+	updated, err := f.q.UpdateShot(f.ctx, db.UpdateShotParams{X: number(t, 50), Y: number(t, 0), ID: shot.ID, SetID: set.ID})
+	if err != nil || updated.Score != 10 {
+		t.Errorf("UpdateShot scored %d, %v, want 10", updated.Score, err)
+	}
+
+	if err := f.q.RefreshRoundStatistics(f.ctx, pgID(round.ID)); err != nil {
+		t.Fatal(err)
+	}
+	// 10 + 9 + 8 + 7
+	if got := f.getRound(round.ID); got.TotalScore != 34 || got.ShotsCount != 4 {
+		t.Errorf("expected the refreshed round to keep 34 in 4 arrows, got %d in %d", got.TotalScore, got.ShotsCount)
+	}
+}
@@ -0,0 +1,23 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// InTx runs fn with queries bound to a new transaction, which is committed
+// when fn returns nil and rolled back otherwise. Inside a transaction it
+// starts a savepoint.
+func (q *Queries) InTx(ctx context.Context, fn func(*Queries) error) error {
+	beginner, ok := q.db.(interface {
+		Begin(context.Context) (pgx.Tx, error)
+	})
+	if !ok {
+		return errors.New("db: connection cannot begin a transaction")
+	}
+	return pgx.BeginFunc(ctx, beginner, func(tx pgx.Tx) error {
+		return fn(q.WithTx(tx))
+	})
+}
@@ -0,0 +1,53 @@
+package jwt
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Reasons a token can be rejected. They are safe to show to clients; the
+// underlying library error is kept in VerificationError.Err for logs only.
+var (
+	ErrMissingToken         = errors.New("missing bearer token")
+	ErrMalformedToken       = errors.New("malformed token")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrInvalidSignature     = errors.New("invalid token signature")
+	ErrUnknownKey           = errors.New("unknown signing key")
+	ErrTokenExpired         = errors.New("token expired")
+	ErrTokenNotYetValid     = errors.New("token not yet valid")
+	ErrInvalidIssuer        = errors.New("invalid token issuer")
+	ErrInvalidAudience      = errors.New("invalid token audience")
+	ErrMissingClaim         = errors.New("missing required claim")
+	ErrKeySetUnavailable    = errors.New("signing keys unavailable")
+)
+
+// VerificationError describes why a token was rejected. errors.Is matches it
+// against its Kind, one of the Err* values above.
+type VerificationError struct {
+	Kind  error
+	Claim string // set for ErrMissingClaim
+	Err   error  // underlying cause, not meant for clients
+}
+
+func (e *VerificationError) Error() string {
+	msg := e.Kind.Error()
+	if e.Claim != "" {
+		msg = fmt.Sprintf("%s %q", msg, e.Claim)
+	}
+	if e.Err != nil {
+		msg = fmt.Sprintf("%s: %v", msg, e.Err)
+	}
+	return msg
+}
+
+func (e *VerificationError) Unwrap() error {
+	return e.Kind
+}
+
+// PublicMessage is the rejection reason without internal details.
+func (e *VerificationError) PublicMessage() string {
+	if e.Claim != "" {
+		return fmt.Sprintf("%s %q", e.Kind.Error(), e.Claim)
+	}
+	return e.Kind.Error()
+}
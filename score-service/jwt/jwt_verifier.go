@@ -2,16 +2,25 @@ package jwt
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lestrrat-go/jwx/v2/jwa"
 	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
 	"github.com/lestrrat-go/jwx/v2/jwt"
+	"golang.org/x/sync/singleflight"
 )
 
+// fetchTimeout bounds a single request to the auth-service's JWKS endpoint.
+const fetchTimeout = 10 * time.Second
+
 type JWKVerifier struct {
 	authServiceURL  string
 	jwksURL         string
@@ -19,68 +28,259 @@ type JWKVerifier struct {
 	keysExpiry      time.Time
 	mu              sync.RWMutex
 	refreshInterval time.Duration
+
+	// staticKeys are trusted in addition to the fetched key set; remoteKeys
+	// controls whether the auth-service is asked for keys at all.
+	staticKeys      jwk.Set
+	remoteKeys      bool
+	keyWithoutID    bool
+	remoteFetchedAt time.Time
+	// onFetch, if set, is told the outcome of every request for the
+	// auth-service's key set.
+	onFetch func(error)
+
+	// fetches collapses concurrent JWKS requests into one.
+	fetches singleflight.Group
+	// background is set once Start runs the refresher, which then owns
+	// periodic refreshes and their backoff.
+	background atomic.Bool
+	// lastOnDemandFetch rate-limits refetches triggered by requests.
+	lastOnDemandFetch time.Time
+	onDemandInterval  time.Duration
+	minRefreshBackoff time.Duration
+	maxRefreshBackoff time.Duration
+
+	issuer            string
+	audience          string
+	allowedAlgorithms []jwa.SignatureAlgorithm
+	clockSkew         time.Duration
+	requiredClaims    []string
 }
 
-func NewJWKVerifier(authServiceURL string) *JWKVerifier {
-	return &JWKVerifier{
-		authServiceURL:  authServiceURL,
-		jwksURL:         fmt.Sprintf("%s/api/auth/jwks", authServiceURL),
-		refreshInterval: 15 * time.Minute,
+func NewJWKVerifier(authServiceURL string, opts ...Option) *JWKVerifier {
+	j := &JWKVerifier{
+		authServiceURL:    authServiceURL,
+		jwksURL:           fmt.Sprintf("%s/api/auth/jwks", authServiceURL),
+		refreshInterval:   DefaultRefreshInterval,
+		remoteKeys:        true,
+		onDemandInterval:  DefaultKidRefetchInterval,
+		minRefreshBackoff: DefaultMinRefreshBackoff,
+		maxRefreshBackoff: DefaultMaxRefreshBackoff,
+		allowedAlgorithms: DefaultAllowedAlgorithms,
+		clockSkew:         DefaultClockSkew,
+		requiredClaims:    DefaultRequiredClaims,
+	}
+	for _, opt := range opts {
+		opt(j)
 	}
+	if j.staticKeys != nil {
+		j.Set = mergeKeySets(j.staticKeys)
+		j.keyWithoutID = hasKeyWithoutID(j.staticKeys)
+	}
+	return j
 }
 
+// fetchJWKS downloads the key set and replaces the cached one, keeping any
+// static keys. Concurrent callers share a single request; on failure the
+// previous set is kept.
 func (j *JWKVerifier) fetchJWKS() error {
-	set, err := jwk.Fetch(context.Background(), j.jwksURL)
-	if err != nil {
-		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	if !j.remoteKeys {
+		return nil
 	}
 
-	j.mu.Lock()
-	defer j.mu.Unlock()
+	_, err, _ := j.fetches.Do("jwks", func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+		defer cancel()
 
-	j.Set = set
-	j.keysExpiry = time.Now().Add(j.refreshInterval)
-	return nil
+		set, err := jwk.Fetch(ctx, j.jwksURL)
+		if j.onFetch != nil {
+			j.onFetch(err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+		}
+
+		j.mu.Lock()
+		defer j.mu.Unlock()
+
+		j.Set = mergeKeySets(j.staticKeys, set)
+		j.keysExpiry = time.Now().Add(j.refreshInterval)
+		j.remoteFetchedAt = time.Now()
+		return nil, nil
+	})
+	return err
+}
+
+// refetchOnDemand refreshes the key set on behalf of a request, at most once
+// per onDemandInterval. Requests arriving while a refetch is in flight wait
+// for it instead of starting their own.
+func (j *JWKVerifier) refetchOnDemand() error {
+	_, err, _ := j.fetches.Do("on-demand", func() (any, error) {
+		j.mu.Lock()
+		if time.Since(j.lastOnDemandFetch) < j.onDemandInterval {
+			j.mu.Unlock()
+			return nil, nil
+		}
+		j.lastOnDemandFetch = time.Now()
+		j.mu.Unlock()
+
+		return nil, j.fetchJWKS()
+	})
+	return err
 }
 
+// getJWKSet returns the cached key set. It only blocks on the auth-service
+// when no key set has ever been fetched; a stale set keeps being served while
+// a refresh happens in the background, so an auth-service outage does not
+// reject tokens signed with known keys.
 func (j *JWKVerifier) getJWKSet() (jwk.Set, error) {
 	j.mu.RLock()
 	set := j.Set
 	expiry := j.keysExpiry
 	j.mu.RUnlock()
 
-	if set != nil && time.Now().Before(expiry) {
+	if set == nil {
+		if err := j.refetchOnDemand(); err != nil {
+			return nil, err
+		}
+		j.mu.RLock()
+		set = j.Set
+		j.mu.RUnlock()
+		if set == nil {
+			return nil, errors.New("no JWKS fetched yet")
+		}
 		return set, nil
 	}
 
-	if err := j.fetchJWKS(); err != nil {
-		return nil, err
+	if j.remoteKeys && time.Now().After(expiry) && !j.background.Load() {
+		go j.refetchOnDemand()
 	}
 
-	j.mu.RLock()
-	defer j.mu.RUnlock()
-	return j.Set, nil
+	return set, nil
 }
 
+// VerifyToken checks the token's algorithm, signature and claims. Failures are
+// returned as *VerificationError.
 func (j *JWKVerifier) VerifyToken(tokenString string) (jwt.Token, error) {
+	if tokenString == "" {
+		return nil, &VerificationError{Kind: ErrMissingToken}
+	}
+
+	kid, err := j.checkAlgorithm([]byte(tokenString))
+	if err != nil {
+		return nil, err
+	}
+
 	set, err := j.getJWKSet()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get JWK set: %w", err)
+		return nil, &VerificationError{Kind: ErrKeySetUnavailable, Err: err}
+	}
+
+	if kid != "" {
+		if set, err = j.keySetWithKey(set, kid); err != nil {
+			return nil, err
+		}
 	}
 
-	token, err := jwt.Parse(
-		[]byte(tokenString),
-		jwt.WithKeySet(set),
+	options := []jwt.ParseOption{
+		// Keys without an ID, e.g. from a PEM file, are tried for any token.
+		jwt.WithKeySet(set, jws.WithInferAlgorithmFromKey(true), jws.WithRequireKid(!j.keyWithoutID)),
 		jwt.WithValidate(true),
-	)
+		jwt.WithAcceptableSkew(j.clockSkew),
+	}
+	if j.issuer != "" {
+		options = append(options, jwt.WithIssuer(j.issuer))
+	}
+	if j.audience != "" {
+		options = append(options, jwt.WithAudience(j.audience))
+	}
 
+	token, err := jwt.Parse([]byte(tokenString), options...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to verify token: %w", err)
+		return nil, classifyError(err)
+	}
+
+	for _, claim := range j.requiredClaims {
+		if _, ok := token.Get(claim); !ok {
+			return nil, &VerificationError{Kind: ErrMissingClaim, Claim: claim}
+		}
 	}
 
 	return token, nil
 }
 
+// checkAlgorithm rejects tokens whose JWS header names an algorithm that is
+// not explicitly allowed, before any key is looked up. It returns the key ID
+// the token was signed with, if any.
+func (j *JWKVerifier) checkAlgorithm(token []byte) (string, error) {
+	msg, err := jws.Parse(token)
+	if err != nil {
+		return "", &VerificationError{Kind: ErrMalformedToken, Err: err}
+	}
+
+	var kid string
+	for _, sig := range msg.Signatures() {
+		headers := sig.ProtectedHeaders()
+		alg := headers.Algorithm()
+		if !slices.Contains(j.allowedAlgorithms, alg) {
+			return "", &VerificationError{Kind: ErrUnsupportedAlgorithm, Err: fmt.Errorf("alg %q", alg)}
+		}
+		if kid == "" {
+			kid = headers.KeyID()
+		}
+	}
+	return kid, nil
+}
+
+// keySetWithKey makes sure set contains kid. A key the service has not seen
+// yet usually means the auth-service rotated its keys, so the set is
+// refetched (rate-limited) before giving up on the token. Static keys without
+// an ID may still verify it.
+func (j *JWKVerifier) keySetWithKey(set jwk.Set, kid string) (jwk.Set, error) {
+	if _, ok := set.LookupKeyID(kid); ok {
+		return set, nil
+	}
+
+	if j.remoteKeys {
+		if err := j.refetchOnDemand(); err != nil && !j.keyWithoutID {
+			return nil, &VerificationError{Kind: ErrUnknownKey, Err: err}
+		}
+
+		j.mu.RLock()
+		set = j.Set
+		j.mu.RUnlock()
+
+		if _, ok := set.LookupKeyID(kid); ok {
+			return set, nil
+		}
+	}
+
+	if j.keyWithoutID {
+		return set, nil
+	}
+	return nil, &VerificationError{Kind: ErrUnknownKey, Err: fmt.Errorf("kid %q", kid)}
+}
+
+// classifyError maps jwx parse and validation errors to a VerificationError.
+func classifyError(err error) error {
+	kind := ErrMalformedToken
+
+	switch {
+	case errors.Is(err, jwt.ErrTokenExpired()):
+		kind = ErrTokenExpired
+	case errors.Is(err, jwt.ErrTokenNotYetValid()), errors.Is(err, jwt.ErrInvalidIssuedAt()):
+		kind = ErrTokenNotYetValid
+	case errors.Is(err, jwt.ErrInvalidIssuer()):
+		kind = ErrInvalidIssuer
+	case errors.Is(err, jwt.ErrInvalidAudience()):
+		kind = ErrInvalidAudience
+	case jws.IsVerificationError(err):
+		kind = ErrInvalidSignature
+	}
+
+	return &VerificationError{Kind: kind, Err: err}
+}
+
 func (j *JWKVerifier) JWTMiddleware() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -98,7 +298,14 @@ func (j *JWKVerifier) JWTMiddleware() echo.MiddlewareFunc {
 
 			token, err := j.VerifyToken(tokenString)
 			if err != nil {
-				return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("Invalid token: %v", err))
+				var verr *VerificationError
+				if !errors.As(err, &verr) {
+					return echo.NewHTTPError(http.StatusUnauthorized, "Invalid token").SetInternal(err)
+				}
+				if errors.Is(verr, ErrKeySetUnavailable) {
+					return echo.NewHTTPError(http.StatusServiceUnavailable, "Authentication temporarily unavailable").SetInternal(err)
+				}
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid token: "+verr.PublicMessage()).SetInternal(err)
 			}
 
 			c.Set("token", token)
@@ -112,6 +319,17 @@ func (j *JWKVerifier) JWTMiddleware() echo.MiddlewareFunc {
 	}
 }
 
+// Initialize fetches the auth-service's key set. In offline mode, i.e. with
+// WithRemoteKeys(false), there is nothing to fetch.
 func (j *JWKVerifier) Initialize() error {
 	return j.fetchJWKS()
 }
+
+// KeysFetchedAt returns when the auth-service's key set was last fetched,
+// or the zero time if it never was. remote is false in offline mode, where
+// only static keys are used and there is nothing to fetch.
+func (j *JWKVerifier) KeysFetchedAt() (fetchedAt time.Time, remote bool) {
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	return j.remoteFetchedAt, j.remoteKeys
+}
@@ -0,0 +1,116 @@
+package jwt
+
+import (
+	"time"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+const (
+	// DefaultClockSkew tolerates small clock differences between the
+	// auth-service and this service when checking exp, nbf and iat.
+	DefaultClockSkew = 30 * time.Second
+	// DefaultRefreshInterval is how long a fetched key set is used before refetching.
+	DefaultRefreshInterval = 15 * time.Minute
+	// DefaultKidRefetchInterval is the minimum time between refetches
+	// triggered by requests, e.g. for a token signed with an unknown key.
+	DefaultKidRefetchInterval = 30 * time.Second
+	// DefaultMinRefreshBackoff and DefaultMaxRefreshBackoff bound the delay
+	// between background refresh attempts while the auth-service is failing.
+	DefaultMinRefreshBackoff = time.Second
+	DefaultMaxRefreshBackoff = 5 * time.Minute
+)
+
+// DefaultAllowedAlgorithms are the algorithms better-auth's jwt plugin signs with.
+var DefaultAllowedAlgorithms = []jwa.SignatureAlgorithm{jwa.EdDSA}
+
+// DefaultRequiredClaims must be present in every token.
+var DefaultRequiredClaims = []string{"sub", "exp"}
+
+// Option configures a JWKVerifier.
+type Option func(*JWKVerifier)
+
+// WithIssuer requires the iss claim to equal issuer. An empty issuer disables the check.
+func WithIssuer(issuer string) Option {
+	return func(j *JWKVerifier) {
+		j.issuer = issuer
+	}
+}
+
+// WithAudience requires the aud claim to contain audience. An empty audience disables the check.
+func WithAudience(audience string) Option {
+	return func(j *JWKVerifier) {
+		j.audience = audience
+	}
+}
+
+// WithAllowedAlgorithms restricts the JWS alg header to the given algorithms.
+func WithAllowedAlgorithms(algs ...jwa.SignatureAlgorithm) Option {
+	return func(j *JWKVerifier) {
+		j.allowedAlgorithms = algs
+	}
+}
+
+// WithClockSkew sets the tolerance applied to time-based claims.
+func WithClockSkew(skew time.Duration) Option {
+	return func(j *JWKVerifier) {
+		j.clockSkew = skew
+	}
+}
+
+// WithRequiredClaims replaces the claims that must be present in every token.
+func WithRequiredClaims(claims ...string) Option {
+	return func(j *JWKVerifier) {
+		j.requiredClaims = claims
+	}
+}
+
+// WithRefreshInterval sets how long a fetched key set is cached.
+func WithRefreshInterval(interval time.Duration) Option {
+	return func(j *JWKVerifier) {
+		j.refreshInterval = interval
+	}
+}
+
+// WithKidRefetchInterval sets the minimum time between refetches triggered by
+// requests, such as a token signed with a key the service has not seen yet.
+func WithKidRefetchInterval(interval time.Duration) Option {
+	return func(j *JWKVerifier) {
+		j.onDemandInterval = interval
+	}
+}
+
+// WithRefreshBackoff bounds the exponential backoff the background refresher
+// applies while fetching the key set fails.
+func WithRefreshBackoff(minDelay, maxDelay time.Duration) Option {
+	return func(j *JWKVerifier) {
+		j.minRefreshBackoff = minDelay
+		j.maxRefreshBackoff = maxDelay
+	}
+}
+
+// WithStaticKeys adds keys that are always trusted, e.g. loaded with
+// LoadKeySetFile. They are used alongside the auth-service's key set, or
+// instead of it together with WithRemoteKeys(false).
+func WithStaticKeys(set jwk.Set) Option {
+	return func(j *JWKVerifier) {
+		j.staticKeys = set
+	}
+}
+
+// WithRemoteKeys enables or disables fetching the auth-service's key set.
+// It is enabled by default.
+func WithRemoteKeys(enabled bool) Option {
+	return func(j *JWKVerifier) {
+		j.remoteKeys = enabled
+	}
+}
+
+// WithFetchHook calls fn after every request for the auth-service's key set,
+// with the error if the request failed. It is meant for metrics.
+func WithFetchHook(fn func(err error)) Option {
+	return func(j *JWKVerifier) {
+		j.onFetch = fn
+	}
+}
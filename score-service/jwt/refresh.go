@@ -0,0 +1,72 @@
+package jwt
+
+import (
+	"context"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+)
+
+// Start refreshes the key set in the background until ctx is cancelled.
+// Refreshes happen roughly every refresh interval, with jitter so replicas do
+// not hit the auth-service in lockstep. Failed attempts are retried with
+// exponential backoff while the last good key set stays in use.
+// If the key set has never been fetched, e.g. because the auth-service was
+// down at startup, the first attempt is made after the minimum backoff.
+func (j *JWKVerifier) Start(ctx context.Context) {
+	if !j.remoteKeys || !j.background.CompareAndSwap(false, true) {
+		return
+	}
+
+	j.mu.RLock()
+	failures := 0
+	if j.remoteFetchedAt.IsZero() {
+		failures = 1
+	}
+	j.mu.RUnlock()
+
+	go func() {
+		defer j.background.Store(false)
+
+		for {
+			timer := time.NewTimer(j.nextRefresh(failures))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			if err := j.fetchJWKS(); err != nil {
+				failures++
+				slog.Warn("JWKS refresh failed, keeping previous keys", "attempt", failures, "error", err)
+				continue
+			}
+			failures = 0
+		}
+	}()
+}
+
+// nextRefresh returns how long to wait before the next refresh attempt after
+// the given number of consecutive failures.
+func (j *JWKVerifier) nextRefresh(failures int) time.Duration {
+	if failures == 0 {
+		return jitter(j.refreshInterval)
+	}
+
+	delay := j.minRefreshBackoff
+	for i := 1; i < failures && delay < j.maxRefreshBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, j.maxRefreshBackoff, j.refreshInterval)
+	return jitter(delay)
+}
+
+// jitter spreads d by ±10%.
+func jitter(d time.Duration) time.Duration {
+	spread := int64(d / 5)
+	if spread <= 0 {
+		return d
+	}
+	return d - d/10 + time.Duration(rand.Int64N(spread))
+}
@@ -0,0 +1,46 @@
+package jwt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextRefresh(t *testing.T) {
+	j := NewJWKVerifier("http://auth.test",
+		WithRefreshInterval(10*time.Minute),
+		WithRefreshBackoff(time.Second, time.Minute),
+	)
+
+	tests := []struct {
+		failures int
+		base     time.Duration
+	}{
+		{0, 10 * time.Minute},
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{7, time.Minute},
+		{100, time.Minute},
+	}
+
+	for _, tt := range tests {
+		for range 50 {
+			got := j.nextRefresh(tt.failures)
+			lo, hi := tt.base-tt.base/10, tt.base+tt.base/10
+			if got < lo || got > hi {
+				t.Fatalf("nextRefresh(%d) = %v, want within [%v, %v]", tt.failures, got, lo, hi)
+			}
+		}
+	}
+}
+
+func TestNextRefresh_BackoffNeverExceedsInterval(t *testing.T) {
+	j := NewJWKVerifier("http://auth.test",
+		WithRefreshInterval(time.Second),
+		WithRefreshBackoff(100*time.Millisecond, time.Hour),
+	)
+
+	if got := j.nextRefresh(20); got > time.Second+time.Second/10 {
+		t.Errorf("nextRefresh(20) = %v, want at most the refresh interval", got)
+	}
+}
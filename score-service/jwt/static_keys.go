@@ -0,0 +1,79 @@
+package jwt
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+// LoadKeySetFile reads public keys from a JWKS document or a PEM file, as
+// used in offline mode. Private keys are reduced to their public part. When
+// kid is set it is assigned to every key that does not have a key ID; keys
+// without an ID are tried for any token.
+func LoadKeySetFile(path string, kid string) (jwk.Set, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+
+	var set jwk.Set
+	if bytes.Contains(data, []byte("-----BEGIN")) {
+		set, err = jwk.Parse(data, jwk.WithPEM(true))
+	} else {
+		set, err = jwk.Parse(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key file %s: %w", path, err)
+	}
+	if set.Len() == 0 {
+		return nil, fmt.Errorf("key file %s contains no keys", path)
+	}
+
+	set, err = jwk.PublicSetOf(set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive public keys from %s: %w", path, err)
+	}
+
+	if kid != "" {
+		for i := range set.Len() {
+			key, _ := set.Key(i)
+			if key.KeyID() == "" {
+				if err := key.Set(jwk.KeyIDKey, kid); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return set, nil
+}
+
+// mergeKeySets returns a set holding the keys of all given sets. Nil sets are skipped.
+func mergeKeySets(sets ...jwk.Set) jwk.Set {
+	merged := jwk.NewSet()
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+		for i := range set.Len() {
+			key, _ := set.Key(i)
+			_ = merged.AddKey(key)
+		}
+	}
+	return merged
+}
+
+// hasKeyWithoutID reports whether any key in set lacks a key ID.
+func hasKeyWithoutID(set jwk.Set) bool {
+	if set == nil {
+		return false
+	}
+	for i := range set.Len() {
+		if key, _ := set.Key(i); key.KeyID() == "" {
+			return true
+		}
+	}
+	return false
+}
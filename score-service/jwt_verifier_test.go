@@ -2,11 +2,26 @@ package main
 
 import (
 	"archy/scores/jwt"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	jwtx "github.com/lestrrat-go/jwx/v2/jwt"
 )
 
 func TestJWKVerifier_FetchJWKS(t *testing.T) {
@@ -114,3 +129,553 @@ func TestJWKVerifier_Middleware_InvalidFormat(t *testing.T) {
 		t.Errorf("Expected HTTP 401 error, got: %v", err)
 	}
 }
+
+const testAuthURL = "https://auth.archy.test"
+
+// newSigningKey generates an Ed25519 key and serves its public half as a JWKS.
+func newSigningKey(t *testing.T) (jwk.Key, *httptest.Server) {
+	t.Helper()
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	key, err := jwk.FromRaw(priv)
+	if err != nil {
+		t.Fatalf("Failed to wrap key: %v", err)
+	}
+	key.Set(jwk.KeyIDKey, "test-key")
+	key.Set(jwk.AlgorithmKey, jwa.EdDSA)
+
+	pub, err := jwk.PublicKeyOf(key)
+	if err != nil {
+		t.Fatalf("Failed to derive public key: %v", err)
+	}
+	set := jwk.NewSet()
+	set.AddKey(pub)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(server.Close)
+
+	return key, server
+}
+
+func signToken(t *testing.T, key jwk.Key, alg jwa.SignatureAlgorithm, build func(*jwtx.Builder) *jwtx.Builder) string {
+	t.Helper()
+
+	token, err := build(jwtx.NewBuilder()).Build()
+	if err != nil {
+		t.Fatalf("Failed to build token: %v", err)
+	}
+	signed, err := jwtx.Sign(token, jwtx.WithKey(alg, key))
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return string(signed)
+}
+
+func validClaims(b *jwtx.Builder) *jwtx.Builder {
+	return b.Subject("user-1").
+		Issuer(testAuthURL).
+		Audience([]string{testAuthURL}).
+		IssuedAt(time.Now()).
+		Expiration(time.Now().Add(time.Hour))
+}
+
+func TestJWKVerifier_VerifyToken_Claims(t *testing.T) {
+	key, server := newSigningKey(t)
+	verifier := jwt.NewJWKVerifier(server.URL,
+		jwt.WithIssuer(testAuthURL),
+		jwt.WithAudience(testAuthURL),
+		jwt.WithClockSkew(time.Minute),
+	)
+	if err := verifier.Initialize(); err != nil {
+		t.Fatalf("Failed to fetch JWKS: %v", err)
+	}
+
+	hmacKey, err := jwk.FromRaw([]byte("not-the-auth-service-key"))
+	if err != nil {
+		t.Fatalf("Failed to create HMAC key: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"valid", signToken(t, key, jwa.EdDSA, validClaims), nil},
+		{"expired within skew", signToken(t, key, jwa.EdDSA, func(b *jwtx.Builder) *jwtx.Builder {
+			return validClaims(b).Expiration(time.Now().Add(-30 * time.Second))
+		}), nil},
+		{"expired", signToken(t, key, jwa.EdDSA, func(b *jwtx.Builder) *jwtx.Builder {
+			return validClaims(b).Expiration(time.Now().Add(-time.Hour))
+		}), jwt.ErrTokenExpired},
+		{"not yet valid", signToken(t, key, jwa.EdDSA, func(b *jwtx.Builder) *jwtx.Builder {
+			return validClaims(b).NotBefore(time.Now().Add(time.Hour))
+		}), jwt.ErrTokenNotYetValid},
+		{"wrong issuer", signToken(t, key, jwa.EdDSA, func(b *jwtx.Builder) *jwtx.Builder {
+			return validClaims(b).Issuer("https://evil.test")
+		}), jwt.ErrInvalidIssuer},
+		{"wrong audience", signToken(t, key, jwa.EdDSA, func(b *jwtx.Builder) *jwtx.Builder {
+			return validClaims(b).Audience([]string{"another-service"})
+		}), jwt.ErrInvalidAudience},
+		{"missing subject", signToken(t, key, jwa.EdDSA, func(b *jwtx.Builder) *jwtx.Builder {
+			return b.Issuer(testAuthURL).Audience([]string{testAuthURL}).Expiration(time.Now().Add(time.Hour))
+		}), jwt.ErrMissingClaim},
+		{"disallowed algorithm", signToken(t, hmacKey, jwa.HS256, validClaims), jwt.ErrUnsupportedAlgorithm},
+		{"malformed", "not-a-jwt", jwt.ErrMalformedToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := verifier.VerifyToken(tt.token)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Expected token to be accepted, got: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Expected %v, got: %v", tt.want, err)
+			}
+			var verr *jwt.VerificationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Expected *jwt.VerificationError, got %T", err)
+			}
+		})
+	}
+}
+
+func TestJWKVerifier_Middleware_HidesInternalErrors(t *testing.T) {
+	key, server := newSigningKey(t)
+	verifier := jwt.NewJWKVerifier(server.URL, jwt.WithIssuer("https://expected.test"))
+	verifier.Initialize()
+
+	handler := verifier.JWTMiddleware()(func(c echo.Context) error {
+		return c.String(http.StatusOK, "success")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+signToken(t, key, jwa.EdDSA, validClaims))
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+
+	err := handler(c)
+	httpErr, ok := err.(*echo.HTTPError)
+	if !ok || httpErr.Code != http.StatusUnauthorized {
+		t.Fatalf("Expected HTTP 401 error, got: %v", err)
+	}
+	if httpErr.Message != "Invalid token: invalid token issuer" {
+		t.Errorf("Unexpected client message: %v", httpErr.Message)
+	}
+}
+
+// rotatingJWKS is an auth-service stand-in whose published keys can be
+// rotated, and which can be taken down, while a verifier is using it.
+type rotatingJWKS struct {
+	t      *testing.T
+	server *httptest.Server
+
+	mu      sync.Mutex
+	keys    []jwk.Key
+	down    bool
+	fetches atomic.Int32
+	delay   time.Duration
+}
+
+func newRotatingJWKS(t *testing.T) *rotatingJWKS {
+	t.Helper()
+
+	r := &rotatingJWKS{t: t}
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.fetches.Add(1)
+
+		r.mu.Lock()
+		down, delay := r.down, r.delay
+		set := jwk.NewSet()
+		for _, key := range r.keys {
+			pub, _ := jwk.PublicKeyOf(key)
+			set.AddKey(pub)
+		}
+		r.mu.Unlock()
+
+		time.Sleep(delay)
+		if down {
+			http.Error(w, "auth-service unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+// rotate generates a new signing key with the given kid and publishes it,
+// alongside the previous keys unless replace is set.
+func (r *rotatingJWKS) rotate(kid string, replace bool) jwk.Key {
+	r.t.Helper()
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		r.t.Fatalf("Failed to generate key: %v", err)
+	}
+	key, err := jwk.FromRaw(priv)
+	if err != nil {
+		r.t.Fatalf("Failed to wrap key: %v", err)
+	}
+	key.Set(jwk.KeyIDKey, kid)
+	key.Set(jwk.AlgorithmKey, jwa.EdDSA)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if replace {
+		r.keys = nil
+	}
+	r.keys = append(r.keys, key)
+	return key
+}
+
+func (r *rotatingJWKS) setDown(down bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.down = down
+}
+
+func (r *rotatingJWKS) setDelay(delay time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.delay = delay
+}
+
+func userClaims(b *jwtx.Builder) *jwtx.Builder {
+	return b.Subject("user-1").IssuedAt(time.Now()).Expiration(time.Now().Add(time.Hour))
+}
+
+// unpublishedKeyToken is signed with a key the auth-service never publishes.
+func unpublishedKeyToken(t *testing.T) string {
+	t.Helper()
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	key, err := jwk.FromRaw(priv)
+	if err != nil {
+		t.Fatalf("Failed to wrap key: %v", err)
+	}
+	key.Set(jwk.KeyIDKey, "unpublished")
+	return signToken(t, key, jwa.EdDSA, userClaims)
+}
+
+func TestJWKVerifier_RefetchesOnUnknownKid(t *testing.T) {
+	jwks := newRotatingJWKS(t)
+	jwks.rotate("key-1", false)
+
+	verifier := jwt.NewJWKVerifier(jwks.server.URL)
+	if err := verifier.Initialize(); err != nil {
+		t.Fatalf("Failed to fetch JWKS: %v", err)
+	}
+
+	// The auth-service rotates to a new key long before the cache expires.
+	newKey := jwks.rotate("key-2", false)
+
+	if _, err := verifier.VerifyToken(signToken(t, newKey, jwa.EdDSA, userClaims)); err != nil {
+		t.Fatalf("Token signed with rotated key rejected: %v", err)
+	}
+	if got := jwks.fetches.Load(); got != 2 {
+		t.Errorf("Expected 2 JWKS fetches, got %d", got)
+	}
+}
+
+func TestJWKVerifier_UnknownKidRefetchIsRateLimited(t *testing.T) {
+	jwks := newRotatingJWKS(t)
+	jwks.rotate("key-1", false)
+
+	verifier := jwt.NewJWKVerifier(jwks.server.URL, jwt.WithKidRefetchInterval(time.Hour))
+	if err := verifier.Initialize(); err != nil {
+		t.Fatalf("Failed to fetch JWKS: %v", err)
+	}
+
+	token := unpublishedKeyToken(t)
+
+	for range 20 {
+		_, err := verifier.VerifyToken(token)
+		if !errors.Is(err, jwt.ErrUnknownKey) {
+			t.Fatalf("Expected ErrUnknownKey, got %v", err)
+		}
+	}
+	if got := jwks.fetches.Load(); got != 2 {
+		t.Errorf("Expected a single refetch for unknown kids, got %d fetches", got-1)
+	}
+}
+
+func TestJWKVerifier_ConcurrentRequestsShareFetch(t *testing.T) {
+	jwks := newRotatingJWKS(t)
+	key := jwks.rotate("key-1", false)
+	jwks.setDelay(100 * time.Millisecond)
+
+	// Not initialized: every request needs the key set at the same time.
+	verifier := jwt.NewJWKVerifier(jwks.server.URL)
+	token := signToken(t, key, jwa.EdDSA, userClaims)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 50)
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := verifier.VerifyToken(token); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("Request failed: %v", err)
+	}
+	if got := jwks.fetches.Load(); got != 1 {
+		t.Errorf("Expected concurrent requests to share 1 JWKS fetch, got %d", got)
+	}
+}
+
+func TestJWKVerifier_ServesLastGoodKeysWhileAuthServiceDown(t *testing.T) {
+	jwks := newRotatingJWKS(t)
+	key := jwks.rotate("key-1", false)
+
+	verifier := jwt.NewJWKVerifier(jwks.server.URL, jwt.WithRefreshInterval(time.Millisecond))
+	if err := verifier.Initialize(); err != nil {
+		t.Fatalf("Failed to fetch JWKS: %v", err)
+	}
+
+	jwks.setDown(true)
+	time.Sleep(5 * time.Millisecond) // let the cached set expire
+
+	token := signToken(t, key, jwa.EdDSA, userClaims)
+	for range 5 {
+		if _, err := verifier.VerifyToken(token); err != nil {
+			t.Fatalf("Expected cached keys to be used while the auth-service is down, got %v", err)
+		}
+	}
+}
+
+func TestJWKVerifier_FetchHook(t *testing.T) {
+	jwks := newRotatingJWKS(t)
+	jwks.rotate("key-1", false)
+
+	var results []error
+	verifier := jwt.NewJWKVerifier(jwks.server.URL, jwt.WithFetchHook(func(err error) {
+		results = append(results, err)
+	}))
+
+	if err := verifier.Initialize(); err != nil {
+		t.Fatalf("Failed to fetch JWKS: %v", err)
+	}
+	jwks.setDown(true)
+	if err := verifier.Initialize(); err == nil {
+		t.Fatal("Expected the fetch to fail while the auth-service is down")
+	}
+
+	if len(results) != 2 || results[0] != nil || results[1] == nil {
+		t.Errorf("Expected a success and a failure to be reported, got %v", results)
+	}
+}
+
+func TestJWKVerifier_BackgroundRefreshPicksUpRotation(t *testing.T) {
+	jwks := newRotatingJWKS(t)
+	jwks.rotate("key-1", false)
+
+	// Kid-miss refetches are effectively disabled so only the background
+	// refresher can pick up the new key.
+	verifier := jwt.NewJWKVerifier(jwks.server.URL,
+		jwt.WithRefreshInterval(20*time.Millisecond),
+		jwt.WithKidRefetchInterval(time.Hour),
+	)
+	if err := verifier.Initialize(); err != nil {
+		t.Fatalf("Failed to fetch JWKS: %v", err)
+	}
+	// Use up the single on-demand refetch allowed per interval.
+	verifier.VerifyToken(unpublishedKeyToken(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	verifier.Start(ctx)
+
+	newKey := jwks.rotate("key-2", true)
+	token := signToken(t, newKey, jwa.EdDSA, userClaims)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		_, err := verifier.VerifyToken(token)
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Background refresh did not pick up rotated key: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestJWKVerifier_BackgroundRefreshSurvivesOutage(t *testing.T) {
+	jwks := newRotatingJWKS(t)
+	key := jwks.rotate("key-1", false)
+
+	verifier := jwt.NewJWKVerifier(jwks.server.URL,
+		jwt.WithRefreshInterval(20*time.Millisecond),
+		jwt.WithRefreshBackoff(5*time.Millisecond, 20*time.Millisecond),
+		jwt.WithKidRefetchInterval(time.Hour),
+	)
+	if err := verifier.Initialize(); err != nil {
+		t.Fatalf("Failed to fetch JWKS: %v", err)
+	}
+	verifier.VerifyToken(unpublishedKeyToken(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	verifier.Start(ctx)
+
+	jwks.setDown(true)
+	before := jwks.fetches.Load()
+	time.Sleep(100 * time.Millisecond)
+	if jwks.fetches.Load() == before {
+		t.Error("Expected the refresher to keep retrying during the outage")
+	}
+	if _, err := verifier.VerifyToken(signToken(t, key, jwa.EdDSA, userClaims)); err != nil {
+		t.Fatalf("Expected last good keys to be served during the outage, got %v", err)
+	}
+
+	// Once the auth-service is back the refresher recovers on its own.
+	newKey := jwks.rotate("key-2", true)
+	jwks.setDown(false)
+	token := signToken(t, newKey, jwa.EdDSA, userClaims)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		_, err := verifier.VerifyToken(token)
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Refresher did not recover after the outage: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// writePublicKeyFile writes the public part of key to dir as a PEM file or a
+// JWKS document.
+func writePublicKeyFile(t *testing.T, key jwk.Key, pemFormat bool) string {
+	t.Helper()
+
+	pub, err := jwk.PublicKeyOf(key)
+	if err != nil {
+		t.Fatalf("Failed to derive public key: %v", err)
+	}
+
+	var data []byte
+	path := filepath.Join(t.TempDir(), "keys.json")
+	if pemFormat {
+		var raw any
+		if err := pub.Raw(&raw); err != nil {
+			t.Fatalf("Failed to export key: %v", err)
+		}
+		der, err := x509.MarshalPKIXPublicKey(raw)
+		if err != nil {
+			t.Fatalf("Failed to marshal key: %v", err)
+		}
+		data = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+		path = filepath.Join(t.TempDir(), "key.pem")
+	} else {
+		set := jwk.NewSet()
+		set.AddKey(pub)
+		if data, err = json.Marshal(set); err != nil {
+			t.Fatalf("Failed to marshal key set: %v", err)
+		}
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("Failed to write key file: %v", err)
+	}
+	return path
+}
+
+func TestJWKVerifier_OfflineMode(t *testing.T) {
+	jwks := newRotatingJWKS(t)
+	key := jwks.rotate("key-1", false)
+	token := signToken(t, key, jwa.EdDSA, userClaims)
+
+	tests := []struct {
+		name string
+		pem  bool
+		kid  string
+	}{
+		{"jwks file", false, ""},
+		{"pem file with key id", true, "key-1"},
+		{"pem file without key id", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := jwt.LoadKeySetFile(writePublicKeyFile(t, key, tt.pem), tt.kid)
+			if err != nil {
+				t.Fatalf("Failed to load key file: %v", err)
+			}
+
+			// The auth-service URL is never contacted.
+			verifier := jwt.NewJWKVerifier(jwks.server.URL, jwt.WithStaticKeys(set), jwt.WithRemoteKeys(false))
+			if err := verifier.Initialize(); err != nil {
+				t.Fatalf("Initialize should not need the auth-service: %v", err)
+			}
+			if _, err := verifier.VerifyToken(token); err != nil {
+				t.Fatalf("Token rejected: %v", err)
+			}
+			if _, err := verifier.VerifyToken(unpublishedKeyToken(t)); err == nil {
+				t.Error("Token signed with another key accepted")
+			}
+			if got := jwks.fetches.Load(); got != 0 {
+				t.Errorf("Expected no JWKS fetches in offline mode, got %d", got)
+			}
+		})
+	}
+}
+
+func TestJWKVerifier_StaticKeysWithUnreachableAuthService(t *testing.T) {
+	jwks := newRotatingJWKS(t)
+	remoteKey := jwks.rotate("remote-key", false)
+	jwks.setDown(true)
+
+	_, priv, _ := ed25519.GenerateKey(rand.Reader)
+	localKey, _ := jwk.FromRaw(priv)
+	localKey.Set(jwk.KeyIDKey, "local-key")
+	set, err := jwt.LoadKeySetFile(writePublicKeyFile(t, localKey, false), "")
+	if err != nil {
+		t.Fatalf("Failed to load key file: %v", err)
+	}
+
+	verifier := jwt.NewJWKVerifier(jwks.server.URL, jwt.WithStaticKeys(set))
+	if err := verifier.Initialize(); err == nil {
+		t.Fatal("Expected Initialize to report the unreachable auth-service")
+	}
+
+	// Static keys work while the auth-service is down...
+	if _, err := verifier.VerifyToken(signToken(t, localKey, jwa.EdDSA, userClaims)); err != nil {
+		t.Fatalf("Token signed with static key rejected: %v", err)
+	}
+
+	// ...and remote keys are picked up once it is back, without losing the static ones.
+	jwks.setDown(false)
+	if err := verifier.Initialize(); err != nil {
+		t.Fatalf("Failed to fetch JWKS: %v", err)
+	}
+	if _, err := verifier.VerifyToken(signToken(t, remoteKey, jwa.EdDSA, userClaims)); err != nil {
+		t.Fatalf("Token signed with remote key rejected: %v", err)
+	}
+	if _, err := verifier.VerifyToken(signToken(t, localKey, jwa.EdDSA, userClaims)); err != nil {
+		t.Fatalf("Static key lost after fetching remote keys: %v", err)
+	}
+}